@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -38,6 +39,7 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	"github.com/spf13/cast"
+	"google.golang.org/grpc"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	reflectionv1 "cosmossdk.io/api/cosmos/reflection/v1"
@@ -85,7 +87,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
-	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -132,6 +133,8 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/CosmWasm/wasmd/x/wasm"
+	"github.com/CosmWasm/wasmd/x/wasm/debugsink"
+	"github.com/CosmWasm/wasmd/x/wasm/eventindex"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 )
@@ -242,6 +245,13 @@ type WasmApp struct {
 
 	// module configurator
 	configurator module.Configurator
+
+	// eventIndexer indexes contract events when enabled in the wasm config
+	eventIndexer *eventindex.Indexer
+	// contractDebugSink receives the contract debug output in contract debug mode
+	contractDebugSink wasmtypes.DebugSink
+	// contractDebugServer serves the output of the memory contract debug sink when configured
+	contractDebugServer *grpc.Server
 }
 
 // NewWasmApp returns a reference to an initialized WasmApp.
@@ -627,6 +637,27 @@ func NewWasmApp(
 	if err != nil {
 		panic(fmt.Sprintf("error while reading wasm config: %s", err))
 	}
+	if wasmConfig.EventIndex {
+		app.eventIndexer, err = eventindex.Open(filepath.Join(homePath, "data"), server.GetAppDBBackend(appOpts))
+		if err != nil {
+			panic(err)
+		}
+		wasmtypes.RegisterEventIndexServer(app.GRPCQueryRouter(), app.eventIndexer)
+	}
+	if wasmConfig.ContractDebugMode {
+		app.contractDebugSink, err = debugsink.New(homePath, wasmConfig)
+		if err != nil {
+			panic(err)
+		}
+		if rb, ok := app.contractDebugSink.(*debugsink.RingBuffer); ok && wasmConfig.ContractDebugGRPCAddress != "" {
+			app.contractDebugServer, err = debugsink.Serve(wasmConfig.ContractDebugGRPCAddress, rb)
+			if err != nil {
+				panic(err)
+			}
+		}
+		// options of the caller take precedence
+		wasmOpts = append([]wasmkeeper.Option{wasmkeeper.WithCallTracer(wasmtypes.NewCallTraceExporter(app.contractDebugSink))}, wasmOpts...)
+	}
 
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
@@ -960,12 +991,9 @@ func (app *WasmApp) setAnteHandler(txConfig client.TxConfig, wasmConfig wasmtype
 }
 
 func (app *WasmApp) setPostHandler() {
-	postHandler, err := posthandler.NewPostHandler(
-		posthandler.HandlerOptions{},
+	postHandler := sdk.ChainPostDecorators(
+		wasmkeeper.NewGasProfilePostDecorator(), // returns the gas breakdown of contract calls in simulations
 	)
-	if err != nil {
-		panic(err)
-	}
 
 	app.SetPostHandler(postHandler)
 }
@@ -973,6 +1001,37 @@ func (app *WasmApp) setPostHandler() {
 // Name returns the name of the App
 func (app *WasmApp) Name() string { return app.BaseApp.Name() }
 
+// FinalizeBlock executes the block and indexes the contract events when the event index is enabled.
+// Indexing failures are logged only as the index is not part of the consensus state.
+func (app *WasmApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+	res, err := app.BaseApp.FinalizeBlock(req)
+	if err != nil || app.eventIndexer == nil {
+		return res, err
+	}
+	if err := app.eventIndexer.ListenFinalizeBlock(context.Background(), *req, *res); err != nil {
+		app.Logger().Error("failed to index contract events", "height", req.Height, "err", err)
+	}
+	return res, nil
+}
+
+// Close closes the event index and the BaseApp
+func (app *WasmApp) Close() error {
+	if app.eventIndexer != nil {
+		if err := app.eventIndexer.Close(); err != nil {
+			return err
+		}
+	}
+	if app.contractDebugServer != nil {
+		app.contractDebugServer.Stop()
+	}
+	if c, ok := app.contractDebugSink.(io.Closer); ok {
+		if err := c.Close(); err != nil {
+			return err
+		}
+	}
+	return app.BaseApp.Close()
+}
+
 // PreBlocker application updates every pre block
 func (app *WasmApp) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
 	return app.ModuleManager.PreBlock(ctx)
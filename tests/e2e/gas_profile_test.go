@@ -0,0 +1,81 @@
+package e2e_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/tests/e2e"
+	"github.com/CosmWasm/wasmd/x/wasm/ibctesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestGasProfileInSimulation(t *testing.T) {
+	// Given a contract
+	// When  a tx that executes the contract is simulated
+	// Then  the gas profile is returned as event on success
+	// And   as part of the error on failure
+	coord := ibctesting.NewCoordinator(t, 1)
+	chain := coord.GetChain(ibctesting.GetChainID(1))
+	contractAddr := e2e.InstantiateReflectContract(t, chain)
+
+	specs := map[string]struct {
+		msg    []byte
+		expErr bool
+	}{
+		"success": {
+			msg: []byte(`{"change_owner":{"owner":"` + chain.SenderAccount.GetAddress().String() + `"}}`),
+		},
+		"contract error": {
+			msg:    []byte(`{"unknown":{}}`),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			tx, err := simtestutil.GenSignedMockTx(
+				rand.New(rand.NewSource(time.Now().UnixNano())),
+				chain.TxConfig,
+				[]sdk.Msg{&types.MsgExecuteContract{
+					Sender:   chain.SenderAccount.GetAddress().String(),
+					Contract: contractAddr.String(),
+					Msg:      spec.msg,
+				}},
+				chain.DefaultMsgFees,
+				simtestutil.DefaultGenTxGas,
+				chain.ChainID,
+				[]uint64{chain.SenderAccount.GetAccountNumber()},
+				[]uint64{chain.SenderAccount.GetSequence()},
+				chain.SenderPrivKey,
+			)
+			require.NoError(t, err)
+			txBytes, err := chain.TxConfig.TxEncoder()(tx)
+			require.NoError(t, err)
+
+			// when
+			_, res, gotErr := chain.App.GetBaseApp().Simulate(txBytes)
+
+			// then
+			expProfile := `[{"step":"execute","contract":"` + contractAddr.String()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.Contains(t, gotErr.Error(), "gas profile: "+expProfile)
+				return
+			}
+			require.NoError(t, gotErr)
+			var profile string
+			for _, e := range res.Events {
+				if e.Type == types.EventTypeGasProfile {
+					profile = e.Attributes[0].Value
+				}
+			}
+			assert.Contains(t, profile, expProfile)
+		})
+	}
+}
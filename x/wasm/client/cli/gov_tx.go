@@ -53,6 +53,12 @@ func SubmitProposalCmd() *cobra.Command {
 		ProposalAddCodeUploadParamsAddresses(),
 		ProposalRemoveCodeUploadParamsAddresses(),
 		ProposalStoreAndMigrateContractCmd(),
+		ProposalFreezeContractCmd(),
+		ProposalUnfreezeContractCmd(),
+		ProposalSetCodeStatusCmd(),
+		ProposalPurgeContractCmd(),
+		ProposalAddCronScheduleCmd(),
+		ProposalRemoveCronScheduleCmd(),
 	)
 	return cmd
 }
@@ -991,3 +997,317 @@ func ProposalStoreAndMigrateContractCmd() *cobra.Command {
 	addCommonProposalFlags(cmd)
 	return cmd
 }
+
+func ProposalFreezeContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-contract [contract_addr_bech32] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a freeze contract proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			msg := types.MsgFreezeContract{
+				Sender:   authority,
+				Contract: args[0],
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalUnfreezeContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze-contract [contract_addr_bech32] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a unfreeze contract proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			msg := types.MsgUnfreezeContract{
+				Sender:   authority,
+				Contract: args[0],
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalSetCodeStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-code-status [active|deprecated] [code-ids] --checksum [hex] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to deprecate or reactivate codes",
+		Long: "Submit a proposal to deprecate or reactivate codes. Deprecated codes can not be used for new contract instances or migrations.\n" +
+			"Codes are selected by code ids and/or by checksum.",
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			msg, err := parseSetCodeStatusArgs(args, authority, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().String(flagChecksum, "", "Select all codes with this hex encoded checksum")
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func parseSetCodeStatusArgs(args []string, authority string, flags *flag.FlagSet) (types.MsgSetCodeStatus, error) {
+	var status types.CodeStatus
+	switch args[0] {
+	case "active":
+		status = types.CodeStatusActive
+	case "deprecated":
+		status = types.CodeStatusDeprecated
+	default:
+		return types.MsgSetCodeStatus{}, fmt.Errorf("unknown status: %q", args[0])
+	}
+
+	var codeIDs []uint64
+	if len(args) > 1 {
+		var err error
+		if codeIDs, err = parsePinCodesArgs(args[1:]); err != nil {
+			return types.MsgSetCodeStatus{}, err
+		}
+	}
+
+	checksumHex, err := flags.GetString(flagChecksum)
+	if err != nil {
+		return types.MsgSetCodeStatus{}, fmt.Errorf("checksum: %s", err)
+	}
+	checksum, err := hex.DecodeString(checksumHex)
+	if err != nil {
+		return types.MsgSetCodeStatus{}, fmt.Errorf("checksum: %s", err)
+	}
+
+	return types.MsgSetCodeStatus{
+		Authority: authority,
+		CodeIDs:   codeIDs,
+		Checksum:  checksum,
+		Status:    status,
+	}, nil
+}
+
+func ProposalPurgeContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "purge-contract [contract_addr_bech32] [beneficiary_addr_bech32] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a purge contract proposal",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			skipHook, err := cmd.Flags().GetBool(flagSkipPurgeHook)
+			if err != nil {
+				return fmt.Errorf("skip purge hook: %s", err)
+			}
+
+			msg := types.MsgPurgeContract{
+				Sender:        authority,
+				Contract:      args[0],
+				Beneficiary:   args[1],
+				SkipPurgeHook: skipHook,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().Bool(flagSkipPurgeHook, false, "Do not call the purge sudo entrypoint of the contract. Required for frozen contracts and contracts without sudo entrypoint")
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalAddCronScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-cron-schedule [name] [contract_addr_bech32] [json_encoded_sudo_msg] --interval [blocks] --gas-limit [gas] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to call a contract with a sudo message at the end of every interval blocks",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			interval, err := cmd.Flags().GetUint64(flagInterval)
+			if err != nil {
+				return fmt.Errorf("interval: %s", err)
+			}
+			gasLimit, err := cmd.Flags().GetUint64(flagGasLimit)
+			if err != nil {
+				return fmt.Errorf("gas limit: %s", err)
+			}
+
+			msg := types.MsgAddCronSchedule{
+				Authority: authority,
+				Name:      args[0],
+				Contract:  args[1],
+				Msg:       []byte(args[2]),
+				Interval:  interval,
+				GasLimit:  gasLimit,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().Uint64(flagInterval, 1, "Number of blocks between two runs")
+	cmd.Flags().Uint64(flagGasLimit, 0, "Max gas that can be spent on a single run")
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
+
+func ProposalRemoveCronScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-cron-schedule [name] --title [text] --summary [text] --authority [address]",
+		Short: "Submit a proposal to remove a scheduled contract call",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, proposalTitle, summary, deposit, expedite, err := getProposalInfo(cmd)
+			if err != nil {
+				return err
+			}
+
+			authority, err := cmd.Flags().GetString(flagAuthority)
+			if err != nil {
+				return fmt.Errorf("authority: %s", err)
+			}
+
+			if len(authority) == 0 {
+				return errors.New("authority address is required")
+			}
+
+			msg := types.MsgRemoveCronSchedule{
+				Authority: authority,
+				Name:      args[0],
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{&msg}, deposit, clientCtx.GetFromAddress().String(), "", proposalTitle, summary, expedite)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+		},
+		SilenceUsage: true,
+	}
+	// proposal flags
+	addCommonProposalFlags(cmd)
+	return cmd
+}
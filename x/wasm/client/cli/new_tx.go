@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/spf13/cobra"
@@ -10,6 +11,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
@@ -185,3 +188,369 @@ func UpdateContractLabelCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// FreezeContractCmd blocks any execution of a contract
+func FreezeContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze [contract_addr_bech32]",
+		Short: "Freeze a contract to block any further execution",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgFreezeContract{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// UnfreezeContractCmd lifts the freeze from a contract
+func UnfreezeContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze [contract_addr_bech32]",
+		Short: "Unfreeze a contract to allow execution again",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUnfreezeContract{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// PurgeContractCmd removes a contract with its state
+func PurgeContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "purge [contract_addr_bech32] [beneficiary_addr_bech32]",
+		Short: "Purge a contract with its state and move the remaining balance to the beneficiary",
+		Long: "Purge a contract with its state and move the remaining balance to the beneficiary. The contract's `purge` sudo entrypoint is called first unless skipped. " +
+			"The contract address can never be used again.",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			skipHook, err := cmd.Flags().GetBool(flagSkipPurgeHook)
+			if err != nil {
+				return errorsmod.Wrap(err, "skip purge hook")
+			}
+
+			msg := types.MsgPurgeContract{
+				Sender:        clientCtx.GetFromAddress().String(),
+				Contract:      args[0],
+				Beneficiary:   args[1],
+				SkipPurgeHook: skipHook,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().Bool(flagSkipPurgeHook, false, "Do not call the purge sudo entrypoint of the contract. Required for frozen contracts and contracts without sudo entrypoint")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// SetFeeSponsorshipCmd creates or updates the fee sponsorship of a contract
+func SetFeeSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fee-sponsorship [contract_addr_bech32]",
+		Short: "Let a contract pay the tx fees for calls to it",
+		Long: "Create or update the fee sponsorship of a contract. Txs with only execute messages of the fee payer to this contract " +
+			"get their fees paid from the sponsorship balance within the limits. Empty limits are not enforced.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			maxFeePerSender, err := parseCoinsFlag(cmd, flagMaxFeePerSender)
+			if err != nil {
+				return err
+			}
+			maxFeePerBlock, err := parseCoinsFlag(cmd, flagMaxFeePerBlock)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetFeeSponsorship{
+				Sender:          clientCtx.GetFromAddress().String(),
+				Contract:        args[0],
+				MaxFeePerSender: maxFeePerSender,
+				MaxFeePerBlock:  maxFeePerBlock,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().String(flagMaxFeePerSender, "", "Max total fee paid for a single sender")
+	cmd.Flags().String(flagMaxFeePerBlock, "", "Max total fee paid within a single block")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// FundFeeSponsorshipCmd deposits funds to the fee sponsorship of a contract
+func FundFeeSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-fee-sponsorship [contract_addr_bech32] [amount]",
+		Short: "Deposit funds to pay the tx fees for calls to a contract",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return errorsmod.Wrap(err, "amount")
+			}
+
+			msg := types.MsgFundFeeSponsorship{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+				Amount:   amount,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// RemoveFeeSponsorshipCmd removes the fee sponsorship of a contract
+func RemoveFeeSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-fee-sponsorship [contract_addr_bech32]",
+		Short: "Remove the fee sponsorship of a contract and return the remaining balance to the contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRemoveFeeSponsorship{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// UpdateExecuteConfigCmd restricts who can execute a contract
+func UpdateExecuteConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-execute-config [contract_addr_bech32]",
+		Short: "Update the execute permission of a contract",
+		Long: fmt.Sprintf(`Update the permission for executing a contract. The access config flags define the accounts
+that can execute the contract. Without any of them, the restriction is removed and everybody can execute it again.
+Example:
+$ %s tx wasm update-execute-config wasm1... --%s wasm1...,wasm1...
+`, version.AppName, flagInstantiateByAnyOfAddress),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			perm, err := parseAccessConfigFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUpdateExecuteConfig{
+				Sender:               clientCtx.GetFromAddress().String(),
+				Contract:             args[0],
+				NewExecutePermission: perm,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	addInstantiatePermissionFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// UpdateContractAdminSetCmd replaces the admin of a contract with a group of admins
+func UpdateContractAdminSetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-contract-admin-set [contract_addr_bech32] [threshold] [member_addr_bech32]...",
+		Short: "Replace the admin of a contract with a group of admins",
+		Long: `Replace the admin of a contract with a group of admins. Migrations require the approval of threshold members.
+Members can act alone on other admin operations only with a threshold of 1.`,
+		Args: cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			threshold, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return errorsmod.Wrap(err, "threshold")
+			}
+
+			msg := types.MsgUpdateAdminSet{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+				AdminSet: types.AdminSet{
+					Members:   args[2:],
+					Threshold: uint32(threshold),
+				},
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// ApproveMigrationCmd approves the migration of a contract by an admin set member
+func ApproveMigrationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-migration [contract_addr_bech32] [new_code_id_int64] [json_encoded_migration_args]",
+		Short: "Approve the migration of a contract as a member of its admin set",
+		Long: `Approve the migration of a contract as a member of its admin set. The contract is migrated when the
+approvals reach the threshold. Approvals for a different code id or message replace the pending migration.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			codeID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return errorsmod.Wrap(err, "code id")
+			}
+
+			msg := types.MsgApproveMigration{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+				CodeID:   codeID,
+				Msg:      []byte(args[2]),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// SetContractMetadataCmd sets the metadata of a contract
+func SetContractMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-contract-metadata [contract_addr_bech32]",
+		Short: "Set the metadata of a contract as its creator or admin",
+		Long: `Set the metadata of a contract as its creator or admin. The metadata replaces the existing one.
+Without any metadata flag, the existing metadata is removed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			var metadata types.ContractMetadata
+			for _, v := range []struct {
+				flag string
+				dst  *string
+			}{
+				{flagMetadataName, &metadata.Name},
+				{flagMetadataDescription, &metadata.Description},
+				{flagMetadataHomepage, &metadata.Homepage},
+				{flagMetadataSchemaURI, &metadata.SchemaURI},
+				{flagMetadataSchemaHash, &metadata.SchemaHash},
+			} {
+				if *v.dst, err = cmd.Flags().GetString(v.flag); err != nil {
+					return errorsmod.Wrap(err, v.flag)
+				}
+			}
+			if metadata.AuditLinks, err = cmd.Flags().GetStringSlice(flagMetadataAuditLink); err != nil {
+				return errorsmod.Wrap(err, flagMetadataAuditLink)
+			}
+
+			msg := types.MsgSetContractMetadata{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Contract: args[0],
+				Metadata: metadata,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().String(flagMetadataName, "", "Display name of the contract")
+	cmd.Flags().String(flagMetadataDescription, "", "Description of the contract")
+	cmd.Flags().String(flagMetadataHomepage, "", "Homepage URL of the contract")
+	cmd.Flags().StringSlice(flagMetadataAuditLink, []string{}, "Audit report URLs, can be repeated or comma separated")
+	cmd.Flags().String(flagMetadataSchemaURI, "", "URI of the JSON schema of the contract messages")
+	cmd.Flags().String(flagMetadataSchemaHash, "", "Hex encoded sha256 hash of the JSON schema")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseCoinsFlag(cmd *cobra.Command, flagName string) (sdk.Coins, error) {
+	v, err := cmd.Flags().GetString(flagName)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, flagName)
+	}
+	coins, err := sdk.ParseCoinsNormalized(v)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, flagName)
+	}
+	return coins, nil
+}
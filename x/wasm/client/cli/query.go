@@ -1,12 +1,14 @@
 package cli
 
 import (
+	"bufio"
 	"context"
 	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"os"
 	"strconv"
 
@@ -17,10 +19,24 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/CosmWasm/wasmd/x/wasm/client/proof"
+	"github.com/CosmWasm/wasmd/x/wasm/cwstorage"
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
+const (
+	flagProve       = "prove"
+	flagKeyPrefix   = "prefix"
+	flagKeyStart    = "start"
+	flagKeyEnd      = "end"
+	flagKeyEncoding = "key-encoding"
+	flagDecode      = "decode"
+	flagLabelPrefix = "prefix"
+	flagCallers     = "callers"
+)
+
 func GetQueryCmd() *cobra.Command {
 	queryCmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -43,6 +59,23 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdBuildAddress(),
 		GetCmdListContractsByCreator(),
+		GetCmdListFrozenContracts(),
+		GetCmdGetContractStorageStats(),
+		GetCmdListCronSchedules(),
+		GetCmdGetCronSchedule(),
+		GetCmdListContractCallbacks(),
+		GetCmdListFeeSponsorships(),
+		GetCmdGetFeeSponsorship(),
+		GetCmdListFeeSponsorshipUsages(),
+		GetCmdListMigrationApprovals(),
+		GetCmdBatchContractStateSmart(),
+		GetCmdListContractsByAdmin(),
+		GetCmdListContractsByLabel(),
+		GetCmdListCodesByChecksum(),
+		GetCmdListCodesByCreator(),
+		GetCmdGetContractDependencies(),
+		GetCmdGetContractMetadata(),
+		GetCmdQueryGasCosts(),
 	)
 	return queryCmd
 }
@@ -309,6 +342,42 @@ func GetCmdGetContractInfo() *cobra.Command {
 	return cmd
 }
 
+// GetCmdGetContractStorageStats prints the number of keys and bytes stored by a contract
+func GetCmdGetContractStorageStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "contract-storage-stats [bech32_address]",
+		Short:   "Prints out the storage usage of a contract given its address",
+		Long:    "Prints out the number of keys and the total bytes of keys and values stored by a contract given its address",
+		Aliases: []string{"storage-stats"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractStorageStats(
+				context.Background(),
+				&types.QueryContractStorageStatsRequest{
+					Address: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdGetContractState dumps full internal state of a given contract
 func GetCmdGetContractState() *cobra.Command {
 	cmd := &cobra.Command{
@@ -349,40 +418,103 @@ func GetCmdGetContractStateAll() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			encoding, err := cmd.Flags().GetString(flagKeyEncoding)
+			if err != nil {
+				return err
+			}
+			var keyRange [3][]byte
+			for i, name := range []string{flagKeyPrefix, flagKeyStart, flagKeyEnd} {
+				v, err := cmd.Flags().GetString(name)
+				if err != nil {
+					return err
+				}
+				if v == "" {
+					continue
+				}
+				if keyRange[i], err = decodeStateKey(v, encoding); err != nil {
+					return fmt.Errorf("%s: %w", name, err)
+				}
+			}
 			queryClient := types.NewQueryClient(clientCtx)
 			res, err := queryClient.AllContractState(
 				context.Background(),
 				&types.QueryAllContractStateRequest{
 					Address:    args[0],
 					Pagination: pageReq,
+					Prefix:     keyRange[0],
+					Start:      keyRange[1],
+					End:        keyRange[2],
 				},
 			)
 			if err != nil {
 				return err
 			}
+			if decode, _ := cmd.Flags().GetBool(flagDecode); decode {
+				return printDecodedState(clientCtx, res)
+			}
 			return clientCtx.PrintProto(res)
 		},
 		SilenceUsage: true,
 	}
+	cmd.Flags().Bool(flagDecode, false, "Decode keys and values of the cw-storage-plus Item, Map and IndexedMap layouts")
+	cmd.Flags().String(flagKeyPrefix, "", "Only keys starting with this prefix")
+	cmd.Flags().String(flagKeyStart, "", "Inclusive lower key bound")
+	cmd.Flags().String(flagKeyEnd, "", "Exclusive upper key bound")
+	cmd.Flags().String(flagKeyEncoding, "hex", "Encoding of the key flags: hex, base64 or namespace. The namespace of a cw-storage-plus Map is encoded with its length prefix")
 	flags.AddQueryFlagsToCmd(cmd)
 	addPaginationFlags(cmd, "contract state")
 	return cmd
 }
 
+// printDecodedState prints the contract state with keys and values decoded as cw-storage-plus entries
+func printDecodedState(clientCtx client.Context, res *types.QueryAllContractStateResponse) error {
+	entries := make([]cwstorage.Entry, len(res.Models))
+	for i, m := range res.Models {
+		entries[i] = cwstorage.Decode(m.Key, m.Value)
+	}
+	bz, err := json.Marshal(struct {
+		Models     []cwstorage.Entry   `json:"models"`
+		Pagination *query.PageResponse `json:"pagination,omitempty"`
+	}{Models: entries, Pagination: res.Pagination})
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintRaw(bz)
+}
+
+// decodeStateKey decodes a contract state key in hex, base64 or cw-storage-plus namespace form
+func decodeStateKey(s, encoding string) ([]byte, error) {
+	switch encoding {
+	case "hex":
+		return hex.DecodeString(s)
+	case "base64":
+		return base64.StdEncoding.DecodeString(s)
+	case "namespace":
+		if len(s) > math.MaxUint16 {
+			return nil, errors.New("namespace too long")
+		}
+		return cwstorage.MapPrefix(s), nil
+	default:
+		return nil, fmt.Errorf("unsupported key encoding: %q", encoding)
+	}
+}
+
 func GetCmdGetContractStateRaw() *cobra.Command {
 	decoder := newArgDecoder(hex.DecodeString)
 	cmd := &cobra.Command{
 		Use:   "raw [bech32_address] [key]",
 		Short: "Prints out internal state for key of a contract given its address",
-		Long:  "Prints out internal state for of a contract given its address",
-		Args:  cobra.ExactArgs(2),
+		Long: fmt.Sprintf(`Prints out internal state for of a contract given its address.
+With --%s the value is returned with the ICS23 Merkle proof of its presence or absence. The proof can be verified
+against the app hash in the block header at height+1.`, flagProve),
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			_, err = sdk.AccAddressFromBech32(args[0])
+			contractAddr, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
@@ -390,6 +522,21 @@ func GetCmdGetContractStateRaw() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			prove, err := cmd.Flags().GetBool(flagProve)
+			if err != nil {
+				return err
+			}
+			if prove {
+				res, err := proof.QueryContractState(clientCtx, contractAddr, queryData)
+				if err != nil {
+					return err
+				}
+				bz, err := json.Marshal(res)
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintRaw(bz)
+			}
 
 			queryClient := types.NewQueryClient(clientCtx)
 			res, err := queryClient.RawContractState(
@@ -407,6 +554,7 @@ func GetCmdGetContractStateRaw() *cobra.Command {
 		SilenceUsage: true,
 	}
 	decoder.RegisterFlags(cmd.PersistentFlags(), "key argument")
+	cmd.Flags().Bool(flagProve, false, "Return the value with a Merkle proof")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -582,6 +730,651 @@ func GetCmdListContractsByCreator() *cobra.Command {
 	return cmd
 }
 
+// GetCmdListFrozenContracts lists all frozen contracts
+func GetCmdListFrozenContracts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen",
+		Short: "List all frozen contracts",
+		Long:  "List all frozen contracts",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FrozenContracts(
+				context.Background(),
+				&types.QueryFrozenContractsRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list frozen contracts")
+	return cmd
+}
+
+// GetCmdListCronSchedules lists all scheduled contract calls with their last run
+func GetCmdListCronSchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cron-schedules",
+		Short: "List all scheduled contract calls",
+		Long:  "List all scheduled contract calls with the height and result of their last run",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CronSchedules(
+				context.Background(),
+				&types.QueryCronSchedulesRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list cron schedules")
+	return cmd
+}
+
+// GetCmdGetCronSchedule gets a scheduled contract call with its last run
+func GetCmdGetCronSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cron-schedule [name]",
+		Short: "Get a scheduled contract call",
+		Long:  "Get a scheduled contract call with the height and result of its last run",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CronSchedule(
+				context.Background(),
+				&types.QueryCronScheduleRequest{
+					Name: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdListContractCallbacks lists the pending callbacks of a contract
+func GetCmdListContractCallbacks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-callbacks [bech32_address]",
+		Short: "List the pending callbacks of a contract",
+		Long:  "List the callbacks that a contract scheduled to itself and that were not executed yet",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractCallbacks(
+				context.Background(),
+				&types.QueryContractCallbacksRequest{
+					Address:    args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list contract callbacks")
+	return cmd
+}
+
+// GetCmdListFeeSponsorships lists all contracts that pay the tx fees for calls to them
+func GetCmdListFeeSponsorships() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-sponsorships",
+		Short: "List all fee sponsorships",
+		Long:  "List all contracts that pay the tx fees for calls to them with their balances and limits",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FeeSponsorships(
+				context.Background(),
+				&types.QueryFeeSponsorshipsRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list fee sponsorships")
+	return cmd
+}
+
+// GetCmdGetFeeSponsorship gets the fee sponsorship of a contract
+func GetCmdGetFeeSponsorship() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-sponsorship [bech32_address]",
+		Short: "Get the fee sponsorship of a contract",
+		Long:  "Get the fee sponsorship of a contract with the balance, the limits and the fees paid within the last block",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FeeSponsorship(
+				context.Background(),
+				&types.QueryFeeSponsorshipRequest{
+					Address: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdListFeeSponsorshipUsages lists the total fees that a contract paid per sender
+func GetCmdListFeeSponsorshipUsages() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-sponsorship-usages [bech32_address]",
+		Short: "List the total fees that a contract paid per sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FeeSponsorshipUsages(
+				context.Background(),
+				&types.QueryFeeSponsorshipUsagesRequest{
+					Address:    args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list fee sponsorship usages")
+	return cmd
+}
+
+// GetCmdListMigrationApprovals lists the pending migrations of a contract with an admin set
+func GetCmdListMigrationApprovals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migration-approvals [bech32_address]",
+		Short: "List the pending migrations of a contract with an admin set",
+		Long:  "List the pending migrations of a contract with an admin set and the members that approved them",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MigrationApprovals(
+				context.Background(),
+				&types.QueryMigrationApprovalsRequest{
+					Address:    args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list migration approvals")
+	return cmd
+}
+
+// GetCmdListContractsByAdmin lists all contracts by admin
+func GetCmdListContractsByAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-contracts-by-admin [admin]",
+		Short: "List all contracts by admin or admin set member",
+		Long:  "List all contracts by admin or admin set member",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractsByAdmin(
+				context.Background(),
+				&types.QueryContractsByAdminRequest{
+					AdminAddress: args[0],
+					Pagination:   pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list contracts by admin")
+	return cmd
+}
+
+// GetCmdListContractsByLabel lists all contracts by label or label prefix
+func GetCmdListContractsByLabel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-contracts-by-label [label]",
+		Short: "List all contracts with the label or a label prefix",
+		Long:  "List all contracts with the label or, with the prefix flag, all contracts with labels starting with the given prefix",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			prefixMatch, err := cmd.Flags().GetBool(flagLabelPrefix)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractsByLabel(
+				context.Background(),
+				&types.QueryContractsByLabelRequest{
+					Label:       args[0],
+					PrefixMatch: prefixMatch,
+					Pagination:  pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().Bool(flagLabelPrefix, false, "Match all labels starting with the given label")
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list contracts by label")
+	return cmd
+}
+
+// GetCmdListCodesByChecksum lists all code ids that stored the wasm code with the checksum
+func GetCmdListCodesByChecksum() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-codes-by-checksum [checksum]",
+		Short: "List all code ids with the hex encoded wasm checksum",
+		Long:  "List all code ids with the hex encoded wasm checksum",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if _, err := hex.DecodeString(args[0]); err != nil {
+				return fmt.Errorf("checksum: %w", err)
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CodesByChecksum(
+				context.Background(),
+				&types.QueryCodesByChecksumRequest{
+					Checksum:   args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list codes by checksum")
+	return cmd
+}
+
+// GetCmdListCodesByCreator lists all code ids stored by the creator
+func GetCmdListCodesByCreator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-codes-by-creator [creator]",
+		Short: "List all code ids stored by the creator",
+		Long:  "List all code ids stored by the creator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CodesByCreator(
+				context.Background(),
+				&types.QueryCodesByCreatorRequest{
+					CreatorAddress: args[0],
+					Pagination:     pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "list codes by creator")
+	return cmd
+}
+
+// GetCmdGetContractDependencies lists the recorded calls between a contract and other contracts
+func GetCmdGetContractDependencies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contract-dependencies [bech32_address]",
+		Short: "List the recorded calls of a contract to other contracts",
+		Long: "List the recorded calls of a contract to other contracts or, with the callers flag, from other contracts. " +
+			"Calls are only recorded by nodes with contract dependency tracking enabled.",
+		Aliases: []string{"dependencies"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			callers, err := cmd.Flags().GetBool(flagCallers)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractDependencies(
+				context.Background(),
+				&types.QueryContractDependenciesRequest{
+					Address:    args[0],
+					Callers:    callers,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	cmd.Flags().Bool(flagCallers, false, "List the calls from other contracts to the contract")
+	flags.AddQueryFlagsToCmd(cmd)
+	addPaginationFlags(cmd, "contract dependencies")
+	return cmd
+}
+
+// GetCmdGetContractMetadata shows the metadata of a contract
+func GetCmdGetContractMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "contract-metadata [bech32_address]",
+		Short:   "Shows the metadata of a contract",
+		Long:    "Shows the metadata that the creator or admin set for a contract",
+		Aliases: []string{"metadata"},
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContractMetadata(
+				context.Background(),
+				&types.QueryContractMetadataRequest{
+					Address: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryGasCosts shows the gas costs that are charged for wasm operations
+func GetCmdQueryGasCosts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-costs",
+		Short: "Shows the gas costs that are charged for wasm operations",
+		Long:  "Shows the gas costs of the module params or, when not set, of the gas register that the node was configured with",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.GasCosts(
+				context.Background(),
+				&types.QueryGasCostsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdBatchContractStateSmart calls multiple contracts with the queries from a JSON lines file
+func GetCmdBatchContractStateSmart() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "smart-batch [jsonl_file]",
+		Short: "Calls contracts with the queries from a JSON lines file and prints the result of each query",
+		Long: `Calls contracts with the queries from a JSON lines file and prints the result of each query.
+Each line holds one query: {"address": "<bech32_address>", "query": {...}}
+All queries share the gas limit of a single smart query on the node.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queries, err := readBatchQueries(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BatchSmartContractState(
+				context.Background(),
+				&types.QueryBatchSmartContractStateRequest{Queries: queries},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+		SilenceUsage: true,
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// readBatchQueries parses the smart queries from a JSON lines file. Empty lines are skipped.
+func readBatchQueries(file string) ([]types.QuerySmartContractStateRequest, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var queries []types.QuerySmartContractStateRequest
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 1<<20)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var q struct {
+			Address string          `json:"address"`
+			Query   json.RawMessage `json:"query"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &q); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(q.Address); err != nil {
+			return nil, fmt.Errorf("line %d: address: %w", line, err)
+		}
+		if len(q.Query) == 0 {
+			return nil, fmt.Errorf("line %d: query data must not be empty", line)
+		}
+		queries = append(queries, types.QuerySmartContractStateRequest{Address: q.Address, QueryData: types.RawContractMessage(q.Query)})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(queries) == 0 {
+		return nil, errors.New("no queries in file")
+	}
+	return queries, nil
+}
+
 type argumentDecoder struct {
 	// dec is the default decoder
 	dec                func(string) ([]byte, error)
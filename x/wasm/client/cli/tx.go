@@ -48,6 +48,18 @@ const (
 	flagNoTokenTransfer           = "no-token-transfer"
 	flagAuthority                 = "authority"
 	flagExpedite                  = "expedite"
+	flagChecksum                  = "checksum"
+	flagSkipPurgeHook             = "skip-purge-hook"
+	flagInterval                  = "interval"
+	flagGasLimit                  = "gas-limit"
+	flagMaxFeePerSender           = "max-fee-per-sender"
+	flagMaxFeePerBlock            = "max-fee-per-block"
+	flagMetadataName              = "name"
+	flagMetadataDescription       = "description"
+	flagMetadataHomepage          = "homepage"
+	flagMetadataAuditLink         = "audit-link"
+	flagMetadataSchemaURI         = "schema-uri"
+	flagMetadataSchemaHash        = "schema-hash"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -72,6 +84,16 @@ func GetTxCmd() *cobra.Command {
 		UpdateInstantiateConfigCmd(),
 		SubmitProposalCmd(),
 		UpdateContractLabelCmd(),
+		FreezeContractCmd(),
+		UnfreezeContractCmd(),
+		PurgeContractCmd(),
+		SetFeeSponsorshipCmd(),
+		FundFeeSponsorshipCmd(),
+		RemoveFeeSponsorshipCmd(),
+		UpdateExecuteConfigCmd(),
+		UpdateContractAdminSetCmd(),
+		ApproveMigrationCmd(),
+		SetContractMetadataCmd(),
 	)
 	return txCmd
 }
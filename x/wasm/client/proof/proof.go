@@ -0,0 +1,87 @@
+// Package proof provides verifiable reads of raw contract state. A value or its absence is proven with the ICS23
+// commitment proofs of the wasm store and the multistore against the app hash of a block header.
+package proof
+
+import (
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	"cosmossdk.io/store/rootmulti"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// ContractStateProof is a raw contract state value with the Merkle proof of its presence or absence at a height
+type ContractStateProof struct {
+	// Height of the state. The app hash that commits this state is in the header of the block at Height+1.
+	Height int64 `json:"height"`
+	// Contract is the bech32 address of the contract
+	Contract string `json:"contract"`
+	// Key is the raw key in the contract store
+	Key []byte `json:"key"`
+	// Value is the raw value or empty when the key does not exist
+	Value []byte `json:"value,omitempty"`
+	// ProofOps are the ICS23 proofs from the key to the app hash
+	ProofOps *cmtcrypto.ProofOps `json:"proof_ops"`
+}
+
+// QueryContractState reads a raw contract state value with its proof from the node. The height of the client
+// context is used when set, otherwise the latest height.
+func QueryContractState(clientCtx client.Context, contractAddr sdk.AccAddress, key []byte) (*ContractStateProof, error) {
+	res, err := clientCtx.QueryABCI(abci.RequestQuery{
+		Path:  fmt.Sprintf("/store/%s/key", types.StoreKey),
+		Data:  StoreKey(contractAddr, key),
+		Prove: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.ProofOps == nil || len(res.ProofOps.Ops) == 0 {
+		return nil, errors.New("node did not return a proof")
+	}
+	return &ContractStateProof{
+		Height:   res.Height,
+		Contract: contractAddr.String(),
+		Key:      key,
+		Value:    res.Value,
+		ProofOps: res.ProofOps,
+	}, nil
+}
+
+// Verify checks the proof against the app hash. The app hash must be taken from a trusted header of the block at
+// Height+1.
+func (p ContractStateProof) Verify(appHash []byte) error {
+	if p.ProofOps == nil {
+		return errors.New("empty proof")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(p.Contract)
+	if err != nil {
+		return fmt.Errorf("contract: %w", err)
+	}
+	keyPath := KeyPath(contractAddr, p.Key)
+	prt := rootmulti.DefaultProofRuntime()
+	if len(p.Value) == 0 {
+		return prt.VerifyAbsence(p.ProofOps, appHash, keyPath)
+	}
+	return prt.VerifyValue(p.ProofOps, appHash, keyPath, p.Value)
+}
+
+// StoreKey returns the key of a raw contract state entry in the wasm store
+func StoreKey(contractAddr sdk.AccAddress, key []byte) []byte {
+	return append(types.GetContractStorePrefix(contractAddr), key...)
+}
+
+// KeyPath returns the Merkle key path of a raw contract state entry from the multistore root
+func KeyPath(contractAddr sdk.AccAddress, key []byte) string {
+	return merkle.KeyPath{}.
+		AppendKey([]byte(types.StoreKey), merkle.KeyEncodingURL).
+		AppendKey(StoreKey(contractAddr, key), merkle.KeyEncodingHex).
+		String()
+}
@@ -0,0 +1,120 @@
+package proof
+
+import (
+	"bytes"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestVerifyContractStateProof(t *testing.T) {
+	contractAddr := sdk.AccAddress(bytes.Repeat([]byte{1}, types.ContractAddrLen))
+	otherContractAddr := sdk.AccAddress(bytes.Repeat([]byte{2}, types.ContractAddrLen))
+
+	store := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	wasmStoreKey := storetypes.NewKVStoreKey(types.StoreKey)
+	otherStoreKey := storetypes.NewKVStoreKey("other")
+	store.MountStoreWithDB(wasmStoreKey, storetypes.StoreTypeIAVL, nil)
+	store.MountStoreWithDB(otherStoreKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadLatestVersion())
+	store.GetCommitKVStore(wasmStoreKey).Set(StoreKey(contractAddr, []byte("foo")), []byte("bar"))
+	store.GetCommitKVStore(otherStoreKey).Set([]byte("foo"), []byte("bar"))
+	appHash := store.Commit().Hash
+
+	query := func(contractAddr sdk.AccAddress, key []byte) ContractStateProof {
+		res, err := store.Query(&storetypes.RequestQuery{
+			Path:  "/" + types.StoreKey + "/key",
+			Data:  StoreKey(contractAddr, key),
+			Prove: true,
+		})
+		require.NoError(t, err)
+		return ContractStateProof{
+			Height:   res.Height,
+			Contract: contractAddr.String(),
+			Key:      key,
+			Value:    res.Value,
+			ProofOps: res.ProofOps,
+		}
+	}
+	specs := map[string]struct {
+		src     func() ContractStateProof
+		appHash []byte
+		expErr  bool
+	}{
+		"existing value": {
+			src:     func() ContractStateProof { return query(contractAddr, []byte("foo")) },
+			appHash: appHash,
+		},
+		"absent key": {
+			src:     func() ContractStateProof { return query(contractAddr, []byte("other")) },
+			appHash: appHash,
+		},
+		"absent key of other contract": {
+			src:     func() ContractStateProof { return query(otherContractAddr, []byte("foo")) },
+			appHash: appHash,
+		},
+		"modified value": {
+			src: func() ContractStateProof {
+				p := query(contractAddr, []byte("foo"))
+				p.Value = []byte("baz")
+				return p
+			},
+			appHash: appHash,
+			expErr:  true,
+		},
+		"value claimed absent": {
+			src: func() ContractStateProof {
+				p := query(contractAddr, []byte("foo"))
+				p.Value = nil
+				return p
+			},
+			appHash: appHash,
+			expErr:  true,
+		},
+		"other contract": {
+			src: func() ContractStateProof {
+				p := query(contractAddr, []byte("foo"))
+				p.Contract = otherContractAddr.String()
+				return p
+			},
+			appHash: appHash,
+			expErr:  true,
+		},
+		"other app hash": {
+			src:     func() ContractStateProof { return query(contractAddr, []byte("foo")) },
+			appHash: bytes.Repeat([]byte{1}, 32),
+			expErr:  true,
+		},
+		"no proof": {
+			src: func() ContractStateProof {
+				p := query(contractAddr, []byte("foo"))
+				p.ProofOps = nil
+				return p
+			},
+			appHash: appHash,
+			expErr:  true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// when
+			gotErr := spec.src().Verify(spec.appHash)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
@@ -0,0 +1,175 @@
+// Package cwstorage decodes raw contract state that was written with the cw-storage-plus library into readable JSON.
+//
+// The keys are not self describing, so the decoder follows the layout conventions of the library:
+//   - an Item is stored under its namespace
+//   - a Map entry is stored under the length prefixed namespace, followed by the length prefixed elements of a
+//     composite key and the raw last key element
+//   - the indexes of an IndexedMap are Maps in namespaces named "<namespace>__<index>". A multi index appends the
+//     raw primary key to the index key and stores the primary key length as value.
+//
+// Keys that do not match any layout are returned as raw bytes.
+package cwstorage
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// KeyKind is the storage type that a key belongs to
+type KeyKind string
+
+const (
+	KindItem  KeyKind = "item"
+	KindMap   KeyKind = "map"
+	KindIndex KeyKind = "index"
+	KindRaw   KeyKind = "raw"
+)
+
+// indexSeparator separates the namespace of an IndexedMap from the index name
+const indexSeparator = "__"
+
+// Key is a decoded contract state key
+type Key struct {
+	Kind KeyKind `json:"kind"`
+	// Namespace of the Item or Map. For an index, the namespace of the IndexedMap.
+	Namespace string `json:"namespace,omitempty"`
+	// Index is the index name of an IndexedMap index entry
+	Index string `json:"index,omitempty"`
+	// Elements are the elements of a Map key
+	Elements []KeyElement `json:"elements,omitempty"`
+	// PrimaryKey is the primary key of a multi index entry
+	PrimaryKey *KeyElement `json:"primary_key,omitempty"`
+	// Hex is the full key as stored
+	Hex string `json:"hex"`
+}
+
+// KeyElement is a single element of a Map key
+type KeyElement struct {
+	Hex string `json:"hex"`
+	// String is set when the element is printable text
+	String string `json:"string,omitempty"`
+	// Uint is set when the element is not text and has the length of a big endian unsigned integer
+	Uint string `json:"uint,omitempty"`
+}
+
+// Entry is a decoded contract state entry
+type Entry struct {
+	Key   Key             `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+// Decode decodes a contract state entry. The value is used to find the primary key of multi index entries.
+func Decode(key, value []byte) Entry {
+	return Entry{
+		Key:   decodeKey(key, value),
+		Value: DecodeValue(value),
+	}
+}
+
+// DecodeKey decodes a contract state key
+func DecodeKey(key []byte) Key {
+	return decodeKey(key, nil)
+}
+
+func decodeKey(key, value []byte) Key {
+	namespace, rest, ok := splitNamespace(key)
+	if !ok {
+		if isPrintable(key) {
+			return Key{Kind: KindItem, Namespace: string(key), Hex: hex.EncodeToString(key)}
+		}
+		return Key{Kind: KindRaw, Hex: hex.EncodeToString(key)}
+	}
+	result := Key{Kind: KindMap, Namespace: namespace, Hex: hex.EncodeToString(key)}
+	rawLast := true
+	if pos := strings.LastIndex(namespace, indexSeparator); pos > 0 && pos+len(indexSeparator) < len(namespace) {
+		result.Kind = KindIndex
+		result.Namespace, result.Index = namespace[:pos], namespace[pos+len(indexSeparator):]
+		var pkLen uint32
+		if err := json.Unmarshal(value, &pkLen); err == nil && pkLen > 0 && int(pkLen) < len(rest) {
+			pk := newKeyElement(rest[len(rest)-int(pkLen):])
+			result.PrimaryKey = &pk
+			rest = rest[:len(rest)-int(pkLen)]
+			rawLast = false
+		}
+	}
+	result.Elements = splitElements(rest, rawLast)
+	return result
+}
+
+// MapPrefix returns the key prefix of all entries of the Map with the given namespace
+func MapPrefix(namespace string) []byte {
+	return append(binary.BigEndian.AppendUint16(nil, uint16(len(namespace))), namespace...)
+}
+
+// DecodeValue returns the value when it is JSON, as cw-storage-plus stores it, otherwise the hex encoded bytes
+func DecodeValue(value []byte) json.RawMessage {
+	if len(value) != 0 && json.Valid(value) {
+		return value
+	}
+	bz, err := json.Marshal(struct {
+		Hex string `json:"hex"`
+	}{Hex: hex.EncodeToString(value)})
+	if err != nil {
+		panic(err) // can not happen
+	}
+	return bz
+}
+
+// splitNamespace returns the length prefixed namespace of a Map key and the remaining bytes
+func splitNamespace(key []byte) (string, []byte, bool) {
+	if len(key) < 2 {
+		return "", nil, false
+	}
+	n := int(binary.BigEndian.Uint16(key))
+	if n == 0 || 2+n > len(key) || !isPrintable(key[2:2+n]) {
+		return "", nil, false
+	}
+	return string(key[2 : 2+n]), key[2+n:], true
+}
+
+// splitElements splits the remaining bytes of a Map key into the length prefixed elements. The last element is raw
+// unless rawLast is false.
+func splitElements(rest []byte, rawLast bool) []KeyElement {
+	var elements []KeyElement
+	for len(rest) > 2 {
+		n := int(binary.BigEndian.Uint16(rest))
+		// a raw last element must not be empty
+		if n == 0 || 2+n > len(rest) || (rawLast && 2+n == len(rest)) {
+			break
+		}
+		elements = append(elements, newKeyElement(rest[2:2+n]))
+		rest = rest[2+n:]
+	}
+	if len(rest) != 0 {
+		elements = append(elements, newKeyElement(rest))
+	}
+	return elements
+}
+
+func newKeyElement(bz []byte) KeyElement {
+	e := KeyElement{Hex: hex.EncodeToString(bz)}
+	switch {
+	case isPrintable(bz):
+		e.String = string(bz)
+	case len(bz) == 1 || len(bz) == 2 || len(bz) == 4 || len(bz) == 8 || len(bz) == 16:
+		e.Uint = new(big.Int).SetBytes(bz).String()
+	}
+	return e
+}
+
+func isPrintable(bz []byte) bool {
+	if len(bz) == 0 || !utf8.Valid(bz) {
+		return false
+	}
+	for _, r := range string(bz) {
+		if !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,77 @@
+package cwstorage
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecode(t *testing.T) {
+	specs := map[string]struct {
+		key      []byte
+		value    []byte
+		expKey   Key
+		expValue string
+	}{
+		"item": {
+			key:      []byte("config"),
+			value:    []byte(`{"owner":"foo"}`),
+			expKey:   Key{Kind: KindItem, Namespace: "config"},
+			expValue: `{"owner":"foo"}`,
+		},
+		"map with string key": {
+			key:      []byte("\x00\x08balancesalice"),
+			value:    []byte(`"100"`),
+			expKey:   Key{Kind: KindMap, Namespace: "balances", Elements: []KeyElement{{Hex: "616c696365", String: "alice"}}},
+			expValue: `"100"`,
+		},
+		"map with integer key": {
+			key:      []byte("\x00\x06tokens\x00\x00\x00\x00\x00\x00\x00\x07"),
+			value:    []byte(`{}`),
+			expKey:   Key{Kind: KindMap, Namespace: "tokens", Elements: []KeyElement{{Hex: "0000000000000007", Uint: "7"}}},
+			expValue: `{}`,
+		},
+		"map with composite key": {
+			key:      []byte("\x00\x09allowance\x00\x05alicebob"),
+			value:    []byte(`"1"`),
+			expKey:   Key{Kind: KindMap, Namespace: "allowance", Elements: []KeyElement{{Hex: "616c696365", String: "alice"}, {Hex: "626f62", String: "bob"}}},
+			expValue: `"1"`,
+		},
+		"multi index": {
+			key:   []byte("\x00\x0dtokens__owner\x00\x05alicetoken1"),
+			value: []byte(`6`),
+			expKey: Key{
+				Kind: KindIndex, Namespace: "tokens", Index: "owner",
+				Elements:   []KeyElement{{Hex: "616c696365", String: "alice"}},
+				PrimaryKey: &KeyElement{Hex: "746f6b656e31", String: "token1"},
+			},
+			expValue: `6`,
+		},
+		"unique index": {
+			key:   []byte("\x00\x0etokens__serialS1"),
+			value: []byte(`{"pk":"dG9rZW4x","value":{}}`),
+			expKey: Key{
+				Kind: KindIndex, Namespace: "tokens", Index: "serial",
+				Elements: []KeyElement{{Hex: "5331", String: "S1"}},
+			},
+			expValue: `{"pk":"dG9rZW4x","value":{}}`,
+		},
+		"raw key and value": {
+			key:      []byte{0x00, 0x01},
+			value:    []byte{0xff},
+			expKey:   Key{Kind: KindRaw},
+			expValue: `{"hex":"ff"}`,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// when
+			got := Decode(spec.key, spec.value)
+			// then
+			spec.expKey.Hex = hex.EncodeToString(spec.key)
+			assert.Equal(t, spec.expKey, got.Key)
+			assert.JSONEq(t, spec.expValue, string(got.Value))
+		})
+	}
+}
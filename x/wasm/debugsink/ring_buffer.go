@@ -0,0 +1,128 @@
+package debugsink
+
+import (
+	"fmt"
+	"net"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+var (
+	_ types.DebugSink           = &RingBuffer{}
+	_ types.ContractDebugServer = &RingBuffer{}
+)
+
+// RingBuffer keeps the most recent records in memory and serves them with the ContractDebug gRPC service
+type RingBuffer struct {
+	mx      sync.Mutex
+	records []types.DebugRecord
+	// next is the position of the next record in the buffer
+	next int
+	full bool
+	// written is closed and replaced on every write to notify the followers
+	written chan struct{}
+}
+
+// NewRingBuffer constructor
+func NewRingBuffer(size int) *RingBuffer {
+	if size <= 0 {
+		panic("size must be positive")
+	}
+	return &RingBuffer{records: make([]types.DebugRecord, size), written: make(chan struct{})}
+}
+
+// Write adds the record and drops the oldest record when the buffer is full
+func (b *RingBuffer) Write(record types.DebugRecord) error {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+	b.records[b.next] = record
+	b.next = (b.next + 1) % len(b.records)
+	b.full = b.full || b.next == 0
+	close(b.written)
+	b.written = make(chan struct{})
+	return nil
+}
+
+// Records returns the buffered records with a sequence greater than the given one, oldest first. The returned channel
+// is closed on the next write.
+func (b *RingBuffer) Records(afterSequence uint64) ([]types.DebugRecord, <-chan struct{}) {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+	ordered := b.records[:b.next]
+	if b.full {
+		ordered = append(append([]types.DebugRecord{}, b.records[b.next:]...), b.records[:b.next]...)
+	}
+	var result []types.DebugRecord
+	for _, r := range ordered {
+		if r.Sequence > afterSequence {
+			result = append(result, r)
+		}
+	}
+	return result, b.written
+}
+
+// Tail streams the buffered records of the request and the new records when follow is set. Records that were dropped
+// from the buffer before they were sent are skipped.
+func (b *RingBuffer) Tail(req *types.TailDebugRecordsRequest, stream types.ContractDebug_TailServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+	var contractAddr string
+	if req.ContractAddress != "" {
+		addr, err := sdk.AccAddressFromBech32(req.ContractAddress)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, "contract address")
+		}
+		contractAddr = addr.String()
+	}
+	var sent uint64
+	for {
+		records, written := b.Records(sent)
+		for i := range records {
+			sent = records[i].Sequence
+			if contractAddr != "" && records[i].ContractAddress != contractAddr {
+				continue
+			}
+			if err := stream.Send(&records[i]); err != nil {
+				return err
+			}
+		}
+		if !req.Follow {
+			return nil
+		}
+		select {
+		case <-written:
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		}
+	}
+}
+
+// Serve serves the ContractDebug gRPC service of the ring buffer on the address in the background. Only loopback
+// addresses are accepted so that the debug output is not exposed outside the host.
+func Serve(addr string, b *RingBuffer) (*grpc.Server, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("contract debug address: %w", err)
+	}
+	if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		return nil, fmt.Errorf("contract debug address must be a loopback address: %s", addr)
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("listen on contract debug address: %w", err)
+	}
+	srv := grpc.NewServer()
+	types.RegisterContractDebugServer(srv, b)
+	go func() {
+		_ = srv.Serve(listener)
+	}()
+	return srv, nil
+}
@@ -0,0 +1,170 @@
+package debugsink
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestRingBufferRecords(t *testing.T) {
+	b := NewRingBuffer(3)
+	got, _ := b.Records(0)
+	assert.Empty(t, got)
+
+	// when
+	for i := 1; i <= 4; i++ {
+		require.NoError(t, b.Write(types.DebugRecord{Sequence: uint64(i)}))
+	}
+
+	// then the oldest record was dropped
+	got, _ = b.Records(0)
+	assert.Equal(t, []uint64{2, 3, 4}, sequences(got))
+	got, _ = b.Records(3)
+	assert.Equal(t, []uint64{4}, sequences(got))
+
+	// and the followers are notified on write
+	_, written := b.Records(4)
+	select {
+	case <-written:
+		t.Fatal("notified before write")
+	default:
+	}
+	require.NoError(t, b.Write(types.DebugRecord{Sequence: 5}))
+	select {
+	case <-written:
+	default:
+		t.Fatal("not notified")
+	}
+}
+
+func TestRingBufferTail(t *testing.T) {
+	myContract, otherContract := sdk.AccAddress("myContract").String(), sdk.AccAddress("otherContract").String()
+	b := NewRingBuffer(10)
+	require.NoError(t, b.Write(types.DebugRecord{Sequence: 1, ContractAddress: myContract}))
+	require.NoError(t, b.Write(types.DebugRecord{Sequence: 2, ContractAddress: otherContract}))
+
+	specs := map[string]struct {
+		req    *types.TailDebugRecordsRequest
+		expSeq []uint64
+		expErr bool
+	}{
+		"all": {
+			req:    &types.TailDebugRecordsRequest{},
+			expSeq: []uint64{1, 2},
+		},
+		"by contract": {
+			req:    &types.TailDebugRecordsRequest{ContractAddress: otherContract},
+			expSeq: []uint64{2},
+		},
+		"invalid contract": {
+			req:    &types.TailDebugRecordsRequest{ContractAddress: "invalid"},
+			expErr: true,
+		},
+		"empty request": {
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			stream := &mockTailStream{ctx: context.Background()}
+			// when
+			err := b.Tail(spec.req, stream)
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expSeq, sequences(stream.sent))
+		})
+	}
+}
+
+func TestRingBufferTailFollow(t *testing.T) {
+	b := NewRingBuffer(10)
+	require.NoError(t, b.Write(types.DebugRecord{Sequence: 1}))
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &mockTailStream{ctx: ctx, onSend: make(chan struct{}, 10)}
+	done := make(chan error)
+	go func() {
+		done <- b.Tail(&types.TailDebugRecordsRequest{Follow: true}, stream)
+	}()
+	waitForSend(t, stream)
+
+	// when
+	require.NoError(t, b.Write(types.DebugRecord{Sequence: 2}))
+	waitForSend(t, stream)
+	cancel()
+
+	// then
+	require.Error(t, <-done)
+	assert.Equal(t, []uint64{1, 2}, sequences(stream.sent))
+}
+
+func TestServe(t *testing.T) {
+	specs := map[string]struct {
+		addr   string
+		expErr bool
+	}{
+		"loopback ip": {addr: "127.0.0.1:0"},
+		"localhost":   {addr: "localhost:0"},
+		"any":         {addr: ":0", expErr: true},
+		"public ip":   {addr: "0.0.0.0:0", expErr: true},
+		"no port":     {addr: "127.0.0.1", expErr: true},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			srv, err := Serve(spec.addr, NewRingBuffer(1))
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			srv.Stop()
+		})
+	}
+}
+
+func sequences(records []types.DebugRecord) []uint64 {
+	var r []uint64
+	for _, v := range records {
+		r = append(r, v.Sequence)
+	}
+	return r
+}
+
+func waitForSend(t *testing.T, stream *mockTailStream) {
+	t.Helper()
+	select {
+	case <-stream.onSend:
+	case <-time.After(time.Second):
+		t.Fatal("timeout")
+	}
+}
+
+type mockTailStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	sent   []types.DebugRecord
+	onSend chan struct{}
+}
+
+func (m *mockTailStream) Send(r *types.DebugRecord) error {
+	m.sent = append(m.sent, *r)
+	if m.onSend != nil {
+		m.onSend <- struct{}{}
+	}
+	return nil
+}
+
+func (m *mockTailStream) Context() context.Context {
+	return m.ctx
+}
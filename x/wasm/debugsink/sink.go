@@ -0,0 +1,171 @@
+// Package debugsink provides the sinks of the contract debug output that a node writes in contract debug mode: JSON
+// lines on stdout, a rotating file or an in-memory ring buffer that can be tailed over a gRPC endpoint on a local
+// address.
+//
+// The debug output are the call trees of the top level contract calls. Messages that contracts print with
+// `deps.api.debug` are written to stdout by wasmvm directly and are not part of the sink output.
+package debugsink
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+const (
+	// FileName is the name of the debug output file in the node data directory
+	FileName = "wasm_debug.jsonl"
+	// DefaultMaxFileSize is the size in bytes at which the debug output file is rotated
+	DefaultMaxFileSize = 100 << 20
+	// DefaultMaxBackups is the number of rotated debug output files that are kept
+	DefaultMaxBackups = 3
+	// DefaultRingBufferSize is the number of records that the in-memory sink keeps
+	DefaultRingBufferSize = 1000
+)
+
+// New returns the debug sink of the wasm config. The file sink writes into the data directory of the node home.
+func New(homeDir string, cfg types.WasmConfig) (types.DebugSink, error) {
+	switch cfg.ContractDebugSink {
+	case "", types.ContractDebugSinkStdout:
+		return NewJSONLines(os.Stdout), nil
+	case types.ContractDebugSinkFile:
+		return OpenRotatingFile(filepath.Join(homeDir, "data", FileName), DefaultMaxFileSize, DefaultMaxBackups)
+	case types.ContractDebugSinkMemory:
+		return NewRingBuffer(DefaultRingBufferSize), nil
+	default:
+		return nil, fmt.Errorf("unknown contract debug sink: %q", cfg.ContractDebugSink)
+	}
+}
+
+var _ types.DebugSink = &JSONLines{}
+
+// JSONLines writes each record as a line of JSON
+type JSONLines struct {
+	w io.Writer
+}
+
+// NewJSONLines constructor
+func NewJSONLines(w io.Writer) *JSONLines {
+	if w == nil {
+		panic("writer must not be nil")
+	}
+	return &JSONLines{w: w}
+}
+
+// Write writes the record as a line of JSON
+func (s *JSONLines) Write(record types.DebugRecord) error {
+	bz, err := marshalLine(record)
+	if err != nil {
+		return err
+	}
+	_, err = s.w.Write(bz)
+	return err
+}
+
+var _ types.DebugSink = &RotatingFile{}
+
+// RotatingFile writes each record as a line of JSON into a file. The file is rotated before it exceeds the max size:
+// the file is renamed with the suffix ".1" and older files are shifted up to the max number of backups.
+type RotatingFile struct {
+	mx         sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+// OpenRotatingFile opens or creates the file to append the records to
+func OpenRotatingFile(path string, maxSize int64, maxBackups int) (*RotatingFile, error) {
+	if maxSize <= 0 {
+		return nil, fmt.Errorf("max size must be positive")
+	}
+	if maxBackups < 0 {
+		return nil, fmt.Errorf("max backups must not be negative")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("create debug output directory: %w", err)
+	}
+	f := &RotatingFile{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Write appends the record as a line of JSON and rotates the file before when the line does not fit
+func (f *RotatingFile) Write(record types.DebugRecord) error {
+	bz, err := marshalLine(record)
+	if err != nil {
+		return err
+	}
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	if f.size > 0 && f.size+int64(len(bz)) > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := f.file.Write(bz)
+	f.size += int64(n)
+	return err
+}
+
+// Close closes the file
+func (f *RotatingFile) Close() error {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	return f.file.Close()
+}
+
+func (f *RotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("open debug output file: %w", err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("stat debug output file: %w", err)
+	}
+	f.file, f.size = file, info.Size()
+	return nil
+}
+
+func (f *RotatingFile) rotate() error {
+	if err := f.file.Close(); err != nil {
+		return fmt.Errorf("close debug output file: %w", err)
+	}
+	if f.maxBackups == 0 {
+		if err := os.Remove(f.path); err != nil {
+			return fmt.Errorf("remove debug output file: %w", err)
+		}
+		return f.open()
+	}
+	for i := f.maxBackups - 1; i > 0; i-- {
+		if err := os.Rename(backupPath(f.path, i), backupPath(f.path, i+1)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("rotate debug output file: %w", err)
+		}
+	}
+	if err := os.Rename(f.path, backupPath(f.path, 1)); err != nil {
+		return fmt.Errorf("rotate debug output file: %w", err)
+	}
+	return f.open()
+}
+
+func backupPath(path string, i int) string {
+	return fmt.Sprintf("%s.%d", path, i)
+}
+
+func marshalLine(record types.DebugRecord) ([]byte, error) {
+	bz, err := json.Marshal(record)
+	if err != nil {
+		return nil, fmt.Errorf("marshal debug record: %w", err)
+	}
+	return append(bz, '\n'), nil
+}
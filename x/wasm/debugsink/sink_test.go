@@ -0,0 +1,97 @@
+package debugsink
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestNew(t *testing.T) {
+	specs := map[string]struct {
+		sink   string
+		expErr bool
+		expT   any
+	}{
+		"default": {expT: &JSONLines{}},
+		"stdout":  {sink: types.ContractDebugSinkStdout, expT: &JSONLines{}},
+		"file":    {sink: types.ContractDebugSinkFile, expT: &RotatingFile{}},
+		"memory":  {sink: types.ContractDebugSinkMemory, expT: &RingBuffer{}},
+		"unknown": {sink: "other", expErr: true},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			homeDir := t.TempDir()
+			// when
+			got, err := New(homeDir, types.WasmConfig{ContractDebugSink: spec.sink})
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.IsType(t, spec.expT, got)
+			if f, ok := got.(*RotatingFile); ok {
+				require.NoError(t, f.Close())
+				assert.FileExists(t, filepath.Join(homeDir, "data", FileName))
+			}
+		})
+	}
+}
+
+func TestJSONLines(t *testing.T) {
+	var buf bytes.Buffer
+	s := NewJSONLines(&buf)
+
+	// when
+	require.NoError(t, s.Write(types.DebugRecord{Sequence: 1, Height: 2, TxHash: "AB", ContractAddress: "myContract", CodeID: 3, Output: []byte(`{"entrypoint":"execute"}`)}))
+	require.NoError(t, s.Write(types.DebugRecord{Sequence: 2, Output: []byte(`{}`)}))
+
+	// then
+	lines := strings.Split(buf.String(), "\n")
+	require.Len(t, lines, 3)
+	assert.JSONEq(t, `{"sequence":1,"height":2,"tx_hash":"AB","contract_address":"myContract","code_id":3,"output":{"entrypoint":"execute"}}`, lines[0])
+	assert.JSONEq(t, `{"sequence":2,"output":{}}`, lines[1])
+	assert.Empty(t, lines[2])
+}
+
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "debug", "out.jsonl")
+	record := types.DebugRecord{Sequence: 1, Output: []byte(`{}`)}
+	line, err := marshalLine(record)
+	require.NoError(t, err)
+	f, err := OpenRotatingFile(path, int64(2*len(line)), 2)
+	require.NoError(t, err)
+
+	// when
+	for i := 1; i <= 7; i++ {
+		record.Sequence = uint64(i)
+		require.NoError(t, f.Write(record))
+	}
+	require.NoError(t, f.Close())
+
+	// then the two most recent rotations are kept
+	readSequences := func(path string) string {
+		bz, err := os.ReadFile(path)
+		require.NoError(t, err)
+		return string(bz)
+	}
+	assert.Equal(t, `{"sequence":7,"output":{}}`+"\n", readSequences(path))
+	assert.Equal(t, `{"sequence":5,"output":{}}`+"\n"+`{"sequence":6,"output":{}}`+"\n", readSequences(path+".1"))
+	assert.Equal(t, `{"sequence":3,"output":{}}`+"\n"+`{"sequence":4,"output":{}}`+"\n", readSequences(path+".2"))
+	assert.NoFileExists(t, path+".3")
+
+	// and an existing file is appended to
+	f, err = OpenRotatingFile(path, int64(2*len(line)), 2)
+	require.NoError(t, err)
+	record.Sequence = 8
+	require.NoError(t, f.Write(record))
+	require.NoError(t, f.Close())
+	assert.Equal(t, `{"sequence":7,"output":{}}`+"\n"+`{"sequence":8,"output":{}}`+"\n", readSequences(path))
+}
@@ -0,0 +1,195 @@
+// Package eventindex persists the custom contract events of finalized blocks into a database that is local to the
+// node. The index is not part of the consensus state, so that it can be enabled on any node without tx indexing.
+//
+// Each event is stored under its position in the chain, the block height and the sequence within the block. Secondary
+// indexes by contract address, event type, attribute and attribute key point to the position so that queries by height range are
+// served with a single iterator.
+package eventindex
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// DBName is the name of the event index database in the node data directory
+const DBName = "wasm_events"
+
+var (
+	eventKeyPrefix          = []byte{0x01}
+	contractIndexPrefix     = []byte{0x02}
+	typeIndexPrefix         = []byte{0x03}
+	attributeIndexPrefix    = []byte{0x04}
+	attributeKeyIndexPrefix = []byte{0x05}
+)
+
+// positionLen is the length of the height and sequence of an event
+const positionLen = 8 + 4
+
+// maxIndexedLen is the max length of strings in the secondary indexes. Longer attributes are not indexed.
+const maxIndexedLen = 1<<16 - 1
+
+var _ storetypes.ABCIListener = &Indexer{}
+
+// Indexer writes the custom contract events of finalized blocks into the event index database and serves queries
+type Indexer struct {
+	db dbm.DB
+}
+
+// NewIndexer constructor
+func NewIndexer(db dbm.DB) *Indexer {
+	return &Indexer{db: db}
+}
+
+// Open opens or creates the event index database in the given directory
+func Open(dir string, backend dbm.BackendType) (*Indexer, error) {
+	db, err := dbm.NewDB(DBName, backend, dir)
+	if err != nil {
+		return nil, fmt.Errorf("open event index: %w", err)
+	}
+	return NewIndexer(db), nil
+}
+
+// Close closes the database
+func (i *Indexer) Close() error {
+	return i.db.Close()
+}
+
+// ListenFinalizeBlock indexes the custom contract events of successful transactions and of the block. Blocks that are
+// replayed overwrite their events.
+func (i *Indexer) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	batch := i.db.NewBatch()
+	defer batch.Close()
+
+	var seq uint32
+	indexEvents := func(txHash string, events []abci.Event) error {
+		for _, e := range events {
+			event, ok := newIndexedEvent(req.Height, txHash, seq, e)
+			if !ok {
+				continue
+			}
+			if err := writeEvent(batch, event); err != nil {
+				return err
+			}
+			seq++
+		}
+		return nil
+	}
+	for idx, txRes := range res.TxResults {
+		if txRes == nil || txRes.Code != abci.CodeTypeOK || idx >= len(req.Txs) {
+			continue
+		}
+		if err := indexEvents(fmt.Sprintf("%X", cmttypes.Tx(req.Txs[idx]).Hash()), txRes.Events); err != nil {
+			return err
+		}
+	}
+	if err := indexEvents("", res.Events); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// ListenCommit is a no-op as the events are indexed with the block
+func (i *Indexer) ListenCommit(context.Context, abci.ResponseCommit, []*storetypes.StoreKVPair) error {
+	return nil
+}
+
+// newIndexedEvent converts a custom contract event. Returns false for all other events.
+func newIndexedEvent(height int64, txHash string, seq uint32, e abci.Event) (types.IndexedEvent, bool) {
+	if !strings.HasPrefix(e.Type, types.CustomContractEventPrefix) {
+		return types.IndexedEvent{}, false
+	}
+	event := types.IndexedEvent{
+		Height:     height,
+		TxHash:     txHash,
+		Sequence:   seq,
+		Type:       e.Type,
+		Attributes: make([]types.IndexedEventAttribute, 0, len(e.Attributes)),
+	}
+	for _, a := range e.Attributes {
+		if a.Key == types.AttributeKeyContractAddr {
+			event.ContractAddress = a.Value
+		}
+		event.Attributes = append(event.Attributes, types.IndexedEventAttribute{Key: a.Key, Value: a.Value})
+	}
+	return event, event.ContractAddress != ""
+}
+
+func writeEvent(batch dbm.Batch, event types.IndexedEvent) error {
+	bz, err := event.Marshal()
+	if err != nil {
+		return err
+	}
+	pos := positionKey(event.Height, event.Sequence)
+	if err := batch.Set(eventKey(pos), bz); err != nil {
+		return err
+	}
+	if err := batch.Set(append(contractIndexPrefixKey(event.ContractAddress), pos...), []byte{}); err != nil {
+		return err
+	}
+	if len(event.Type) <= maxIndexedLen {
+		if err := batch.Set(append(typeIndexPrefixKey(event.Type), pos...), []byte{}); err != nil {
+			return err
+		}
+	}
+	for _, a := range event.Attributes {
+		if a.Key == types.AttributeKeyContractAddr || len(a.Key) > maxIndexedLen {
+			continue
+		}
+		if err := batch.Set(append(attributeKeyIndexPrefixKey(a.Key), pos...), []byte{}); err != nil {
+			return err
+		}
+		if len(a.Value) > maxIndexedLen {
+			continue
+		}
+		if err := batch.Set(append(attributeIndexPrefixKey(a.Key, a.Value), pos...), []byte{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// positionKey returns the position of an event: `<height><sequence>`
+func positionKey(height int64, seq uint32) []byte {
+	r := binary.BigEndian.AppendUint64(make([]byte, 0, positionLen), uint64(height))
+	return binary.BigEndian.AppendUint32(r, seq)
+}
+
+// eventKey returns the key of an event: `<prefix><height><sequence>`
+func eventKey(pos []byte) []byte {
+	return append(append([]byte{}, eventKeyPrefix...), pos...)
+}
+
+// contractIndexPrefixKey returns the prefix of the events of a contract: `<prefix><address length><address>`
+func contractIndexPrefixKey(contractAddr string) []byte {
+	return appendLengthPrefixed(append([]byte{}, contractIndexPrefix...), contractAddr)
+}
+
+// typeIndexPrefixKey returns the prefix of the events of a type: `<prefix><type length><type>`
+func typeIndexPrefixKey(eventType string) []byte {
+	return appendLengthPrefixed(append([]byte{}, typeIndexPrefix...), eventType)
+}
+
+// attributeIndexPrefixKey returns the prefix of the events with an attribute:
+// `<prefix><key length><key><value length><value>`
+func attributeIndexPrefixKey(key, value string) []byte {
+	return appendLengthPrefixed(appendLengthPrefixed(append([]byte{}, attributeIndexPrefix...), key), value)
+}
+
+// attributeKeyIndexPrefixKey returns the prefix of the events with an attribute key: `<prefix><key length><key>`
+func attributeKeyIndexPrefixKey(key string) []byte {
+	return appendLengthPrefixed(append([]byte{}, attributeKeyIndexPrefix...), key)
+}
+
+func appendLengthPrefixed(bz []byte, s string) []byte {
+	return append(binary.BigEndian.AppendUint16(bz, uint16(len(s))), s...)
+}
@@ -0,0 +1,181 @@
+package eventindex
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestContractEvents(t *testing.T) {
+	contractA := sdk.AccAddress(bytes.Repeat([]byte{1}, types.ContractAddrLen)).String()
+	contractB := sdk.AccAddress(bytes.Repeat([]byte{2}, types.ContractAddrLen)).String()
+	contractEvent := func(typ, contractAddr, key, value string) abci.Event {
+		return abci.Event{Type: typ, Attributes: []abci.EventAttribute{
+			{Key: types.AttributeKeyContractAddr, Value: contractAddr},
+			{Key: key, Value: value},
+		}}
+	}
+	indexer := NewIndexer(dbm.NewMemDB())
+	for height := int64(1); height <= 3; height++ {
+		tx := []byte(fmt.Sprintf("tx%d", height))
+		require.NoError(t, indexer.ListenFinalizeBlock(context.Background(), abci.RequestFinalizeBlock{
+			Height: height,
+			Txs:    [][]byte{tx, []byte("failed")},
+		}, abci.ResponseFinalizeBlock{
+			TxResults: []*abci.ExecTxResult{
+				{Events: []abci.Event{
+					{Type: types.WasmModuleEventType, Attributes: []abci.EventAttribute{{Key: types.AttributeKeyContractAddr, Value: contractA}}},
+					contractEvent("wasm-transfer", contractA, "amount", fmt.Sprint(height)),
+				}},
+				{Code: 1, Events: []abci.Event{contractEvent("wasm-transfer", contractA, "amount", "0")}},
+			},
+			Events: []abci.Event{contractEvent("wasm-cron", contractB, "amount", "1")},
+		}))
+	}
+	transferEvent := func(height int64) types.IndexedEvent {
+		return types.IndexedEvent{
+			Height: height, TxHash: fmt.Sprintf("%X", cmttypes.Tx(fmt.Sprintf("tx%d", height)).Hash()), Sequence: 0,
+			ContractAddress: contractA, Type: "wasm-transfer",
+			Attributes: []types.IndexedEventAttribute{
+				{Key: types.AttributeKeyContractAddr, Value: contractA},
+				{Key: "amount", Value: fmt.Sprint(height)},
+			},
+		}
+	}
+	cronEvent := func(height int64) types.IndexedEvent {
+		return types.IndexedEvent{
+			Height: height, Sequence: 1, ContractAddress: contractB, Type: "wasm-cron",
+			Attributes: []types.IndexedEventAttribute{
+				{Key: types.AttributeKeyContractAddr, Value: contractB},
+				{Key: "amount", Value: "1"},
+			},
+		}
+	}
+
+	specs := map[string]struct {
+		src        types.QueryContractEventsRequest
+		expEvents  []types.IndexedEvent
+		expNextKey []byte
+		expErr     bool
+	}{
+		"all": {
+			src:       types.QueryContractEventsRequest{},
+			expEvents: []types.IndexedEvent{transferEvent(1), cronEvent(1), transferEvent(2), cronEvent(2), transferEvent(3), cronEvent(3)},
+		},
+		"by contract": {
+			src:       types.QueryContractEventsRequest{ContractAddress: contractB},
+			expEvents: []types.IndexedEvent{cronEvent(1), cronEvent(2), cronEvent(3)},
+		},
+		"by type and height range": {
+			src:       types.QueryContractEventsRequest{EventType: "wasm-transfer", MinHeight: 2, MaxHeight: 2},
+			expEvents: []types.IndexedEvent{transferEvent(2)},
+		},
+		"by attribute": {
+			src:       types.QueryContractEventsRequest{AttributeKey: "amount", AttributeValue: "1"},
+			expEvents: []types.IndexedEvent{transferEvent(1), cronEvent(1), cronEvent(2), cronEvent(3)},
+		},
+		"by attribute key": {
+			src:       types.QueryContractEventsRequest{AttributeKey: "amount", MinHeight: 3},
+			expEvents: []types.IndexedEvent{transferEvent(3), cronEvent(3)},
+		},
+		"by contract and attribute": {
+			src:       types.QueryContractEventsRequest{ContractAddress: contractA, AttributeKey: "amount", AttributeValue: "3"},
+			expEvents: []types.IndexedEvent{transferEvent(3)},
+		},
+		"first page": {
+			src:        types.QueryContractEventsRequest{ContractAddress: contractA, Pagination: &query.PageRequest{Limit: 2}},
+			expEvents:  []types.IndexedEvent{transferEvent(1), transferEvent(2)},
+			expNextKey: positionKey(3, 0),
+		},
+		"next page": {
+			src:       types.QueryContractEventsRequest{ContractAddress: contractA, Pagination: &query.PageRequest{Key: positionKey(3, 0), Limit: 2}},
+			expEvents: []types.IndexedEvent{transferEvent(3)},
+		},
+		"reverse": {
+			src:        types.QueryContractEventsRequest{ContractAddress: contractA, Pagination: &query.PageRequest{Limit: 2, Reverse: true}},
+			expEvents:  []types.IndexedEvent{transferEvent(3), transferEvent(2)},
+			expNextKey: positionKey(1, 0),
+		},
+		"reverse next page": {
+			src:       types.QueryContractEventsRequest{ContractAddress: contractA, Pagination: &query.PageRequest{Key: positionKey(1, 0), Reverse: true}},
+			expEvents: []types.IndexedEvent{transferEvent(1)},
+		},
+		"no match": {
+			src:       types.QueryContractEventsRequest{EventType: "wasm-other"},
+			expEvents: []types.IndexedEvent{},
+		},
+		"invalid contract address": {
+			src:    types.QueryContractEventsRequest{ContractAddress: "invalid"},
+			expErr: true,
+		},
+		"attribute value without key": {
+			src:    types.QueryContractEventsRequest{AttributeValue: "1"},
+			expErr: true,
+		},
+		"invalid height range": {
+			src:    types.QueryContractEventsRequest{MinHeight: 2, MaxHeight: 1},
+			expErr: true,
+		},
+		"offset": {
+			src:    types.QueryContractEventsRequest{Pagination: &query.PageRequest{Offset: 1}},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// when
+			got, gotErr := indexer.ContractEvents(context.Background(), &spec.src)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expEvents, got.Events)
+			assert.Equal(t, spec.expNextKey, got.Pagination.NextKey)
+		})
+	}
+}
+
+func TestContractEventsScanLimit(t *testing.T) {
+	contractAddr := sdk.AccAddress(bytes.Repeat([]byte{1}, types.ContractAddrLen)).String()
+	events := make([]abci.Event, maxScannedEntries+1)
+	for i := range events {
+		events[i] = abci.Event{Type: "wasm-noise", Attributes: []abci.EventAttribute{{Key: types.AttributeKeyContractAddr, Value: contractAddr}}}
+	}
+	events[maxScannedEntries] = abci.Event{Type: "wasm-match", Attributes: []abci.EventAttribute{
+		{Key: types.AttributeKeyContractAddr, Value: contractAddr},
+		{Key: "amount", Value: "1"},
+	}}
+	indexer := NewIndexer(dbm.NewMemDB())
+	require.NoError(t, indexer.ListenFinalizeBlock(context.Background(), abci.RequestFinalizeBlock{Height: 1}, abci.ResponseFinalizeBlock{Events: events}))
+	req := types.QueryContractEventsRequest{ContractAddress: contractAddr, AttributeKey: "amount"}
+
+	// when
+	got, err := indexer.ContractEvents(context.Background(), &req)
+	// then the page ends at the scan limit
+	require.NoError(t, err)
+	assert.Empty(t, got.Events)
+	assert.Equal(t, positionKey(1, maxScannedEntries), got.Pagination.NextKey)
+
+	// when
+	req.Pagination = &query.PageRequest{Key: got.Pagination.NextKey}
+	got, err = indexer.ContractEvents(context.Background(), &req)
+	// then
+	require.NoError(t, err)
+	require.Len(t, got.Events, 1)
+	assert.Equal(t, "wasm-match", got.Events[0].Type)
+	assert.Nil(t, got.Pagination.NextKey)
+}
@@ -0,0 +1,187 @@
+package eventindex
+
+import (
+	"bytes"
+	"context"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+const (
+	// maxResultEntries is the max number of events returned per page
+	maxResultEntries = 100
+	// maxScannedEntries is the max number of index entries that are scanned per page. The page ends early with a
+	// next key when the filters match too few of them.
+	maxScannedEntries = 10 * maxResultEntries
+)
+
+var _ types.EventIndexServer = &Indexer{}
+
+// ContractEvents returns the indexed events that match all filters of the request ordered by position. The most
+// selective index is used for iteration: contract address, event type and then attribute. A page can have fewer events
+// than the limit but a next key when the max number of scanned entries is reached.
+func (i *Indexer) ContractEvents(_ context.Context, req *types.QueryContractEventsRequest) (*types.QueryContractEventsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.ContractAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(req.ContractAddress); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "contract address")
+		}
+	}
+	if req.AttributeValue != "" && req.AttributeKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "attribute value without key")
+	}
+	if req.MinHeight < 0 || req.MaxHeight < 0 || (req.MaxHeight != 0 && req.MaxHeight < req.MinHeight) {
+		return nil, status.Error(codes.InvalidArgument, "height range")
+	}
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset != 0 || pageReq.CountTotal {
+		return nil, status.Error(codes.InvalidArgument, "offset and count queries not supported")
+	}
+	if len(pageReq.Key) != 0 && len(pageReq.Key) != positionLen {
+		return nil, status.Error(codes.InvalidArgument, "pagination key")
+	}
+	limit := pageReq.Limit
+	if limit == 0 || limit > maxResultEntries {
+		limit = maxResultEntries
+	}
+
+	indexPrefix := selectIndex(req)
+	start := append(bytes.Clone(indexPrefix), positionKey(req.MinHeight, 0)...)
+	end := storetypes.PrefixEndBytes(indexPrefix)
+	if req.MaxHeight != 0 {
+		end = append(bytes.Clone(indexPrefix), positionKey(req.MaxHeight+1, 0)...)
+	}
+	if len(pageReq.Key) != 0 {
+		pageKey := append(bytes.Clone(indexPrefix), pageReq.Key...)
+		if pageReq.Reverse {
+			// the end is exclusive so that the page key is included
+			end = minKey(end, append(pageKey, 0))
+		} else {
+			start = maxKey(start, pageKey)
+		}
+	}
+	if bytes.Compare(start, end) >= 0 {
+		return &types.QueryContractEventsResponse{Events: []types.IndexedEvent{}, Pagination: &query.PageResponse{}}, nil
+	}
+
+	var (
+		iter dbm.Iterator
+		err  error
+	)
+	if pageReq.Reverse {
+		iter, err = i.db.ReverseIterator(start, end)
+	} else {
+		iter, err = i.db.Iterator(start, end)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	events := make([]types.IndexedEvent, 0)
+	var nextKey []byte
+	for scanned := 0; iter.Valid(); iter.Next() {
+		pos := iter.Key()[len(indexPrefix):]
+		if scanned == maxScannedEntries {
+			nextKey = bytes.Clone(pos)
+			break
+		}
+		scanned++
+		event, err := i.loadEvent(pos)
+		if err != nil {
+			return nil, err
+		}
+		if event == nil || !matches(req, *event) {
+			continue
+		}
+		if uint64(len(events)) == limit {
+			nextKey = bytes.Clone(pos)
+			break
+		}
+		events = append(events, *event)
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	return &types.QueryContractEventsResponse{
+		Events:     events,
+		Pagination: &query.PageResponse{NextKey: nextKey},
+	}, nil
+}
+
+// selectIndex returns the prefix of the most selective index for the request
+func selectIndex(req *types.QueryContractEventsRequest) []byte {
+	switch {
+	case req.ContractAddress != "":
+		return contractIndexPrefixKey(req.ContractAddress)
+	case req.EventType != "":
+		return typeIndexPrefixKey(req.EventType)
+	case req.AttributeKey != "" && req.AttributeValue != "":
+		return attributeIndexPrefixKey(req.AttributeKey, req.AttributeValue)
+	case req.AttributeKey != "":
+		return attributeKeyIndexPrefixKey(req.AttributeKey)
+	default:
+		return eventKeyPrefix
+	}
+}
+
+// matches returns true when the event matches all filters of the request
+func matches(req *types.QueryContractEventsRequest, event types.IndexedEvent) bool {
+	if req.ContractAddress != "" && req.ContractAddress != event.ContractAddress {
+		return false
+	}
+	if req.EventType != "" && req.EventType != event.Type {
+		return false
+	}
+	if req.AttributeKey == "" {
+		return true
+	}
+	for _, a := range event.Attributes {
+		if a.Key == req.AttributeKey && (req.AttributeValue == "" || a.Value == req.AttributeValue) {
+			return true
+		}
+	}
+	return false
+}
+
+func (i *Indexer) loadEvent(pos []byte) (*types.IndexedEvent, error) {
+	bz, err := i.db.Get(eventKey(pos))
+	if err != nil || bz == nil {
+		return nil, err
+	}
+	var event types.IndexedEvent
+	if err := event.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// maxKey returns the greater of two keys
+func maxKey(a, b []byte) []byte {
+	if bytes.Compare(a, b) >= 0 {
+		return a
+	}
+	return b
+}
+
+// minKey returns the lesser of two keys
+func minKey(a, b []byte) []byte {
+	if bytes.Compare(a, b) <= 0 {
+		return a
+	}
+	return b
+}
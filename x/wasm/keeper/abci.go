@@ -0,0 +1,16 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker runs the scheduled contract calls and callbacks that are due and deletes the remaining state of purged contracts
+func EndBlocker(ctx context.Context, k *Keeper) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	k.runCronSchedules(sdkCtx)
+	k.runDueCallbacks(sdkCtx)
+	k.processPendingPurges(sdkCtx, PurgeStateGasLimitPerBlock)
+	return nil
+}
@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/ioutils"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// MigrationApprovalPeriod is the time that the admin set members have to reach the threshold for a migration
+const MigrationApprovalPeriod = 7 * 24 * time.Hour
+
+// setContractAdminSet replaces the admin of a contract with a group of admins. Pending migration approvals are void.
+func (k Keeper) setContractAdminSet(ctx context.Context, contractAddress, caller sdk.AccAddress, adminSet types.AdminSet, authZ types.AuthorizationPolicy) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	contractInfo := k.GetContractInfo(sdkCtx, contractAddress)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !canModifyContract(authZ, contractInfo, caller) {
+		return unauthorizedModification(contractInfo, "can not modify contract")
+	}
+	if err := k.removeFromContractAdminSecondaryIndex(sdkCtx, contractAddress, contractInfo.Admins()); err != nil {
+		return err
+	}
+	contractInfo.Admin = ""
+	contractInfo.AdminSet = &adminSet
+	k.mustStoreContractInfo(sdkCtx, contractAddress, contractInfo)
+	if err := k.addToContractAdminSecondaryIndex(sdkCtx, contractAddress, adminSet); err != nil {
+		return err
+	}
+	if err := k.deleteMigrationApprovals(ctx, contractAddress); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateAdminSet,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyAdminSetMembers, strings.Join(adminSet.Members, ",")),
+		sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatUint(uint64(adminSet.Threshold), 10)),
+	))
+	return nil
+}
+
+// approveMigration adds the approval of an admin set member to the pending migration proposal of the contract. Each
+// combination of code id and msg is a separate proposal. Expired proposals of the contract are removed first, so that
+// an expired proposal is restarted. The contract is migrated when
+// the approvals of a proposal reach the threshold and all other pending proposals of the contract are void.
+func (k Keeper) approveMigration(ctx context.Context, contractAddress, caller sdk.AccAddress, newCodeID uint64, rawMsg []byte, authZ types.AuthorizationPolicy) (bool, []byte, error) {
+	msg, err := ioutils.CompactMsg(rawMsg)
+	if err != nil {
+		return false, nil, errorsmod.Wrapf(types.ErrInvalidMsg, "failed to compact migrate msg: %s", err.Error())
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	contractInfo := k.GetContractInfo(sdkCtx, contractAddress)
+	if contractInfo == nil {
+		return false, nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if contractInfo.AdminSet == nil {
+		return false, nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "contract has no admin set")
+	}
+	if !contractInfo.AdminSet.Contains(caller) {
+		return false, nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "not an admin set member")
+	}
+
+	blockTime := uint64(sdkCtx.BlockTime().UnixNano())
+	if err := k.pruneExpiredMigrationApprovals(ctx, contractAddress, blockTime); err != nil {
+		return false, nil, err
+	}
+	approval := k.GetMigrationApproval(ctx, contractAddress, newCodeID, msg)
+	switch {
+	case approval == nil:
+		approval = &types.MigrationApproval{
+			Contract:  contractAddress.String(),
+			CodeID:    newCodeID,
+			Msg:       msg,
+			ExpiresAt: blockTime + uint64(MigrationApprovalPeriod),
+		}
+	case approval.HasApproved(caller):
+		return false, nil, errorsmod.Wrap(types.ErrDuplicate, "approval")
+	}
+	approval.Approvals = append(approval.Approvals, caller.String())
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeApproveMigration,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(newCodeID, 10)),
+		sdk.NewAttribute(types.AttributeKeyApprover, caller.String()),
+		sdk.NewAttribute(types.AttributeKeyApprovals, strconv.Itoa(len(approval.Approvals))),
+	))
+
+	if len(approval.Approvals) < int(contractInfo.AdminSet.Threshold) {
+		k.mustStoreMigrationApproval(ctx, *approval)
+		return false, nil, nil
+	}
+	if err := k.deleteMigrationApprovals(ctx, contractAddress); err != nil {
+		return false, nil, err
+	}
+	// the threshold replaces the admin check, the new code must still be usable by the caller
+	data, err := k.migrate(ctx, contractAddress, caller, newCodeID, msg, NewPartialGovAuthorizationPolicy(authZ, types.AuthZActionMigrateContract))
+	if err != nil {
+		return false, nil, err
+	}
+	return true, data, nil
+}
+
+// GetMigrationApproval returns the pending approval of the migration to the code id with the msg or nil when not found
+func (k Keeper) GetMigrationApproval(ctx context.Context, contractAddr sdk.AccAddress, codeID uint64, msg types.RawContractMessage) *types.MigrationApproval {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.GetMigrationApprovalKey(contractAddr, types.MigrationProposalID(codeID, msg)))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var approval types.MigrationApproval
+	k.cdc.MustUnmarshal(bz, &approval)
+	return &approval
+}
+
+// IterateMigrationApprovals iterates over all pending migration approvals
+func (k Keeper) IterateMigrationApprovals(ctx context.Context, cb func(types.MigrationApproval) bool) {
+	k.iterateMigrationApprovals(ctx, types.MigrationApprovalPrefix, cb)
+}
+
+// IterateContractMigrationApprovals iterates over the pending migration approvals of a contract
+func (k Keeper) IterateContractMigrationApprovals(ctx context.Context, contractAddr sdk.AccAddress, cb func(types.MigrationApproval) bool) {
+	k.iterateMigrationApprovals(ctx, types.GetMigrationApprovalsPrefix(contractAddr), cb)
+}
+
+func (k Keeper) iterateMigrationApprovals(ctx context.Context, keyPrefix []byte, cb func(types.MigrationApproval) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var approval types.MigrationApproval
+		k.cdc.MustUnmarshal(iter.Value(), &approval)
+		// cb returns true to stop early
+		if cb(approval) {
+			return
+		}
+	}
+}
+
+func (k Keeper) mustStoreMigrationApproval(ctx context.Context, approval types.MigrationApproval) {
+	contractAddr := sdk.MustAccAddressFromBech32(approval.Contract)
+	if err := k.storeService.OpenKVStore(ctx).Set(types.GetMigrationApprovalKey(contractAddr, approval.ProposalID()), k.cdc.MustMarshal(&approval)); err != nil {
+		panic(err)
+	}
+}
+
+// deleteMigrationApprovals removes all pending migration approvals of the contract
+func (k Keeper) deleteMigrationApprovals(ctx context.Context, contractAddr sdk.AccAddress) error {
+	var proposalIDs [][]byte
+	k.IterateContractMigrationApprovals(ctx, contractAddr, func(approval types.MigrationApproval) bool {
+		proposalIDs = append(proposalIDs, approval.ProposalID())
+		return false
+	})
+	store := k.storeService.OpenKVStore(ctx)
+	for _, proposalID := range proposalIDs {
+		if err := store.Delete(types.GetMigrationApprovalKey(contractAddr, proposalID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pruneExpiredMigrationApprovals removes the pending migration approvals of the contract that expired
+func (k Keeper) pruneExpiredMigrationApprovals(ctx context.Context, contractAddr sdk.AccAddress, blockTime uint64) error {
+	var proposalIDs [][]byte
+	k.IterateContractMigrationApprovals(ctx, contractAddr, func(approval types.MigrationApproval) bool {
+		if approval.IsExpired(blockTime) {
+			proposalIDs = append(proposalIDs, approval.ProposalID())
+		}
+		return false
+	})
+	store := k.storeService.OpenKVStore(ctx)
+	for _, proposalID := range proposalIDs {
+		if err := store.Delete(types.GetMigrationApprovalKey(contractAddr, proposalID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// importMigrationApproval stores a pending migration approval from genesis
+func (k Keeper) importMigrationApproval(ctx context.Context, approval types.MigrationApproval) error {
+	if err := approval.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr := sdk.MustAccAddressFromBech32(approval.Contract)
+	contractInfo := k.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return types.ErrNoSuchContractFn(approval.Contract).Wrapf("address %s", approval.Contract)
+	}
+	if contractInfo.AdminSet == nil {
+		return errorsmod.Wrapf(types.ErrInvalid, "contract has no admin set: %s", approval.Contract)
+	}
+	if k.GetMigrationApproval(ctx, contractAddr, approval.CodeID, approval.Msg) != nil {
+		return errorsmod.Wrapf(types.ErrDuplicate, "migration approval: %s", approval.Contract)
+	}
+	k.mustStoreMigrationApproval(ctx, approval)
+	return nil
+}
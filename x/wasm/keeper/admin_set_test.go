@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestSetContractAdminSet(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	policy := DefaultAuthorizationPolicy{}
+	memberA, memberB := RandomAccountAddress(t), RandomAccountAddress(t)
+	adminSet := types.AdminSet{Members: []string{memberA.String(), memberB.String()}, Threshold: 2}
+
+	// when unauthorized
+	err := k.setContractAdminSet(ctx, example.Contract, memberA, adminSet, policy)
+	// then
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// when set by admin
+	require.NoError(t, k.setContractAdminSet(ctx, example.Contract, example.CreatorAddr, adminSet, policy))
+	// then
+	info := k.GetContractInfo(ctx, example.Contract)
+	assert.Empty(t, info.Admin)
+	assert.Equal(t, &adminSet, info.AdminSet)
+	require.NoError(t, info.ValidateBasic())
+
+	// and members can not act alone with a threshold above 1
+	err = k.setContractLabel(ctx, example.Contract, memberA, "new label", policy)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.ErrorContains(t, err, "admin set with threshold 2 requires governance")
+	_, err = k.migrate(ctx, example.Contract, memberA, example.CodeID, []byte(`{}`), policy)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	err = k.setContractAdminSet(ctx, example.Contract, memberA, types.AdminSet{Members: []string{memberA.String()}, Threshold: 1}, policy)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	err = k.setContractFrozen(ctx, example.Contract, memberA, true, false, policy)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	err = k.setContractMetadata(ctx, example.Contract, memberA, types.ContractMetadata{}, policy)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// and governance can act on them
+	require.NoError(t, k.setContractLabel(ctx, example.Contract, nil, "new label", GovAuthorizationPolicy{}))
+	assert.Equal(t, "new label", k.GetContractInfo(ctx, example.Contract).Label)
+
+	// when a new single admin is set by gov
+	require.NoError(t, k.setContractAdmin(ctx, example.Contract, nil, memberB, GovAuthorizationPolicy{}))
+	// then the admin set is removed
+	info = k.GetContractInfo(ctx, example.Contract)
+	assert.Equal(t, memberB.String(), info.Admin)
+	assert.Nil(t, info.AdminSet)
+}
+
+func TestApproveMigration(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	newCodeID := StoreHackatomExampleContract(t, parentCtx, keepers).CodeID
+	memberA, memberB, memberC := RandomAccountAddress(t), RandomAccountAddress(t), RandomAccountAddress(t)
+	adminSet := types.AdminSet{Members: []string{memberA.String(), memberB.String(), memberC.String()}, Threshold: 2}
+	require.NoError(t, k.setContractAdminSet(parentCtx, example.Contract, example.CreatorAddr, adminSet, DefaultAuthorizationPolicy{}))
+	parentCtx = parentCtx.WithBlockTime(time.Unix(1000, 0))
+
+	newVerifier := RandomAccountAddress(t)
+	migMsg := []byte(fmt.Sprintf(`{"verifier":%q}`, newVerifier.String()))
+	otherMsg := []byte(fmt.Sprintf(`{"verifier":%q}`, RandomBech32AccountAddress(t)))
+	specs := map[string]struct {
+		setup       func(ctx sdk.Context)
+		caller      sdk.AccAddress
+		codeID      uint64
+		expErr      error
+		expMigrated bool
+		expPending  int
+		expPendings int
+	}{
+		"first approval": {
+			caller:      memberA,
+			codeID:      newCodeID,
+			expPending:  1,
+			expPendings: 1,
+		},
+		"threshold reached": {
+			setup: func(ctx sdk.Context) {
+				_, _, err := k.approveMigration(ctx, example.Contract, memberA, newCodeID, migMsg, DefaultAuthorizationPolicy{})
+				require.NoError(t, err)
+			},
+			caller:      memberB,
+			codeID:      newCodeID,
+			expMigrated: true,
+		},
+		"duplicate approval": {
+			setup: func(ctx sdk.Context) {
+				_, _, err := k.approveMigration(ctx, example.Contract, memberA, newCodeID, migMsg, DefaultAuthorizationPolicy{})
+				require.NoError(t, err)
+			},
+			caller: memberA,
+			codeID: newCodeID,
+			expErr: types.ErrDuplicate,
+		},
+		"different migration is a separate proposal": {
+			setup: func(ctx sdk.Context) {
+				_, _, err := k.approveMigration(ctx, example.Contract, memberA, newCodeID, otherMsg, DefaultAuthorizationPolicy{})
+				require.NoError(t, err)
+			},
+			caller:      memberB,
+			codeID:      newCodeID,
+			expPending:  1,
+			expPendings: 2,
+		},
+		"different migration does not reset approvals": {
+			setup: func(ctx sdk.Context) {
+				_, _, err := k.approveMigration(ctx, example.Contract, memberA, newCodeID, migMsg, DefaultAuthorizationPolicy{})
+				require.NoError(t, err)
+				_, _, err = k.approveMigration(ctx, example.Contract, memberB, newCodeID, otherMsg, DefaultAuthorizationPolicy{})
+				require.NoError(t, err)
+			},
+			caller:      memberC,
+			codeID:      newCodeID,
+			expMigrated: true,
+		},
+		"expired approval replaced": {
+			setup: func(ctx sdk.Context) {
+				ctx = ctx.WithBlockTime(ctx.BlockTime().Add(-MigrationApprovalPeriod - time.Nanosecond))
+				_, _, err := k.approveMigration(ctx, example.Contract, memberA, newCodeID, migMsg, DefaultAuthorizationPolicy{})
+				require.NoError(t, err)
+			},
+			caller:      memberB,
+			codeID:      newCodeID,
+			expPending:  1,
+			expPendings: 1,
+		},
+		"expired proposals pruned": {
+			setup: func(ctx sdk.Context) {
+				ctx = ctx.WithBlockTime(ctx.BlockTime().Add(-MigrationApprovalPeriod - time.Nanosecond))
+				_, _, err := k.approveMigration(ctx, example.Contract, memberA, newCodeID, otherMsg, DefaultAuthorizationPolicy{})
+				require.NoError(t, err)
+			},
+			caller:      memberB,
+			codeID:      newCodeID,
+			expPending:  1,
+			expPendings: 1,
+		},
+		"not a member": {
+			caller: example.CreatorAddr,
+			codeID: newCodeID,
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"unknown code": {
+			setup: func(ctx sdk.Context) {
+				_, _, err := k.approveMigration(ctx, example.Contract, memberA, 99, migMsg, DefaultAuthorizationPolicy{})
+				require.NoError(t, err)
+			},
+			caller: memberB,
+			codeID: 99,
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			if spec.setup != nil {
+				spec.setup(ctx)
+			}
+
+			// when
+			migrated, _, gotErr := k.approveMigration(ctx, example.Contract, spec.caller, spec.codeID, migMsg, DefaultAuthorizationPolicy{})
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expMigrated, migrated)
+			info := k.GetContractInfo(ctx, example.Contract)
+			var pendings int
+			k.IterateContractMigrationApprovals(ctx, example.Contract, func(types.MigrationApproval) bool {
+				pendings++
+				return false
+			})
+			assert.Equal(t, spec.expPendings, pendings)
+			if spec.expMigrated {
+				assert.Equal(t, newCodeID, info.CodeID)
+				return
+			}
+			assert.Equal(t, example.CodeID, info.CodeID)
+			approval := k.GetMigrationApproval(ctx, example.Contract, spec.codeID, migMsg)
+			require.NotNil(t, approval)
+			assert.Len(t, approval.Approvals, spec.expPending)
+			assert.Equal(t, spec.caller.String(), approval.Approvals[len(approval.Approvals)-1])
+			assert.Equal(t, uint64(ctx.BlockTime().Add(MigrationApprovalPeriod).UnixNano()), approval.ExpiresAt)
+		})
+	}
+}
@@ -1,13 +1,19 @@
 package keeper
 
 import (
+	"context"
 	"encoding/binary"
+	"encoding/json"
+	"math"
 
 	corestoretypes "cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
@@ -64,6 +70,111 @@ func decodeHeightCounter(bz []byte) (int64, uint32) {
 	return int64(sdk.BigEndianToUint64(bz[0:8])), binary.BigEndian.Uint32(bz[8:])
 }
 
+// FeeSponsor pays the tx fees of a sender from the fee sponsorship of a contract
+type FeeSponsor interface {
+	DeductSponsoredFee(ctx sdk.Context, contractAddr, sender sdk.AccAddress, fee sdk.Coins) (bool, error)
+}
+
+// SponsoredFeeDecorator ante handler to deduct the tx fees from the fee sponsorship of a contract.
+type SponsoredFeeDecorator struct {
+	sponsor      FeeSponsor
+	feeDecorator sdk.AnteDecorator
+	txFeeChecker ante.TxFeeChecker
+}
+
+// NewSponsoredFeeDecorator constructor. The fee decorator is used for all txs that are not sponsored and should be
+// the default fee decorator of the chain. The tx fee checker should be the one of the fee decorator, the validator
+// min gas prices are checked when nil.
+func NewSponsoredFeeDecorator(s FeeSponsor, feeDecorator sdk.AnteDecorator, tfc ante.TxFeeChecker) *SponsoredFeeDecorator {
+	if tfc == nil {
+		tfc = checkTxFeeWithMinGasPrices
+	}
+	return &SponsoredFeeDecorator{sponsor: s, feeDecorator: feeDecorator, txFeeChecker: tfc}
+}
+
+// AnteHandle deducts the fees from the fee sponsorship when the tx contains only `MsgExecuteContract` calls of the fee
+// payer to the same contract and the sponsorship of this contract can pay the fees. The fee and the tx priority are
+// returned by the tx fee checker, like in the fee decorator.
+// All other txs, txs with a fee granter or when the sponsorship limits are exceeded are passed to the fee decorator.
+func (d SponsoredFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.FeeGranter() != nil || feeTx.GetFee().IsZero() {
+		return d.feeDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+	feePayer := sdk.AccAddress(feeTx.FeePayer())
+	contractAddr, ok := sponsoredContract(tx.GetMsgs(), feePayer)
+	if !ok {
+		return d.feeDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+	if !simulate && ctx.BlockHeight() > 0 && feeTx.GetGas() == 0 {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidGasLimit, "must provide positive gas")
+	}
+	fee, priority := feeTx.GetFee(), int64(0)
+	if !simulate {
+		var err error
+		if fee, priority, err = d.txFeeChecker(ctx, tx); err != nil {
+			return ctx, err
+		}
+	}
+	sponsored, err := d.sponsor.DeductSponsoredFee(ctx, contractAddr, feePayer, fee)
+	if err != nil {
+		return ctx, err
+	}
+	if !sponsored {
+		return d.feeDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+	return next(ctx.WithPriority(priority), tx, simulate)
+}
+
+// sponsoredContract returns the contract address when all messages are executions of the same contract by the sender
+func sponsoredContract(msgs []sdk.Msg, sender sdk.AccAddress) (sdk.AccAddress, bool) {
+	var contract string
+	for _, msg := range msgs {
+		execMsg, ok := msg.(*types.MsgExecuteContract)
+		if !ok || execMsg.Sender != sender.String() || (contract != "" && execMsg.Contract != contract) {
+			return nil, false
+		}
+		contract = execMsg.Contract
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return nil, false
+	}
+	return contractAddr, true
+}
+
+// checkTxFeeWithMinGasPrices returns an error when the tx fee does not cover the min gas prices of the node in check tx.
+// The tx priority is the smallest gas price of the fee coins, as in the default tx fee checker of the sdk.
+func checkTxFeeWithMinGasPrices(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	feeCoins, gas := feeTx.GetFee(), feeTx.GetGas()
+	if minGasPrices := ctx.MinGasPrices(); ctx.IsCheckTx() && !minGasPrices.IsZero() {
+		requiredFees := make(sdk.Coins, len(minGasPrices))
+		glDec := sdkmath.LegacyNewDec(int64(gas))
+		for i, gp := range minGasPrices {
+			fee := gp.Amount.Mul(glDec)
+			requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+		}
+		if !feeCoins.IsAnyGTE(requiredFees) {
+			return nil, 0, errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+		}
+	}
+	var priority int64
+	for _, c := range feeCoins {
+		p := int64(math.MaxInt64)
+		if gasPrice := c.Amount.QuoRaw(int64(gas)); gasPrice.IsInt64() {
+			p = gasPrice.Int64()
+		}
+		if priority == 0 || p < priority {
+			priority = p
+		}
+	}
+	return feeCoins, priority, nil
+}
+
 // LimitSimulationGasDecorator ante decorator to limit gas in simulation calls
 type LimitSimulationGasDecorator struct {
 	gasLimit *storetypes.Gas
@@ -106,17 +217,76 @@ func (d LimitSimulationGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 	return next(ctx, tx, simulate)
 }
 
+// GasRegisterSource provides the gas register with the gas costs that are currently active
+type GasRegisterSource interface {
+	GetActiveGasRegister(ctx context.Context) types.GasRegister
+}
+
 // GasRegisterDecorator ante decorator to store gas register in the context
 type GasRegisterDecorator struct {
 	gasRegister types.GasRegister
+	source      GasRegisterSource
 }
 
-// NewGasRegisterDecorator constructor.
+// NewGasRegisterDecorator constructor. The gas costs in the params are not applied to the txs with this fixed
+// register, use NewActiveGasRegisterDecorator instead.
 func NewGasRegisterDecorator(gr types.GasRegister) *GasRegisterDecorator {
 	return &GasRegisterDecorator{gasRegister: gr}
 }
 
-// AnteHandle adds the gas register to the context.
+// NewActiveGasRegisterDecorator constructor that resolves the gas register from the source for every tx
+func NewActiveGasRegisterDecorator(s GasRegisterSource) *GasRegisterDecorator {
+	return &GasRegisterDecorator{source: s}
+}
+
+// AnteHandle adds the gas register to the context. With a source, the register is resolved once per tx so that
+// changes of the gas costs in the params apply to the next tx.
 func (g GasRegisterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
-	return next(types.WithGasRegister(ctx, g.gasRegister), tx, simulate)
+	gasRegister := g.gasRegister
+	if g.source != nil {
+		gasRegister = g.source.GetActiveGasRegister(ctx)
+	}
+	return next(types.WithGasRegister(ctx, gasRegister), tx, simulate)
+}
+
+// GasProfileDecorator ante decorator to collect the gas breakdown of the contract calls in simulations
+type GasProfileDecorator struct{}
+
+// NewGasProfileDecorator constructor.
+func NewGasProfileDecorator() *GasProfileDecorator {
+	return &GasProfileDecorator{}
+}
+
+// AnteHandle adds a gas profiler to the context in simulations only. The profiler does not consume gas.
+func (d GasProfileDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !simulate {
+		return next(ctx, tx, simulate)
+	}
+	return next(types.WithGasProfiler(ctx, types.NewGasProfiler()), tx, simulate)
+}
+
+// GasProfilePostDecorator post decorator to return the gas breakdown of the contract calls in simulations
+type GasProfilePostDecorator struct{}
+
+// NewGasProfilePostDecorator constructor.
+func NewGasProfilePostDecorator() *GasProfilePostDecorator {
+	return &GasProfilePostDecorator{}
+}
+
+// PostHandle emits the collected gas profiles as JSON tree in an event so that it is part of the simulation response.
+// Failed simulations do not return events, the profiles are part of the error of the failed contract call instead.
+func (d GasProfilePostDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	profiler, ok := types.GasProfilerFromContext(ctx)
+	if !simulate || !ok || len(profiler.Profiles()) == 0 {
+		return next(ctx, tx, simulate, success)
+	}
+	bz, err := json.Marshal(profiler.Profiles())
+	if err != nil {
+		return ctx, errorsmod.Wrap(err, "gas profile")
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeGasProfile,
+		sdk.NewAttribute(types.AttributeKeyGasProfile, string(bz)),
+	))
+	return next(ctx, tx, simulate, success)
 }
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
 	storemetrics "cosmossdk.io/store/metrics"
@@ -16,6 +18,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/CosmWasm/wasmd/x/wasm/keeper"
 	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
@@ -224,7 +227,9 @@ func TestGasRegisterDecorator(t *testing.T) {
 			var anyTx sdk.Tx
 
 			// when
-			ante := keeper.NewGasRegisterDecorator(&wasmtesting.MockGasRegister{})
+			ante := keeper.NewActiveGasRegisterDecorator(mockGasRegisterSource(func(context.Context) types.GasRegister {
+				return &wasmtesting.MockGasRegister{}
+			}))
 			_, gotErr := ante.AnteHandle(ctx, anyTx, spec.simulate, spec.nextAssertAnte)
 
 			// then
@@ -232,3 +237,199 @@ func TestGasRegisterDecorator(t *testing.T) {
 		})
 	}
 }
+
+func TestSponsoredFeeDecorator(t *testing.T) {
+	var (
+		myContract  = keeper.RandomAccountAddress(t)
+		otherAddr   = keeper.RandomAccountAddress(t)
+		mySender    = keeper.RandomAccountAddress(t)
+		myFee       = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+		myPriority  = int64(10)
+		execMsg     = &types.MsgExecuteContract{Sender: mySender.String(), Contract: myContract.String(), Msg: []byte(`{}`)}
+		otherExec   = &types.MsgExecuteContract{Sender: mySender.String(), Contract: otherAddr.String(), Msg: []byte(`{}`)}
+		otherSender = &types.MsgExecuteContract{Sender: otherAddr.String(), Contract: myContract.String(), Msg: []byte(`{}`)}
+	)
+	specs := map[string]struct {
+		tx             sdk.Tx
+		sponsored      bool
+		feeCheckErr    error
+		expSponsorCall bool
+		expFallback    bool
+		expPriority    int64
+		expErr         *errorsmod.Error
+	}{
+		"sponsored": {
+			tx:             mockFeeTx{msgs: []sdk.Msg{execMsg, execMsg}, fee: myFee, payer: mySender},
+			sponsored:      true,
+			expSponsorCall: true,
+			expPriority:    myPriority,
+		},
+		"fee checker rejects": {
+			tx:          mockFeeTx{msgs: []sdk.Msg{execMsg}, fee: myFee, payer: mySender},
+			sponsored:   true,
+			feeCheckErr: sdkerrors.ErrInsufficientFee,
+			expErr:      sdkerrors.ErrInsufficientFee,
+		},
+		"sponsorship can not pay": {
+			tx:             mockFeeTx{msgs: []sdk.Msg{execMsg}, fee: myFee, payer: mySender},
+			expSponsorCall: true,
+			expFallback:    true,
+		},
+		"different contracts": {
+			tx:          mockFeeTx{msgs: []sdk.Msg{execMsg, otherExec}, fee: myFee, payer: mySender},
+			expFallback: true,
+		},
+		"sender is not fee payer": {
+			tx:          mockFeeTx{msgs: []sdk.Msg{otherSender}, fee: myFee, payer: mySender},
+			expFallback: true,
+		},
+		"other message type": {
+			tx:          mockFeeTx{msgs: []sdk.Msg{execMsg, &types.MsgClearAdmin{Sender: mySender.String(), Contract: myContract.String()}}, fee: myFee, payer: mySender},
+			expFallback: true,
+		},
+		"fee granter set": {
+			tx:          mockFeeTx{msgs: []sdk.Msg{execMsg}, fee: myFee, payer: mySender, granter: otherAddr},
+			expFallback: true,
+		},
+		"zero fee": {
+			tx:          mockFeeTx{msgs: []sdk.Msg{execMsg}, payer: mySender},
+			expFallback: true,
+		},
+		"no messages": {
+			tx:          mockFeeTx{fee: myFee, payer: mySender},
+			expFallback: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			var sponsorCalled, fallbackCalled, nextCalled bool
+			var gotPriority int64
+			sponsor := mockFeeSponsor(func(ctx sdk.Context, contractAddr, sender sdk.AccAddress, fee sdk.Coins) (bool, error) {
+				sponsorCalled = true
+				assert.Equal(t, myContract, contractAddr)
+				assert.Equal(t, mySender, sender)
+				assert.Equal(t, myFee, fee)
+				return spec.sponsored, nil
+			})
+			fallback := mockAnteDecorator(func(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+				fallbackCalled = true
+				return next(ctx, tx, simulate)
+			})
+			feeChecker := func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+				return myFee, myPriority, spec.feeCheckErr
+			}
+			next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+				nextCalled = true
+				gotPriority = ctx.Priority()
+				return ctx, nil
+			}
+
+			// when
+			ante := keeper.NewSponsoredFeeDecorator(sponsor, fallback, feeChecker)
+			_, gotErr := ante.AnteHandle(sdk.Context{}, spec.tx, false, next)
+
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.False(t, nextCalled)
+				assert.False(t, sponsorCalled)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.True(t, nextCalled)
+			assert.Equal(t, spec.expPriority, gotPriority)
+			assert.Equal(t, spec.expSponsorCall, sponsorCalled)
+			assert.Equal(t, spec.expFallback, fallbackCalled)
+		})
+	}
+}
+
+type mockFeeSponsor func(ctx sdk.Context, contractAddr, sender sdk.AccAddress, fee sdk.Coins) (bool, error)
+
+func (m mockFeeSponsor) DeductSponsoredFee(ctx sdk.Context, contractAddr, sender sdk.AccAddress, fee sdk.Coins) (bool, error) {
+	return m(ctx, contractAddr, sender, fee)
+}
+
+type mockAnteDecorator func(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error)
+
+func (m mockAnteDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return m(ctx, tx, simulate, next)
+}
+
+type mockFeeTx struct {
+	sdk.FeeTx
+	msgs    []sdk.Msg
+	fee     sdk.Coins
+	payer   sdk.AccAddress
+	granter sdk.AccAddress
+}
+
+func (m mockFeeTx) GetMsgs() []sdk.Msg { return m.msgs }
+func (m mockFeeTx) GetFee() sdk.Coins  { return m.fee }
+func (m mockFeeTx) GetGas() uint64     { return 100_000 }
+func (m mockFeeTx) FeePayer() []byte   { return m.payer }
+func (m mockFeeTx) FeeGranter() []byte { return m.granter }
+
+type mockGasRegisterSource func(ctx context.Context) types.GasRegister
+
+func (m mockGasRegisterSource) GetActiveGasRegister(ctx context.Context) types.GasRegister {
+	return m(ctx)
+}
+
+func TestGasProfileDecorator(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
+
+	specs := map[string]struct {
+		simulate   bool
+		expProfile bool
+	}{
+		"simulation": {
+			simulate:   true,
+			expProfile: true,
+		},
+		"not simulation": {
+			simulate: false,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.NewContext(ms, cmtproto.Header{
+				Height: 100,
+				Time:   time.Now(),
+			}, false, log.NewNopLogger())
+			var anyTx sdk.Tx
+
+			// when
+			ante := keeper.NewGasProfileDecorator()
+			ctx, gotErr := ante.AnteHandle(ctx, anyTx, spec.simulate, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				return ctx, nil
+			})
+
+			// then
+			require.NoError(t, gotErr)
+			profiler, ok := types.GasProfilerFromContext(ctx)
+			require.Equal(t, spec.expProfile, ok)
+
+			// and when a contract was called
+			profiler.Enter(types.GasProfileStepExecute, nil, ctx.GasMeter())
+			profiler.Exit()
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			post := keeper.NewGasProfilePostDecorator()
+			ctx, gotErr = post.PostHandle(ctx, anyTx, spec.simulate, true, func(ctx sdk.Context, _ sdk.Tx, _, _ bool) (sdk.Context, error) {
+				return ctx, nil
+			})
+
+			// then the profile is emitted
+			require.NoError(t, gotErr)
+			if !spec.expProfile {
+				assert.Empty(t, ctx.EventManager().Events())
+				return
+			}
+			require.Len(t, ctx.EventManager().Events(), 1)
+			gotEvent := ctx.EventManager().Events()[0]
+			assert.Equal(t, types.EventTypeGasProfile, gotEvent.Type)
+			assert.JSONEq(t, `[{"step":"execute","gas":0}]`, gotEvent.Attributes[0].Value)
+		})
+	}
+}
@@ -1,12 +1,18 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
-var _ types.AuthorizationPolicy = DefaultAuthorizationPolicy{}
+var (
+	_ types.AuthorizationPolicy         = DefaultAuthorizationPolicy{}
+	_ types.AdminSetAuthorizationPolicy = DefaultAuthorizationPolicy{}
+)
 
 type DefaultAuthorizationPolicy struct{}
 
@@ -19,10 +25,21 @@ func (p DefaultAuthorizationPolicy) CanInstantiateContract(config types.AccessCo
 	return config.Allowed(actor)
 }
 
+func (p DefaultAuthorizationPolicy) CanExecuteContract(config types.AccessConfig, actor sdk.AccAddress) bool {
+	return config.Allowed(actor)
+}
+
 func (p DefaultAuthorizationPolicy) CanModifyContract(admin, actor sdk.AccAddress) bool {
 	return admin != nil && admin.Equals(actor)
 }
 
+// CanModifyContractWithAdminSet returns true when the actor is a member of the admin set and can act alone.
+// Members of a set with a higher threshold have to approve migrations instead. Other modifications of such a contract
+// require governance.
+func (p DefaultAuthorizationPolicy) CanModifyContractWithAdminSet(admins types.AdminSet, actor sdk.AccAddress) bool {
+	return admins.Threshold == 1 && admins.Contains(actor)
+}
+
 func (p DefaultAuthorizationPolicy) CanModifyCodeAccessConfig(creator, actor sdk.AccAddress, isSubset bool) bool {
 	return creator != nil && creator.Equals(actor) && isSubset
 }
@@ -32,7 +49,10 @@ func (p DefaultAuthorizationPolicy) SubMessageAuthorizationPolicy(_ types.Author
 	return p
 }
 
-var _ types.AuthorizationPolicy = GovAuthorizationPolicy{}
+var (
+	_ types.AuthorizationPolicy         = GovAuthorizationPolicy{}
+	_ types.AdminSetAuthorizationPolicy = GovAuthorizationPolicy{}
+)
 
 type GovAuthorizationPolicy struct {
 	propagate map[types.AuthorizationPolicyAction]struct{}
@@ -63,10 +83,18 @@ func (p GovAuthorizationPolicy) CanInstantiateContract(types.AccessConfig, sdk.A
 	return true
 }
 
+func (p GovAuthorizationPolicy) CanExecuteContract(types.AccessConfig, sdk.AccAddress) bool {
+	return true
+}
+
 func (p GovAuthorizationPolicy) CanModifyContract(sdk.AccAddress, sdk.AccAddress) bool {
 	return true
 }
 
+func (p GovAuthorizationPolicy) CanModifyContractWithAdminSet(types.AdminSet, sdk.AccAddress) bool {
+	return true
+}
+
 func (p GovAuthorizationPolicy) CanModifyCodeAccessConfig(sdk.AccAddress, sdk.AccAddress, bool) bool {
 	return true
 }
@@ -82,7 +110,10 @@ func (p GovAuthorizationPolicy) SubMessageAuthorizationPolicy(action types.Autho
 	return defaultPolicy
 }
 
-var _ types.AuthorizationPolicy = PartialGovAuthorizationPolicy{}
+var (
+	_ types.AuthorizationPolicy         = PartialGovAuthorizationPolicy{}
+	_ types.AdminSetAuthorizationPolicy = PartialGovAuthorizationPolicy{}
+)
 
 // PartialGovAuthorizationPolicy decorates the given default policy to add fine-grained gov permissions
 // to the defined action
@@ -107,6 +138,10 @@ func (p PartialGovAuthorizationPolicy) CanInstantiateContract(c types.AccessConf
 	return p.defaultPolicy.CanInstantiateContract(c, actor)
 }
 
+func (p PartialGovAuthorizationPolicy) CanExecuteContract(c types.AccessConfig, actor sdk.AccAddress) bool {
+	return p.defaultPolicy.CanExecuteContract(c, actor)
+}
+
 func (p PartialGovAuthorizationPolicy) CanModifyContract(admin, actor sdk.AccAddress) bool {
 	if p.action == types.AuthZActionMigrateContract {
 		return true
@@ -114,6 +149,13 @@ func (p PartialGovAuthorizationPolicy) CanModifyContract(admin, actor sdk.AccAdd
 	return p.defaultPolicy.CanModifyContract(admin, actor)
 }
 
+func (p PartialGovAuthorizationPolicy) CanModifyContractWithAdminSet(admins types.AdminSet, actor sdk.AccAddress) bool {
+	if p.action == types.AuthZActionMigrateContract {
+		return true
+	}
+	return canModifyContractWithAdminSet(p.defaultPolicy, admins, actor)
+}
+
 func (p PartialGovAuthorizationPolicy) CanModifyCodeAccessConfig(creator, actor sdk.AccAddress, isSubset bool) bool {
 	return p.defaultPolicy.CanModifyCodeAccessConfig(creator, actor, isSubset)
 }
@@ -122,3 +164,27 @@ func (p PartialGovAuthorizationPolicy) CanModifyCodeAccessConfig(creator, actor
 func (p PartialGovAuthorizationPolicy) SubMessageAuthorizationPolicy(_ types.AuthorizationPolicyAction) types.AuthorizationPolicy {
 	return p
 }
+
+// canModifyContract returns true when the policy authorizes the actor to modify the contract with its admin or
+// admin set
+func canModifyContract(authZ types.AuthorizationPolicy, contractInfo *types.ContractInfo, actor sdk.AccAddress) bool {
+	if contractInfo.AdminSet == nil {
+		return authZ.CanModifyContract(contractInfo.AdminAddr(), actor)
+	}
+	return canModifyContractWithAdminSet(authZ, *contractInfo.AdminSet, actor)
+}
+
+// canModifyContractWithAdminSet fails closed for policies that do not support admin sets
+func canModifyContractWithAdminSet(authZ types.AuthorizationPolicy, admins types.AdminSet, actor sdk.AccAddress) bool {
+	p, ok := authZ.(types.AdminSetAuthorizationPolicy)
+	return ok && p.CanModifyContractWithAdminSet(admins, actor)
+}
+
+// unauthorizedModification returns the error for an actor that can not modify the contract. Members of an admin set
+// with a threshold above 1 can only approve migrations, all other modifications of the contract require governance.
+func unauthorizedModification(contractInfo *types.ContractInfo, msg string) error {
+	if contractInfo.AdminSet != nil && contractInfo.AdminSet.Threshold > 1 {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s: admin set with threshold %d requires governance, members can only approve migrations", msg, contractInfo.AdminSet.Threshold)
+	}
+	return errorsmod.Wrap(sdkerrors.ErrUnauthorized, msg)
+}
@@ -116,6 +116,50 @@ func TestDefaultAuthzPolicyCanInstantiateContract(t *testing.T) {
 	}
 }
 
+func TestDefaultAuthzPolicyCanExecuteContract(t *testing.T) {
+	myActorAddress := RandomAccountAddress(t)
+	otherAddress := RandomAccountAddress(t)
+	specs := map[string]struct {
+		config types.AccessConfig
+		exp    bool
+		panics bool
+	}{
+		"nobody": {
+			config: types.AllowNobody,
+			exp:    false,
+		},
+		"everybody": {
+			config: types.AllowEverybody,
+			exp:    true,
+		},
+		"any address - included": {
+			config: types.AccessTypeAnyOfAddresses.With(otherAddress, myActorAddress),
+			exp:    true,
+		},
+		"any address - not included": {
+			config: types.AccessTypeAnyOfAddresses.With(otherAddress),
+			exp:    false,
+		},
+		"undefined config - panics": {
+			config: types.AccessConfig{},
+			panics: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			policy := DefaultAuthorizationPolicy{}
+			if !spec.panics {
+				got := policy.CanExecuteContract(spec.config, myActorAddress)
+				assert.Equal(t, spec.exp, got)
+				return
+			}
+			assert.Panics(t, func() {
+				policy.CanExecuteContract(spec.config, myActorAddress)
+			})
+		})
+	}
+}
+
 func TestDefaultAuthzPolicyCanModifyContract(t *testing.T) {
 	myActorAddress := RandomAccountAddress(t)
 	otherAddress := RandomAccountAddress(t)
@@ -145,6 +189,39 @@ func TestDefaultAuthzPolicyCanModifyContract(t *testing.T) {
 	}
 }
 
+func TestDefaultAuthzPolicyCanModifyContractWithAdminSet(t *testing.T) {
+	myActorAddress := RandomAccountAddress(t)
+	otherAddress := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		admins types.AdminSet
+		exp    bool
+	}{
+		"member with threshold 1": {
+			admins: types.AdminSet{Members: []string{otherAddress.String(), myActorAddress.String()}, Threshold: 1},
+			exp:    true,
+		},
+		"member with higher threshold": {
+			admins: types.AdminSet{Members: []string{otherAddress.String(), myActorAddress.String()}, Threshold: 2},
+			exp:    false,
+		},
+		"not a member": {
+			admins: types.AdminSet{Members: []string{otherAddress.String()}, Threshold: 1},
+			exp:    false,
+		},
+		"empty set": {
+			exp: false,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			policy := DefaultAuthorizationPolicy{}
+			got := policy.CanModifyContractWithAdminSet(spec.admins, myActorAddress)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
 func TestDefaultAuthzPolicyCanModifyCodeAccessConfig(t *testing.T) {
 	myActorAddress := RandomAccountAddress(t)
 	otherAddress := RandomAccountAddress(t)
@@ -267,6 +344,31 @@ func TestGovAuthzPolicyCanInstantiateContract(t *testing.T) {
 	}
 }
 
+func TestGovAuthzPolicyCanExecuteContract(t *testing.T) {
+	myActorAddress := RandomAccountAddress(t)
+	otherAddress := RandomAccountAddress(t)
+	specs := map[string]struct {
+		config types.AccessConfig
+	}{
+		"nobody": {
+			config: types.AllowNobody,
+		},
+		"everybody": {
+			config: types.AllowEverybody,
+		},
+		"any address - not included": {
+			config: types.AccessTypeAnyOfAddresses.With(otherAddress),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			policy := GovAuthorizationPolicy{}
+			got := policy.CanExecuteContract(spec.config, myActorAddress)
+			assert.True(t, got)
+		})
+	}
+}
+
 func TestGovAuthzPolicyCanModifyContract(t *testing.T) {
 	myActorAddress := RandomAccountAddress(t)
 	otherAddress := RandomAccountAddress(t)
@@ -291,6 +393,30 @@ func TestGovAuthzPolicyCanModifyContract(t *testing.T) {
 	}
 }
 
+func TestGovAuthzPolicyCanModifyContractWithAdminSet(t *testing.T) {
+	myActorAddress := RandomAccountAddress(t)
+	otherAddress := RandomAccountAddress(t)
+
+	specs := map[string]struct {
+		admins types.AdminSet
+	}{
+		"member with higher threshold": {
+			admins: types.AdminSet{Members: []string{otherAddress.String(), myActorAddress.String()}, Threshold: 2},
+		},
+		"not a member": {
+			admins: types.AdminSet{Members: []string{otherAddress.String()}, Threshold: 1},
+		},
+		"empty set": {},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			policy := GovAuthorizationPolicy{}
+			got := policy.CanModifyContractWithAdminSet(spec.admins, myActorAddress)
+			assert.True(t, got)
+		})
+	}
+}
+
 func TestGovAuthzPolicyCanModifyCodeAccessConfig(t *testing.T) {
 	myActorAddress := RandomAccountAddress(t)
 	otherAddress := RandomAccountAddress(t)
@@ -401,6 +527,59 @@ func TestPartialGovAuthorizationPolicyCanModifyContract(t *testing.T) {
 			policy := NewPartialGovAuthorizationPolicy(AlwaysRejectTestAuthZPolicy{}, spec.allowedAction)
 			got := policy.CanModifyContract(nil, nil)
 			assert.Equal(t, spec.exp, got)
+			got = policy.CanModifyContractWithAdminSet(types.AdminSet{}, nil)
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+}
+
+func TestCanModifyContract(t *testing.T) {
+	myActorAddress := RandomAccountAddress(t)
+	otherAddress := RandomAccountAddress(t)
+	adminSet := types.AdminSet{Members: []string{otherAddress.String(), myActorAddress.String()}, Threshold: 2}
+	// a policy without the admin set extension, like those of downstream chains
+	type legacyPolicy struct{ types.AuthorizationPolicy }
+
+	specs := map[string]struct {
+		policy       types.AuthorizationPolicy
+		contractInfo types.ContractInfo
+		exp          bool
+	}{
+		"admin": {
+			policy:       DefaultAuthorizationPolicy{},
+			contractInfo: types.ContractInfo{Admin: myActorAddress.String()},
+			exp:          true,
+		},
+		"not admin": {
+			policy:       DefaultAuthorizationPolicy{},
+			contractInfo: types.ContractInfo{Admin: otherAddress.String()},
+			exp:          false,
+		},
+		"admin set with higher threshold": {
+			policy:       DefaultAuthorizationPolicy{},
+			contractInfo: types.ContractInfo{AdminSet: &adminSet},
+			exp:          false,
+		},
+		"admin set with gov": {
+			policy:       GovAuthorizationPolicy{},
+			contractInfo: types.ContractInfo{AdminSet: &adminSet},
+			exp:          true,
+		},
+		"admin set with policy without extension": {
+			policy:       legacyPolicy{DefaultAuthorizationPolicy{}},
+			contractInfo: types.ContractInfo{AdminSet: &types.AdminSet{Members: []string{myActorAddress.String()}, Threshold: 1}},
+			exp:          false,
+		},
+		"admin set with gov policy without extension fails closed": {
+			policy:       legacyPolicy{GovAuthorizationPolicy{}},
+			contractInfo: types.ContractInfo{AdminSet: &adminSet},
+			exp:          false,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got := canModifyContract(spec.policy, &spec.contractInfo, myActorAddress)
+			assert.Equal(t, spec.exp, got)
 		})
 	}
 }
@@ -416,6 +595,10 @@ func TestPartialGovAuthorizationPolicyDelegatedOnly(t *testing.T) {
 		got = policy.CanModifyCodeAccessConfig(nil, nil, false)
 		exp = v.CanModifyCodeAccessConfig(nil, nil, false)
 		assert.Equal(t, exp, got)
+
+		got = policy.CanExecuteContract(types.AllowNobody, nil)
+		exp = v.CanExecuteContract(types.AllowNobody, nil)
+		assert.Equal(t, exp, got)
 	}
 }
 
@@ -439,6 +622,10 @@ func (a AlwaysRejectTestAuthZPolicy) CanInstantiateContract(c types.AccessConfig
 	return false
 }
 
+func (a AlwaysRejectTestAuthZPolicy) CanExecuteContract(c types.AccessConfig, actor sdk.AccAddress) bool {
+	return false
+}
+
 func (a AlwaysRejectTestAuthZPolicy) CanModifyContract(admin, actor sdk.AccAddress) bool {
 	return false
 }
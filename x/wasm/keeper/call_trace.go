@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// traceExitFn notifies the call tracer about the exit of a contract entrypoint call. The result data is optional.
+type traceExitFn func(callee *sdk.AccAddress, data *[]byte, err *error)
+
+func noopTraceExit(*sdk.AccAddress, *[]byte, *error) {}
+
+// traceCall notifies the call tracer about the entry of a contract entrypoint call and returns the context for the
+// call. The message is traced as JSON when it is not a byte slice already. The code ID is set from the contract info
+// of the callee when not set.
+// The returned exit function must be deferred, so that it also notifies the tracer about panics, like out of gas.
+// They are re-thrown.
+func (k Keeper) traceCall(ctx sdk.Context, call types.TracedCall, msg any) (sdk.Context, traceExitFn) {
+	if k.callTracer == nil {
+		return ctx, noopTraceExit
+	}
+	if call.CodeID == 0 && call.Callee != nil {
+		// tracing is a node local setting, so the lookup must not consume gas that would differ between nodes
+		if contractInfo := k.GetContractInfo(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), call.Callee); contractInfo != nil {
+			call.CodeID = contractInfo.CodeID
+		}
+	}
+	switch m := msg.(type) {
+	case []byte:
+		call.Msg = m
+	default:
+		bz, err := json.Marshal(m)
+		if err != nil {
+			panic(fmt.Sprintf("marshal traced message: %s", err))
+		}
+		call.Msg = bz
+	}
+	ctx = k.callTracer.OnEnter(ctx, call)
+	return ctx, func(callee *sdk.AccAddress, data *[]byte, err *error) {
+		if r := recover(); r != nil {
+			k.callTracer.OnExit(ctx, *callee, nil, fmt.Errorf("panic: %v", r))
+			panic(r)
+		}
+		var result []byte
+		if data != nil {
+			result = *data
+		}
+		k.callTracer.OnExit(ctx, *callee, result, *err)
+	}
+}
@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/debugsink"
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestCallTrace(t *testing.T) {
+	sink := debugsink.NewRingBuffer(10)
+	cdc := MakeEncodingConfig(t).Codec
+	ctx, keepers := CreateTestInput(t, false, ReflectCapabilities, WithMessageEncoders(reflectEncoders(cdc)), WithCallTracer(types.NewCallTraceExporter(sink)))
+	keeper := keepers.ContractKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, deposit...)
+	_, bob := keyPubAddr()
+
+	reflectID, _, err := keeper.Create(ctx, creator, testdata.ReflectContractWasm(), nil)
+	require.NoError(t, err)
+	reflectAddr, _, err := keeper.Instantiate(ctx, reflectID, creator, nil, []byte("{}"), "reflect", nil)
+	require.NoError(t, err)
+	hackatomCode, err := os.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	hackatomID, _, err := keeper.Create(ctx, creator, hackatomCode, nil)
+	require.NoError(t, err)
+	initMsgBz := HackatomExampleInitMsg{Verifier: reflectAddr, Beneficiary: bob}.GetBytes(t)
+	hackatomAddr, _, err := keeper.Instantiate(ctx, hackatomID, creator, nil, initMsgBz, "hackatom", nil)
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		call        func(ctx sdk.Context) error
+		txBytes     []byte
+		expContract sdk.AccAddress
+		expCodeID   uint64
+		expTrace    func(trace *types.CallTrace)
+	}{
+		"instantiate": {
+			call: func(ctx sdk.Context) error {
+				_, _, err := keeper.Instantiate(ctx, hackatomID, creator, nil, initMsgBz, "other", nil)
+				return err
+			},
+			txBytes:   []byte("myTx"),
+			expCodeID: hackatomID,
+			expTrace: func(trace *types.CallTrace) {
+				assert.Equal(t, types.TraceEntrypointInstantiate, trace.Entrypoint)
+				assert.Equal(t, creator.String(), trace.Caller)
+				assert.NotEmpty(t, trace.Callee)
+				assert.JSONEq(t, string(initMsgBz), string(trace.Msg))
+				assert.Empty(t, trace.Error)
+				assert.Empty(t, trace.Children)
+			},
+		},
+		"execute with failing sub message and reply": {
+			call: func(ctx sdk.Context) error {
+				_, err := keeper.Execute(ctx, reflectAddr, creator, mustMarshal(t, testdata.ReflectHandleMsg{
+					ReflectSubMsg: &testdata.ReflectSubPayload{Msgs: []wasmvmtypes.SubMsg{{
+						ID: 1,
+						Msg: wasmvmtypes.CosmosMsg{Wasm: &wasmvmtypes.WasmMsg{Execute: &wasmvmtypes.ExecuteMsg{
+							ContractAddr: hackatomAddr.String(),
+							Msg:          []byte(`{"unknown":{}}`),
+							Funds:        []wasmvmtypes.Coin{},
+						}}},
+						ReplyOn: wasmvmtypes.ReplyError,
+					}}},
+				}), nil)
+				return err
+			},
+			txBytes:     []byte("myTx"),
+			expContract: reflectAddr,
+			expCodeID:   reflectID,
+			expTrace: func(trace *types.CallTrace) {
+				assert.Equal(t, types.TraceEntrypointExecute, trace.Entrypoint)
+				assert.Equal(t, creator.String(), trace.Caller)
+				assert.Equal(t, reflectAddr.String(), trace.Callee)
+				require.Len(t, trace.Children, 2)
+
+				sub := trace.Children[0]
+				assert.Equal(t, types.TraceEntrypointExecute, sub.Entrypoint)
+				assert.Equal(t, reflectAddr.String(), sub.Caller)
+				assert.Equal(t, hackatomAddr.String(), sub.Callee)
+				assert.Equal(t, hackatomID, sub.CodeID)
+				assert.JSONEq(t, `{"unknown":{}}`, string(sub.Msg))
+				// the error is not redacted
+				assert.Contains(t, sub.Error, "unknown variant")
+
+				reply := trace.Children[1]
+				assert.Equal(t, types.TraceEntrypointReply, reply.Entrypoint)
+				assert.Equal(t, reflectAddr.String(), reply.Caller)
+				assert.Equal(t, reflectAddr.String(), reply.Callee)
+				assert.Empty(t, reply.Error)
+				assert.Greater(t, trace.GasUsed, sub.GasUsed+reply.GasUsed)
+			},
+		},
+		"query with sub query": {
+			call: func(ctx sdk.Context) error {
+				_, err := keepers.WasmKeeper.QuerySmart(ctx, reflectAddr, mustMarshal(t, testdata.ReflectQueryMsg{
+					Chain: &testdata.ChainQuery{Request: &wasmvmtypes.QueryRequest{Wasm: &wasmvmtypes.WasmQuery{
+						Smart: &wasmvmtypes.SmartQuery{ContractAddr: hackatomAddr.String(), Msg: []byte(`{"verifier":{}}`)},
+					}}},
+				}))
+				return err
+			},
+			expContract: reflectAddr,
+			expCodeID:   reflectID,
+			expTrace: func(trace *types.CallTrace) {
+				assert.Equal(t, types.TraceEntrypointQuery, trace.Entrypoint)
+				assert.Empty(t, trace.Caller)
+				assert.Equal(t, reflectAddr.String(), trace.Callee)
+				assert.NotEmpty(t, trace.Result)
+				require.Len(t, trace.Children, 1)
+
+				sub := trace.Children[0]
+				assert.Equal(t, types.TraceEntrypointQuery, sub.Entrypoint)
+				assert.Equal(t, reflectAddr.String(), sub.Caller)
+				assert.Equal(t, hackatomAddr.String(), sub.Callee)
+				assert.JSONEq(t, `{"verifier":{}}`, string(sub.Msg))
+				assert.NotEmpty(t, sub.Result)
+				assert.NotZero(t, sub.GasUsed)
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			ctx = ctx.WithTxBytes(spec.txBytes)
+			before, _ := sink.Records(0)
+
+			// when
+			require.NoError(t, spec.call(ctx))
+
+			// then a single record with the call tree is exported
+			var lastSeq uint64
+			if len(before) != 0 {
+				lastSeq = before[len(before)-1].Sequence
+			}
+			records, _ := sink.Records(lastSeq)
+			require.Len(t, records, 1)
+			got := records[0]
+			assert.Equal(t, ctx.BlockHeight(), got.Height)
+			assert.Equal(t, spec.expCodeID, got.CodeID)
+			if spec.expContract != nil {
+				assert.Equal(t, spec.expContract.String(), got.ContractAddress)
+			}
+			if spec.txBytes != nil {
+				assert.Equal(t, fmt.Sprintf("%X", cmttypes.Tx(spec.txBytes).Hash()), got.TxHash)
+			} else {
+				assert.Empty(t, got.TxHash)
+			}
+			var trace types.CallTrace
+			require.NoError(t, json.Unmarshal(got.Output, &trace))
+			assert.Equal(t, got.ContractAddress, trace.Callee)
+			assert.Equal(t, got.CodeID, trace.CodeID)
+			assert.NotZero(t, trace.GasUsed)
+			spec.expTrace(&trace)
+		})
+	}
+}
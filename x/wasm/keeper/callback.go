@@ -0,0 +1,352 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+const (
+	// CallbackGasLimit is the max gas that can be spent on executing a single callback
+	CallbackGasLimit storetypes.Gas = 1_000_000
+	// MaxCallbacksPerBlock is the max number of callbacks executed at the end of a block. Callbacks that are due
+	// but not executed are carried over to the next block.
+	MaxCallbacksPerBlock = 100
+	// MaxPendingCallbacksPerContract is the max number of callbacks that a contract can have scheduled at a time
+	MaxPendingCallbacksPerContract = 10
+)
+
+// CallbackEscrowAddress is the account that holds the callback deposits
+var CallbackEscrowAddress = sdk.AccAddress(address.Module(types.ModuleName, []byte("callback_deposit")))
+
+// NewCallbackMessageHandler handles the `CosmosMsg::Custom` messages of contracts to schedule or cancel callbacks
+// to themselves. Other custom messages are not handled.
+func NewCallbackMessageHandler(k *Keeper) MessageHandlerFunc {
+	return func(ctx sdk.Context, contractAddr sdk.AccAddress, _ string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
+		if msg.Custom == nil {
+			return nil, nil, nil, types.ErrUnknownMsg
+		}
+		var customMsg types.CallbackCustomMsg
+		if err := json.Unmarshal(msg.Custom, &customMsg); err != nil {
+			return nil, nil, nil, types.ErrUnknownMsg
+		}
+		switch {
+		case customMsg.ScheduleCallback != nil:
+			id, err := k.scheduleCallback(ctx, contractAddr, *customMsg.ScheduleCallback)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			bz, err := json.Marshal(types.ScheduleCallbackResponse{ID: id})
+			if err != nil {
+				return nil, nil, nil, errorsmod.Wrap(err, "schedule callback response")
+			}
+			return nil, [][]byte{bz}, nil, nil
+		case customMsg.CancelCallback != nil:
+			return nil, nil, nil, k.cancelCallback(ctx, contractAddr, customMsg.CancelCallback.ID)
+		default:
+			return nil, nil, nil, types.ErrUnknownMsg
+		}
+	}
+}
+
+// scheduleCallback stores a callback of the contract to itself and locks the deposit
+func (k Keeper) scheduleCallback(ctx sdk.Context, contractAddr sdk.AccAddress, msg types.ScheduleCallbackMsg) (uint64, error) {
+	if !k.HasContractInfo(ctx, contractAddr) {
+		return 0, types.ErrNoSuchContractFn(contractAddr.String()).Wrapf("address %s", contractAddr.String())
+	}
+	var pending int
+	k.IterateContractCallbacks(ctx, contractAddr, func(types.Callback) bool {
+		pending++
+		return pending >= MaxPendingCallbacksPerContract
+	})
+	if pending >= MaxPendingCallbacksPerContract {
+		return 0, errorsmod.Wrapf(types.ErrLimit, "max %d pending callbacks per contract", MaxPendingCallbacksPerContract)
+	}
+	switch {
+	case msg.Height != 0 && msg.Time != 0:
+		return 0, errorsmod.Wrap(types.ErrInvalid, "either height or time must be set")
+	case msg.Height != 0 && msg.Height <= uint64(ctx.BlockHeight()):
+		return 0, errorsmod.Wrapf(types.ErrInvalid, "height must be after current height %d", ctx.BlockHeight())
+	case msg.Time != 0 && uint64(msg.Time) <= uint64(ctx.BlockTime().UnixNano()):
+		return 0, errorsmod.Wrap(types.ErrInvalid, "time must be after current block time")
+	}
+	deposit := k.GetParams(ctx).CallbackDeposit
+	if deposit.IsZero() {
+		return 0, errorsmod.Wrap(types.ErrInvalid, "callbacks are disabled without callback deposit param")
+	}
+	callback := types.Callback{
+		ID:       k.mustAutoIncrementID(ctx, types.KeySequenceCallbackID),
+		Contract: contractAddr.String(),
+		Height:   msg.Height,
+		Time:     uint64(msg.Time),
+		Payload:  msg.Payload,
+		Deposit:  deposit,
+	}
+	if err := callback.ValidateBasic(); err != nil {
+		return 0, err
+	}
+	if err := k.bank.TransferCoins(ctx, contractAddr, CallbackEscrowAddress, callback.Deposit); err != nil {
+		return 0, errorsmod.Wrap(err, "callback deposit")
+	}
+	k.mustStoreCallback(ctx, callback)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeScheduleCallback,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, callback.Contract),
+		sdk.NewAttribute(types.AttributeKeyCallbackID, strconv.FormatUint(callback.ID, 10)),
+	))
+	return callback.ID, nil
+}
+
+// cancelCallback removes a pending callback of the contract and returns the deposit
+func (k Keeper) cancelCallback(ctx sdk.Context, contractAddr sdk.AccAddress, id uint64) error {
+	callback := k.GetCallback(ctx, contractAddr, id)
+	if callback == nil {
+		return types.ErrNotFound.Wrapf("callback %d", id)
+	}
+	if err := k.deleteCallback(ctx, contractAddr, *callback); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelCallback,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, callback.Contract),
+		sdk.NewAttribute(types.AttributeKeyCallbackID, strconv.FormatUint(callback.ID, 10)),
+	))
+	return nil
+}
+
+// deleteCallback removes the callback from the store and returns the deposit to the contract
+func (k Keeper) deleteCallback(ctx sdk.Context, contractAddr sdk.AccAddress, callback types.Callback) error {
+	if err := k.removeCallback(ctx, contractAddr, callback); err != nil {
+		return err
+	}
+	if callback.Deposit.IsZero() {
+		return nil
+	}
+	return errorsmod.Wrap(k.bank.TransferCoins(ctx, CallbackEscrowAddress, contractAddr, callback.Deposit), "callback deposit refund")
+}
+
+// removeCallback removes the callback from the store without returning the deposit
+func (k Keeper) removeCallback(ctx sdk.Context, contractAddr sdk.AccAddress, callback types.Callback) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetCallbackKey(contractAddr, callback.ID)); err != nil {
+		return err
+	}
+	return store.Delete(types.GetCallbackQueueKey(callback))
+}
+
+// removeContractCallbacks deletes all pending callbacks of the contract and returns the deposits
+func (k Keeper) removeContractCallbacks(ctx sdk.Context, contractAddr sdk.AccAddress) error {
+	var callbacks []types.Callback
+	k.IterateContractCallbacks(ctx, contractAddr, func(callback types.Callback) bool {
+		callbacks = append(callbacks, callback)
+		return false
+	})
+	for _, callback := range callbacks {
+		if err := k.deleteCallback(ctx, contractAddr, callback); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetCallback returns the pending callback of the contract or nil when not found
+func (k Keeper) GetCallback(ctx context.Context, contractAddr sdk.AccAddress, id uint64) *types.Callback {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.GetCallbackKey(contractAddr, id))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var callback types.Callback
+	k.cdc.MustUnmarshal(bz, &callback)
+	return &callback
+}
+
+// IterateContractCallbacks iterates over the pending callbacks of the contract ordered by id
+func (k Keeper) IterateContractCallbacks(ctx context.Context, contractAddr sdk.AccAddress, cb func(types.Callback) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetContractCallbacksPrefix(contractAddr))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var callback types.Callback
+		k.cdc.MustUnmarshal(iter.Value(), &callback)
+		// cb returns true to stop early
+		if cb(callback) {
+			return
+		}
+	}
+}
+
+// IterateCallbacks iterates over all pending callbacks
+func (k Keeper) IterateCallbacks(ctx context.Context, cb func(types.Callback) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.CallbackPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var callback types.Callback
+		k.cdc.MustUnmarshal(iter.Value(), &callback)
+		// cb returns true to stop early
+		if cb(callback) {
+			return
+		}
+	}
+}
+
+func (k Keeper) mustStoreCallback(ctx context.Context, callback types.Callback) {
+	contractAddr := sdk.MustAccAddressFromBech32(callback.Contract)
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.GetCallbackKey(contractAddr, callback.ID), k.cdc.MustMarshal(&callback)); err != nil {
+		panic(err)
+	}
+	if err := store.Set(types.GetCallbackQueueKey(callback), contractAddr); err != nil {
+		panic(err)
+	}
+}
+
+// importCallback stores a pending callback from genesis. The deposit must be held by the escrow account already.
+func (k Keeper) importCallback(ctx context.Context, callback types.Callback) error {
+	if err := callback.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(callback.Contract)
+	if err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if !k.HasContractInfo(ctx, contractAddr) {
+		return types.ErrNoSuchContractFn(callback.Contract).Wrapf("address %s", callback.Contract)
+	}
+	if k.GetCallback(ctx, contractAddr, callback.ID) != nil {
+		return errorsmod.Wrapf(types.ErrDuplicate, "callback: %d", callback.ID)
+	}
+	k.mustStoreCallback(ctx, callback)
+	return nil
+}
+
+// runDueCallbacks executes the callbacks that are due at the current block height or time.
+// Each callback runs with its own gas limit and a failure does not affect the other callbacks.
+func (k Keeper) runDueCallbacks(ctx sdk.Context) {
+	due := k.dueCallbacks(ctx, types.GetCallbackHeightQueuePrefix(), uint64(ctx.BlockHeight()), MaxCallbacksPerBlock)
+	due = append(due, k.dueCallbacks(ctx, types.GetCallbackTimeQueuePrefix(), uint64(ctx.BlockTime().UnixNano()), MaxCallbacksPerBlock-len(due))...)
+	for _, callback := range due {
+		k.executeCallback(ctx, callback)
+	}
+}
+
+// dueCallbacks returns up to limit callbacks from the queue with a position lower or equal to the given one.
+// Queue entries without a callback are logged and removed.
+func (k Keeper) dueCallbacks(ctx sdk.Context, queuePrefix []byte, pos uint64, limit int) []types.Callback {
+	if limit <= 0 {
+		return nil
+	}
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), queuePrefix)
+	var end []byte
+	if pos != ^uint64(0) {
+		end = sdk.Uint64ToBigEndian(pos + 1)
+	}
+	iter := prefixStore.Iterator(nil, end)
+
+	var (
+		r     []types.Callback
+		stale [][]byte
+	)
+	for ; iter.Valid() && len(r) < limit; iter.Next() {
+		id := sdk.BigEndianToUint64(iter.Key()[8:])
+		callback := k.GetCallback(ctx, iter.Value(), id)
+		if callback == nil {
+			k.Logger(ctx).Error("callback queue out of sync", "id", id, "contract", sdk.AccAddress(iter.Value()).String())
+			stale = append(stale, iter.Key())
+			continue
+		}
+		r = append(r, *callback)
+	}
+	iter.Close()
+	for _, key := range stale {
+		prefixStore.Delete(key)
+	}
+	return r
+}
+
+// executeCallback removes the callback from the store and calls the contract. The gas used is paid from the deposit
+// and the remainder is returned to the contract. When the deposit can not be settled, all changes are reverted and the
+// callback stays scheduled with the deposit in escrow.
+func (k Keeper) executeCallback(ctx sdk.Context, callback types.Callback) {
+	contractAddr := sdk.MustAccAddressFromBech32(callback.Contract)
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyContractAddr, callback.Contract),
+		sdk.NewAttribute(types.AttributeKeyCallbackID, strconv.FormatUint(callback.ID, 10)),
+	}
+	var gasUsed storetypes.Gas
+	cacheCtx, commit := ctx.CacheContext()
+	err := k.removeCallback(cacheCtx, contractAddr, callback)
+	if err == nil {
+		msg, _ := json.Marshal(types.CallbackSudoMsg{ScheduledCallback: &types.ScheduledCallback{ID: callback.ID, Payload: callback.Payload}})
+		gasUsed, err = k.sudoWithGasLimit(cacheCtx, contractAddr, msg, CallbackGasLimit)
+	}
+	fee := callbackFee(callback.Deposit, gasUsed)
+	if settleErr := k.settleCallbackDeposit(cacheCtx, contractAddr, callback.Deposit, fee); settleErr != nil {
+		k.Logger(ctx).Error("callback deposit", "id", callback.ID, "contract", callback.Contract, "error", settleErr)
+		err, fee = errorsmod.Wrap(settleErr, "callback deposit"), sdk.NewCoins()
+	} else {
+		commit()
+	}
+	attrs = append(attrs,
+		sdk.NewAttribute(types.AttributeKeyCronSuccess, strconv.FormatBool(err == nil)),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+		sdk.NewAttribute(types.AttributeKeyCallbackFee, fee.String()),
+	)
+	if err != nil {
+		k.Logger(ctx).Info("callback failed", "id", callback.ID, "contract", callback.Contract, "error", err)
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyCronError, redactError(err).Error()))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeCallback, attrs...))
+}
+
+// callbackFee returns the share of the deposit for the gas used of the callback gas limit, rounded up
+func callbackFee(deposit sdk.Coins, gasUsed storetypes.Gas) sdk.Coins {
+	if gasUsed >= CallbackGasLimit {
+		return deposit
+	}
+	fee := sdk.NewCoins()
+	for _, c := range deposit {
+		amount := c.Amount.Mul(sdkmath.NewIntFromUint64(gasUsed)).Add(sdkmath.NewIntFromUint64(CallbackGasLimit - 1)).Quo(sdkmath.NewIntFromUint64(CallbackGasLimit))
+		fee = fee.Add(sdk.NewCoin(c.Denom, amount))
+	}
+	return fee
+}
+
+// settleCallbackDeposit moves the fee from the escrow to the fee collector and returns the remaining deposit to the
+// contract. The whole deposit is collected when the contract does not exist anymore.
+func (k Keeper) settleCallbackDeposit(ctx sdk.Context, contractAddr sdk.AccAddress, deposit, fee sdk.Coins) error {
+	if !k.HasContractInfo(ctx, contractAddr) {
+		fee = deposit
+	}
+	if !fee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, CallbackEscrowAddress, authtypes.FeeCollectorName, fee); err != nil {
+			return errorsmod.Wrap(err, "callback fee")
+		}
+	}
+	if refund := deposit.Sub(fee...); !refund.IsZero() {
+		return errorsmod.Wrap(k.bank.TransferCoins(ctx, CallbackEscrowAddress, contractAddr, refund), "callback deposit refund")
+	}
+	return nil
+}
@@ -0,0 +1,285 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v2"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestCallbackMessageHandler(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	parentCtx = parentCtx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+
+	deposit := sdk.NewCoins(sdk.NewCoin("denom", sdkmath.NewInt(100)))
+	params := k.GetParams(parentCtx)
+	params.CallbackDeposit = deposit
+	require.NoError(t, k.SetParams(parentCtx, params))
+	keepers.Faucet.Fund(parentCtx, example.Contract, sdk.NewCoin("denom", sdkmath.NewInt(150)))
+
+	handler := NewCallbackMessageHandler(k)
+	specs := map[string]struct {
+		msg     any
+		expErr  bool
+		expSkip bool
+	}{
+		"schedule at height": {
+			msg: types.CallbackCustomMsg{ScheduleCallback: &types.ScheduleCallbackMsg{Height: 11, Payload: []byte("foo")}},
+		},
+		"schedule at time": {
+			msg: types.CallbackCustomMsg{ScheduleCallback: &types.ScheduleCallbackMsg{Time: 1000_000_000_001}},
+		},
+		"height and time": {
+			msg:    types.CallbackCustomMsg{ScheduleCallback: &types.ScheduleCallbackMsg{Height: 11, Time: 1000_000_000_001}},
+			expErr: true,
+		},
+		"height not in the future": {
+			msg:    types.CallbackCustomMsg{ScheduleCallback: &types.ScheduleCallbackMsg{Height: 10}},
+			expErr: true,
+		},
+		"time not in the future": {
+			msg:    types.CallbackCustomMsg{ScheduleCallback: &types.ScheduleCallbackMsg{Time: 1000_000_000_000}},
+			expErr: true,
+		},
+		"payload too big": {
+			msg:    types.CallbackCustomMsg{ScheduleCallback: &types.ScheduleCallbackMsg{Height: 11, Payload: make([]byte, types.MaxCallbackPayloadSize+1)}},
+			expErr: true,
+		},
+		"cancel unknown callback": {
+			msg:    types.CallbackCustomMsg{CancelCallback: &types.CancelCallbackMsg{ID: 1}},
+			expErr: true,
+		},
+		"other custom msg": {
+			msg:     map[string]any{"foo": map[string]any{}},
+			expSkip: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			bz, err := json.Marshal(spec.msg)
+			require.NoError(t, err)
+
+			// when
+			_, data, _, gotErr := handler(ctx, example.Contract, "", wasmvmtypes.CosmosMsg{Custom: bz})
+
+			// then
+			if spec.expSkip {
+				require.ErrorIs(t, gotErr, types.ErrUnknownMsg)
+				return
+			}
+			if spec.expErr {
+				require.Error(t, gotErr)
+				assert.True(t, keepers.BankKeeper.GetAllBalances(ctx, CallbackEscrowAddress).IsZero())
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Len(t, data, 1)
+			var resp types.ScheduleCallbackResponse
+			require.NoError(t, json.Unmarshal(data[0], &resp))
+			assert.Equal(t, uint64(1), resp.ID)
+			assert.NotNil(t, k.GetCallback(ctx, example.Contract, resp.ID))
+			assert.Equal(t, deposit, keepers.BankKeeper.GetAllBalances(ctx, CallbackEscrowAddress))
+		})
+	}
+}
+
+func TestScheduleCancelCallback(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+
+	deposit := sdk.NewCoins(sdk.NewCoin("denom", sdkmath.NewInt(100)))
+	params := k.GetParams(ctx)
+	params.CallbackDeposit = deposit
+	require.NoError(t, k.SetParams(ctx, params))
+	keepers.Faucet.Fund(ctx, example.Contract, sdk.NewCoin("denom", sdkmath.NewInt(150)))
+
+	// when
+	id, err := k.scheduleCallback(ctx, example.Contract, types.ScheduleCallbackMsg{Height: uint64(ctx.BlockHeight()) + 1})
+	require.NoError(t, err)
+	// then
+	assert.Equal(t, uint64(1), id)
+	assert.Equal(t, sdkmath.NewInt(50), keepers.BankKeeper.GetBalance(ctx, example.Contract, "denom").Amount)
+	// and deposit must be paid
+	_, err = k.scheduleCallback(ctx, example.Contract, types.ScheduleCallbackMsg{Height: uint64(ctx.BlockHeight()) + 1})
+	require.Error(t, err)
+
+	// when cancelled
+	require.NoError(t, k.cancelCallback(ctx, example.Contract, id))
+	// then
+	assert.Nil(t, k.GetCallback(ctx, example.Contract, id))
+	assert.Equal(t, sdkmath.NewInt(150), keepers.BankKeeper.GetBalance(ctx, example.Contract, "denom").Amount)
+	require.ErrorIs(t, k.cancelCallback(ctx, example.Contract, id), types.ErrNotFound)
+
+	// and when the max pending callbacks are reached
+	keepers.Faucet.Fund(ctx, example.Contract, sdk.NewCoin("denom", sdkmath.NewInt(100*MaxPendingCallbacksPerContract)))
+	for i := 0; i < MaxPendingCallbacksPerContract; i++ {
+		_, err = k.scheduleCallback(ctx, example.Contract, types.ScheduleCallbackMsg{Height: uint64(ctx.BlockHeight()) + 1})
+		require.NoError(t, err)
+	}
+	_, err = k.scheduleCallback(ctx, example.Contract, types.ScheduleCallbackMsg{Height: uint64(ctx.BlockHeight()) + 1})
+	// then
+	require.ErrorIs(t, err, types.ErrLimit)
+
+	// and when the deposit param is empty
+	params.CallbackDeposit = nil
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err = k.scheduleCallback(ctx, RandomAccountAddress(t), types.ScheduleCallbackMsg{Height: uint64(ctx.BlockHeight()) + 1})
+	// then
+	require.Error(t, err)
+}
+
+func TestRunDueCallbacks(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	parentCtx = parentCtx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0))
+
+	params := k.GetParams(parentCtx)
+	params.CallbackDeposit = sdk.NewCoins(sdk.NewCoin("denom", sdkmath.NewInt(100)))
+	require.NoError(t, k.SetParams(parentCtx, params))
+	keepers.Faucet.Fund(parentCtx, example.Contract, sdk.NewCoin("denom", sdkmath.NewInt(500)))
+
+	mock.SudoFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		var msg types.CallbackSudoMsg
+		require.NoError(t, json.Unmarshal(sudoMsg, &msg))
+		require.NotNil(t, msg.ScheduledCallback)
+		store.Set(msg.ScheduledCallback.Payload, []byte("called"))
+		if string(msg.ScheduledCallback.Payload) == "fail" {
+			return &wasmvmtypes.ContractResult{Err: "failed"}, 0, nil
+		}
+		return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 0, nil
+	}
+	for _, msg := range []types.ScheduleCallbackMsg{
+		{Height: 11, Payload: []byte("fail")},
+		{Height: 11, Payload: []byte("at-height")},
+		{Height: 12, Payload: []byte("later-height")},
+		{Time: wasmvmtypes.Uint64(time.Unix(1005, 0).UnixNano()), Payload: []byte("at-time")},
+		{Time: wasmvmtypes.Uint64(time.Unix(1010, 0).UnixNano()), Payload: []byte("later-time")},
+	} {
+		_, err := k.scheduleCallback(parentCtx, example.Contract, msg)
+		require.NoError(t, err)
+	}
+	ctx := parentCtx.WithBlockHeight(11).WithBlockTime(time.Unix(1005, 0)).
+		WithEventManager(sdk.NewEventManager()).WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	// when
+	require.NoError(t, EndBlocker(ctx, k))
+
+	// then
+	assert.Equal(t, []byte("called"), k.QueryRaw(ctx, example.Contract, []byte("at-height")))
+	assert.Equal(t, []byte("called"), k.QueryRaw(ctx, example.Contract, []byte("at-time")))
+	assert.Nil(t, k.QueryRaw(ctx, example.Contract, []byte("fail")))
+	assert.Nil(t, k.QueryRaw(ctx, example.Contract, []byte("later-height")))
+	assert.Nil(t, k.QueryRaw(ctx, example.Contract, []byte("later-time")))
+
+	var pending []uint64
+	k.IterateContractCallbacks(ctx, example.Contract, func(callback types.Callback) bool {
+		pending = append(pending, callback.ID)
+		return false
+	})
+	assert.Equal(t, []uint64{3, 5}, pending)
+
+	var callbackEvents []sdk.Event
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == types.EventTypeCallback {
+			callbackEvents = append(callbackEvents, e)
+		}
+	}
+	require.Len(t, callbackEvents, 3)
+	assert.Equal(t, "1", callbackEvents[0].Attributes[1].Value)
+	assert.Equal(t, "false", callbackEvents[0].Attributes[2].Value)
+	assert.Equal(t, "true", callbackEvents[1].Attributes[2].Value)
+	assert.Equal(t, "true", callbackEvents[2].Attributes[2].Value)
+
+	// and the gas used is paid from the deposits
+	fees := keepers.BankKeeper.GetBalance(ctx, keepers.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName), "denom").Amount
+	assert.True(t, fees.IsPositive())
+	assert.True(t, fees.LTE(sdkmath.NewInt(300)))
+	assert.Equal(t, sdkmath.NewInt(200), keepers.BankKeeper.GetBalance(ctx, CallbackEscrowAddress, "denom").Amount)
+	assert.Equal(t, sdkmath.NewInt(300).Sub(fees), keepers.BankKeeper.GetBalance(ctx, example.Contract, "denom").Amount)
+	eventFees := sdk.NewCoins()
+	for _, e := range callbackEvents {
+		fee, err := sdk.ParseCoinsNormalized(e.Attributes[4].Value)
+		require.NoError(t, err)
+		eventFees = eventFees.Add(fee...)
+	}
+	assert.Equal(t, sdk.NewCoins(sdk.NewCoin("denom", fees)), eventFees)
+}
+
+func TestRunDueCallbacksWithBrokenState(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	parentCtx = parentCtx.WithBlockHeight(10)
+
+	deposit := sdk.NewCoins(sdk.NewCoin("denom", sdkmath.NewInt(100)))
+	params := k.GetParams(parentCtx)
+	params.CallbackDeposit = deposit
+	require.NoError(t, k.SetParams(parentCtx, params))
+	keepers.Faucet.Fund(parentCtx, example.Contract, deposit...)
+	id, err := k.scheduleCallback(parentCtx, example.Contract, types.ScheduleCallbackMsg{Height: 11, Payload: []byte("foo")})
+	require.NoError(t, err)
+
+	mock.SudoFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		store.Set([]byte("foo"), []byte("called"))
+		return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 0, nil
+	}
+
+	t.Run("queue entry without callback", func(t *testing.T) {
+		ctx, _ := parentCtx.WithBlockHeight(11).CacheContext()
+		staleKey := types.GetCallbackQueueKey(types.Callback{ID: id + 1, Height: 11})
+		ctx.KVStore(keepers.WasmStoreKey).Set(staleKey, example.Contract)
+
+		// when
+		require.NoError(t, EndBlocker(ctx, k))
+
+		// then
+		assert.False(t, ctx.KVStore(keepers.WasmStoreKey).Has(staleKey))
+		assert.Nil(t, k.GetCallback(ctx, example.Contract, id))
+		assert.Equal(t, []byte("called"), k.QueryRaw(ctx, example.Contract, []byte("foo")))
+	})
+	t.Run("deposit not settled", func(t *testing.T) {
+		ctx, _ := parentCtx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager()).CacheContext()
+		require.NoError(t, keepers.BankKeeper.SendCoins(ctx, CallbackEscrowAddress, RandomAccountAddress(t), deposit))
+
+		// when
+		require.NoError(t, EndBlocker(ctx, k))
+
+		// then the callback is reverted and stays scheduled
+		assert.NotNil(t, k.GetCallback(ctx, example.Contract, id))
+		assert.Nil(t, k.QueryRaw(ctx, example.Contract, []byte("foo")))
+		var callbackEvents []sdk.Event
+		for _, e := range ctx.EventManager().Events() {
+			if e.Type == types.EventTypeCallback {
+				callbackEvents = append(callbackEvents, e)
+			}
+		}
+		require.Len(t, callbackEvents, 1)
+		assert.Equal(t, "false", callbackEvents[0].Attributes[2].Value)
+	})
+}
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"context"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// dependencyRecorder records calls between contracts
+type dependencyRecorder interface {
+	recordContractDependency(ctx sdk.Context, caller sdk.AccAddress, callee string)
+}
+
+// recordMsgDependency records the call to another contract for wasm execute and migrate messages
+func recordMsgDependency(ctx sdk.Context, r dependencyRecorder, caller sdk.AccAddress, msg wasmvmtypes.CosmosMsg) {
+	if r == nil || msg.Wasm == nil {
+		return
+	}
+	switch {
+	case msg.Wasm.Execute != nil:
+		r.recordContractDependency(ctx, caller, msg.Wasm.Execute.ContractAddr)
+	case msg.Wasm.Migrate != nil:
+		r.recordContractDependency(ctx, caller, msg.Wasm.Migrate.ContractAddr)
+	}
+}
+
+// recordContractDependency increments the message count of the calls from caller to callee and sets the current
+// height as last seen. Invalid callee addresses and addresses without a contract are ignored.
+func (k Keeper) recordContractDependency(ctx sdk.Context, caller sdk.AccAddress, callee string) {
+	calleeAddr, err := sdk.AccAddressFromBech32(callee)
+	if err != nil || !k.HasContractInfo(ctx, calleeAddr) {
+		return
+	}
+	dependency := k.GetContractDependency(ctx, caller, calleeAddr)
+	if dependency == nil {
+		dependency = &types.ContractDependency{Caller: caller.String(), Callee: calleeAddr.String()}
+	}
+	dependency.MessageCount++
+	dependency.LastHeight = ctx.BlockHeight()
+	k.mustStoreContractDependency(ctx, *dependency)
+}
+
+func (k Keeper) mustStoreContractDependency(ctx context.Context, dependency types.ContractDependency) {
+	caller, callee := sdk.MustAccAddressFromBech32(dependency.Caller), sdk.MustAccAddressFromBech32(dependency.Callee)
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.GetContractDependencyKey(caller, callee), k.cdc.MustMarshal(&dependency)); err != nil {
+		panic(err)
+	}
+	if err := store.Set(types.GetContractDependentKey(callee, caller), []byte{}); err != nil {
+		panic(err)
+	}
+}
+
+// importContractDependency stores the recorded calls between two contracts from genesis
+func (k Keeper) importContractDependency(ctx context.Context, dependency types.ContractDependency) error {
+	if err := dependency.ValidateBasic(); err != nil {
+		return err
+	}
+	caller, callee := sdk.MustAccAddressFromBech32(dependency.Caller), sdk.MustAccAddressFromBech32(dependency.Callee)
+	for _, addr := range []sdk.AccAddress{caller, callee} {
+		if !k.HasContractInfo(ctx, addr) {
+			return types.ErrNoSuchContractFn(addr.String()).Wrapf("address %s", addr.String())
+		}
+	}
+	if k.GetContractDependency(ctx, caller, callee) != nil {
+		return errorsmod.Wrapf(types.ErrDuplicate, "contract dependency: %s -> %s", dependency.Caller, dependency.Callee)
+	}
+	k.mustStoreContractDependency(ctx, dependency)
+	return nil
+}
+
+// GetContractDependency returns the recorded calls from caller to callee or nil when none were recorded
+func (k Keeper) GetContractDependency(ctx context.Context, caller, callee sdk.AccAddress) *types.ContractDependency {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.GetContractDependencyKey(caller, callee))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var dependency types.ContractDependency
+	k.cdc.MustUnmarshal(bz, &dependency)
+	return &dependency
+}
+
+// IterateContractDependencies iterates over the recorded calls of the caller contract ordered by callee address
+func (k Keeper) IterateContractDependencies(ctx context.Context, caller sdk.AccAddress, cb func(types.ContractDependency) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetContractDependenciesPrefix(caller))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var dependency types.ContractDependency
+		k.cdc.MustUnmarshal(iter.Value(), &dependency)
+		if cb(dependency) {
+			return
+		}
+	}
+}
+
+// IterateAllContractDependencies iterates over the recorded calls of all contracts ordered by caller and callee address
+func (k Keeper) IterateAllContractDependencies(ctx context.Context, cb func(types.ContractDependency) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ContractDependencyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var dependency types.ContractDependency
+		k.cdc.MustUnmarshal(iter.Value(), &dependency)
+		if cb(dependency) {
+			return
+		}
+	}
+}
+
+// IterateContractDependents iterates over the recorded calls from other contracts to the callee ordered by caller
+// address
+func (k Keeper) IterateContractDependents(ctx context.Context, callee sdk.AccAddress, cb func(types.ContractDependency) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetContractDependentsPrefix(callee))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		dependency := k.GetContractDependency(ctx, iter.Key(), callee)
+		if dependency == nil {
+			continue
+		}
+		if cb(*dependency) {
+			return
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"os"
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestContractDependencyTracking(t *testing.T) {
+	specs := map[string]struct {
+		opts   []Option
+		expDep *types.ContractDependency
+	}{
+		"enabled": {
+			opts:   []Option{WithContractDependencyTracking()},
+			expDep: &types.ContractDependency{MessageCount: 1},
+		},
+		"disabled": {},
+	}
+	gasUsed := make(map[string]uint64, len(specs))
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			cdc := MakeEncodingConfig(t).Codec
+			opts := append([]Option{WithMessageEncoders(reflectEncoders(cdc))}, spec.opts...)
+			ctx, keepers := CreateTestInput(t, false, ReflectCapabilities, opts...)
+			keeper := keepers.ContractKeeper
+
+			deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+			creator := keepers.Faucet.NewFundedRandomAccount(ctx, deposit...)
+			_, bob := keyPubAddr()
+
+			reflectID, _, err := keeper.Create(ctx, creator, testdata.ReflectContractWasm(), nil)
+			require.NoError(t, err)
+			reflectAddr, _, err := keeper.Instantiate(ctx, reflectID, creator, nil, []byte("{}"), "reflect", nil)
+			require.NoError(t, err)
+
+			hackatomCode, err := os.ReadFile("./testdata/hackatom.wasm")
+			require.NoError(t, err)
+			hackatomID, _, err := keeper.Create(ctx, creator, hackatomCode, nil)
+			require.NoError(t, err)
+			initMsgBz := HackatomExampleInitMsg{Verifier: reflectAddr, Beneficiary: bob}.GetBytes(t)
+			hackatomAddr, _, err := keeper.Instantiate(ctx, hackatomID, creator, nil, initMsgBz, "hackatom", nil)
+			require.NoError(t, err)
+
+			// when the reflect contract executes the hackatom contract
+			reflectSendBz := mustMarshal(t, testdata.ReflectHandleMsg{
+				Reflect: &testdata.ReflectPayload{Msgs: []wasmvmtypes.CosmosMsg{{
+					Wasm: &wasmvmtypes.WasmMsg{Execute: &wasmvmtypes.ExecuteMsg{
+						ContractAddr: hackatomAddr.String(),
+						Msg:          []byte(`{"release":{}}`),
+						Funds:        []wasmvmtypes.Coin{},
+					}},
+				}}},
+			})
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+			gasBefore := ctx.GasMeter().GasConsumed()
+			_, err = keeper.Execute(ctx, reflectAddr, creator, reflectSendBz, nil)
+			require.NoError(t, err)
+			gasUsed[name] = ctx.GasMeter().GasConsumed() - gasBefore
+
+			// and queries the hackatom contract
+			queries := []wasmvmtypes.QueryRequest{
+				{Wasm: &wasmvmtypes.WasmQuery{Smart: &wasmvmtypes.SmartQuery{ContractAddr: hackatomAddr.String(), Msg: []byte(`{"verifier":{}}`)}}},
+				{Wasm: &wasmvmtypes.WasmQuery{Raw: &wasmvmtypes.RawQuery{ContractAddr: hackatomAddr.String(), Key: []byte("config")}}},
+				{Wasm: &wasmvmtypes.WasmQuery{Raw: &wasmvmtypes.RawQuery{ContractAddr: bob.String(), Key: []byte("config")}}},
+			}
+			for _, q := range queries {
+				_, err = keepers.WasmKeeper.QuerySmart(ctx, reflectAddr, mustMarshal(t, testdata.ReflectQueryMsg{
+					Chain: &testdata.ChainQuery{Request: &q},
+				}))
+				require.NoError(t, err)
+			}
+
+			// then
+			gotDep := keepers.WasmKeeper.GetContractDependency(ctx, reflectAddr, hackatomAddr)
+			if spec.expDep == nil {
+				assert.Nil(t, gotDep)
+				return
+			}
+			exp := *spec.expDep
+			exp.Caller, exp.Callee, exp.LastHeight = reflectAddr.String(), hackatomAddr.String(), ctx.BlockHeight()
+			require.NotNil(t, gotDep)
+			assert.Equal(t, exp, *gotDep)
+			assert.Nil(t, keepers.WasmKeeper.GetContractDependency(ctx, hackatomAddr, reflectAddr))
+			// and queries or calls to addresses without a contract are not recorded
+			assert.Nil(t, keepers.WasmKeeper.GetContractDependency(ctx, reflectAddr, bob))
+
+			// and the edges are returned for both directions
+			q := Querier(keepers.WasmKeeper)
+			gotCallees, err := q.ContractDependencies(ctx, &types.QueryContractDependenciesRequest{Address: reflectAddr.String()})
+			require.NoError(t, err)
+			assert.Equal(t, []types.ContractDependency{exp}, gotCallees.Dependencies)
+			gotCallers, err := q.ContractDependencies(ctx, &types.QueryContractDependenciesRequest{Address: hackatomAddr.String(), Callers: true})
+			require.NoError(t, err)
+			assert.Equal(t, []types.ContractDependency{exp}, gotCallers.Dependencies)
+			gotNone, err := q.ContractDependencies(ctx, &types.QueryContractDependenciesRequest{Address: hackatomAddr.String()})
+			require.NoError(t, err)
+			assert.Empty(t, gotNone.Dependencies)
+
+			// and when the callee is purged
+			_, err = keepers.WasmKeeper.purgeContract(ctx, hackatomAddr, creator, creator, true, NewGovAuthorizationPolicy())
+			require.NoError(t, err)
+			// then the edges are removed
+			assert.Nil(t, keepers.WasmKeeper.GetContractDependency(ctx, reflectAddr, hackatomAddr))
+			gotCallees, err = q.ContractDependencies(ctx, &types.QueryContractDependenciesRequest{Address: reflectAddr.String()})
+			require.NoError(t, err)
+			assert.Empty(t, gotCallees.Dependencies)
+		})
+	}
+	// the recording is charged to the calling contract
+	assert.Greater(t, gasUsed["enabled"], gasUsed["disabled"])
+}
@@ -29,7 +29,7 @@ type decoratedKeeper interface {
 	setContractAdmin(ctx context.Context, contractAddress, caller, newAdmin sdk.AccAddress, authZ types.AuthorizationPolicy) error
 	pinCode(ctx context.Context, codeID uint64) error
 	unpinCode(ctx context.Context, codeID uint64) error
-	execute(ctx context.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error)
+	execute(ctx context.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins, authZ types.AuthorizationPolicy) ([]byte, error)
 	Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
 	setContractInfoExtension(ctx context.Context, contract sdk.AccAddress, extra types.ContractInfoExtension) error
 	setAccessConfig(ctx context.Context, codeID uint64, caller sdk.AccAddress, newConfig types.AccessConfig, autz types.AuthorizationPolicy) error
@@ -94,7 +94,7 @@ func (p PermissionedKeeper) Instantiate2(
 }
 
 func (p PermissionedKeeper) Execute(ctx sdk.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error) {
-	return p.nested.execute(ctx, contractAddress, caller, msg, coins)
+	return p.nested.execute(ctx, contractAddress, caller, msg, coins, p.authZPolicy)
 }
 
 func (p PermissionedKeeper) Migrate(ctx sdk.Context, contractAddress, caller sdk.AccAddress, newCodeID uint64, msg []byte) ([]byte, error) {
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// setContractMetadata replaces the metadata of a contract. Empty metadata removes the existing one.
+// The contract creator or the caller authorized to modify the contract can set the metadata.
+func (k Keeper) setContractMetadata(ctx context.Context, contractAddr, caller sdk.AccAddress, metadata types.ContractMetadata, authZ types.AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if contractInfo.Creator != caller.String() && !canModifyContract(authZ, contractInfo, caller) {
+		return unauthorizedModification(contractInfo, "can not modify contract")
+	}
+	if err := metadata.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "metadata")
+	}
+	if err := k.storeContractMetadata(ctx, contractAddr, metadata); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetContractMetadata,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+	))
+	return nil
+}
+
+// GetContractMetadata returns the metadata of the contract or nil when not set
+func (k Keeper) GetContractMetadata(ctx context.Context, contractAddr sdk.AccAddress) *types.ContractMetadata {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.GetContractMetadataKey(contractAddr))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var metadata types.ContractMetadata
+	k.cdc.MustUnmarshal(bz, &metadata)
+	return &metadata
+}
+
+// importContractMetadata sets the metadata of an imported contract
+func (k Keeper) importContractMetadata(ctx context.Context, contractAddr sdk.AccAddress, metadata types.ContractMetadata) error {
+	if !k.HasContractInfo(ctx, contractAddr) {
+		return errorsmod.Wrapf(types.ErrNotFound, "contract: %s", contractAddr)
+	}
+	if err := metadata.ValidateBasic(); err != nil {
+		return err
+	}
+	return k.storeContractMetadata(ctx, contractAddr, metadata)
+}
+
+// storeContractMetadata stores the metadata or deletes it when empty
+func (k Keeper) storeContractMetadata(ctx context.Context, contractAddr sdk.AccAddress, metadata types.ContractMetadata) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if metadata.IsEmpty() {
+		return store.Delete(types.GetContractMetadataKey(contractAddr))
+	}
+	return store.Set(types.GetContractMetadataKey(contractAddr), k.cdc.MustMarshal(&metadata))
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestSetContractMetadata(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	creator := example.CreatorAddr
+	admin := RandomAccountAddress(t)
+	require.NoError(t, keepers.ContractKeeper.UpdateContractAdmin(ctx, example.Contract, creator, admin))
+	policy := DefaultAuthorizationPolicy{}
+
+	metadata := types.ContractMetadata{Name: "foo", Homepage: "https://example.com"}
+	// when unauthorized
+	err := k.setContractMetadata(ctx, example.Contract, RandomAccountAddress(t), metadata, policy)
+	// then
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	assert.Nil(t, k.GetContractMetadata(ctx, example.Contract))
+
+	// when set by the creator
+	em := sdk.NewEventManager()
+	require.NoError(t, k.setContractMetadata(ctx.WithEventManager(em), example.Contract, creator, metadata, policy))
+	// then
+	assert.Equal(t, &metadata, k.GetContractMetadata(ctx, example.Contract))
+	require.Len(t, em.Events(), 1)
+	assert.Equal(t, types.EventTypeSetContractMetadata, em.Events()[0].Type)
+
+	// when replaced by the admin
+	metadata = types.ContractMetadata{Name: "bar", AuditLinks: []string{"https://example.com/audit"}}
+	require.NoError(t, k.setContractMetadata(ctx, example.Contract, admin, metadata, policy))
+	// then
+	assert.Equal(t, &metadata, k.GetContractMetadata(ctx, example.Contract))
+	gotRsp, err := Querier(k).ContractMetadata(ctx, &types.QueryContractMetadataRequest{Address: example.Contract.String()})
+	require.NoError(t, err)
+	assert.Equal(t, metadata, gotRsp.Metadata)
+
+	// when invalid
+	err = k.setContractMetadata(ctx, example.Contract, admin, types.ContractMetadata{Homepage: "example.com"}, policy)
+	// then
+	require.ErrorIs(t, err, types.ErrInvalid)
+	assert.Equal(t, &metadata, k.GetContractMetadata(ctx, example.Contract))
+
+	// when set empty
+	require.NoError(t, k.setContractMetadata(ctx, example.Contract, admin, types.ContractMetadata{}, policy))
+	// then
+	assert.Nil(t, k.GetContractMetadata(ctx, example.Contract))
+	_, err = Querier(k).ContractMetadata(ctx, &types.QueryContractMetadataRequest{Address: example.Contract.String()})
+	require.ErrorIs(t, err, types.ErrNotFound)
+
+	// when the contract does not exist
+	err = k.setContractMetadata(ctx, RandomAccountAddress(t), creator, metadata, policy)
+	// then
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
@@ -0,0 +1,231 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+const (
+	// MaxCronSchedules is the max number of cron schedules that can be stored
+	MaxCronSchedules = 100
+	// MaxCronGasPerBlock is the max gas that the schedules due in a block can spend together. A schedule is skipped
+	// when its gas limit exceeds the remaining budget.
+	MaxCronGasPerBlock storetypes.Gas = 50_000_000
+)
+
+// errCronGasBudgetExceeded is recorded as run error of the schedules that were skipped in a block
+var errCronGasBudgetExceeded = errorsmod.Wrap(types.ErrLimit, "cron gas budget of the block exceeded")
+
+// addCronSchedule stores a new contract call that is executed at the end of every interval blocks
+func (k Keeper) addCronSchedule(ctx context.Context, schedule types.CronSchedule) error {
+	if err := schedule.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(schedule.Contract)
+	if err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if !k.HasContractInfo(ctx, contractAddr) {
+		return types.ErrNoSuchContractFn(schedule.Contract).Wrapf("address %s", schedule.Contract)
+	}
+	if k.GetCronSchedule(ctx, schedule.Name) != nil {
+		return errorsmod.Wrapf(types.ErrDuplicate, "cron schedule: %s", schedule.Name)
+	}
+	var count int
+	k.IterateCronSchedules(ctx, func(types.CronSchedule) bool {
+		count++
+		return count >= MaxCronSchedules
+	})
+	if count >= MaxCronSchedules {
+		return errorsmod.Wrapf(types.ErrLimit, "max %d cron schedules", MaxCronSchedules)
+	}
+	schedule.LastRun = nil
+	k.mustStoreCronSchedule(ctx, schedule)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeAddCronSchedule,
+		sdk.NewAttribute(types.AttributeKeyCronName, schedule.Name),
+		sdk.NewAttribute(types.AttributeKeyContractAddr, schedule.Contract),
+	))
+	return nil
+}
+
+// removeCronSchedule deletes a scheduled contract call
+func (k Keeper) removeCronSchedule(ctx context.Context, name string) error {
+	schedule := k.GetCronSchedule(ctx, name)
+	if schedule == nil {
+		return types.ErrNotFound.Wrapf("cron schedule: %s", name)
+	}
+	if err := k.storeService.OpenKVStore(ctx).Delete(types.GetCronScheduleKey(name)); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRemoveCronSchedule,
+		sdk.NewAttribute(types.AttributeKeyCronName, name),
+		sdk.NewAttribute(types.AttributeKeyContractAddr, schedule.Contract),
+	))
+	return nil
+}
+
+// removeContractCronSchedules deletes all scheduled calls of the given contract
+func (k Keeper) removeContractCronSchedules(ctx context.Context, contractAddr sdk.AccAddress) error {
+	var names []string
+	k.IterateCronSchedules(ctx, func(schedule types.CronSchedule) bool {
+		if schedule.Contract == contractAddr.String() {
+			names = append(names, schedule.Name)
+		}
+		return false
+	})
+	for _, name := range names {
+		if err := k.removeCronSchedule(ctx, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetCronSchedule returns the scheduled contract call for the name or nil when not found
+func (k Keeper) GetCronSchedule(ctx context.Context, name string) *types.CronSchedule {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.GetCronScheduleKey(name))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var schedule types.CronSchedule
+	k.cdc.MustUnmarshal(bz, &schedule)
+	return &schedule
+}
+
+// IterateCronSchedules iterates over all scheduled contract calls ordered by name
+func (k Keeper) IterateCronSchedules(ctx context.Context, cb func(types.CronSchedule) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.CronSchedulePrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var schedule types.CronSchedule
+		k.cdc.MustUnmarshal(iter.Value(), &schedule)
+		// cb returns true to stop early
+		if cb(schedule) {
+			return
+		}
+	}
+}
+
+func (k Keeper) mustStoreCronSchedule(ctx context.Context, schedule types.CronSchedule) {
+	if err := k.storeService.OpenKVStore(ctx).Set(types.GetCronScheduleKey(schedule.Name), k.cdc.MustMarshal(&schedule)); err != nil {
+		panic(err)
+	}
+}
+
+// importCronSchedule stores a scheduled contract call from genesis, including the last run
+func (k Keeper) importCronSchedule(ctx context.Context, schedule types.CronSchedule) error {
+	if err := schedule.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(schedule.Contract)
+	if err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if !k.HasContractInfo(ctx, contractAddr) {
+		return types.ErrNoSuchContractFn(schedule.Contract).Wrapf("address %s", schedule.Contract)
+	}
+	if k.GetCronSchedule(ctx, schedule.Name) != nil {
+		return errorsmod.Wrapf(types.ErrDuplicate, "cron schedule: %s", schedule.Name)
+	}
+	k.mustStoreCronSchedule(ctx, schedule)
+	return nil
+}
+
+// runCronSchedules executes all scheduled contract calls that are due at the current block height.
+// Each call runs with its own gas limit and a failure does not affect the other calls. The calls are executed in name
+// order as long as their gas limit fits into the remaining gas budget of the block, see MaxCronGasPerBlock.
+func (k Keeper) runCronSchedules(ctx sdk.Context) {
+	var due []types.CronSchedule
+	k.IterateCronSchedules(ctx, func(schedule types.CronSchedule) bool {
+		if schedule.IsDue(ctx.BlockHeight()) {
+			due = append(due, schedule)
+		}
+		return false
+	})
+	var gasSpent storetypes.Gas
+	for _, schedule := range due {
+		run := types.CronRun{Height: ctx.BlockHeight(), Error: errCronGasBudgetExceeded.Error()}
+		if gasSpent+schedule.GasLimit <= MaxCronGasPerBlock {
+			run = k.executeCronSchedule(ctx, schedule)
+			gasSpent += run.GasUsed
+		} else {
+			k.Logger(ctx).Info("cron schedule skipped", "name", schedule.Name, "contract", schedule.Contract, "error", errCronGasBudgetExceeded)
+		}
+		schedule.LastRun = &run
+		k.mustStoreCronSchedule(ctx, schedule)
+
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyCronName, schedule.Name),
+			sdk.NewAttribute(types.AttributeKeyContractAddr, schedule.Contract),
+			sdk.NewAttribute(types.AttributeKeyCronSuccess, strconv.FormatBool(run.Success)),
+			sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(run.GasUsed, 10)),
+		}
+		if !run.Success {
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyCronError, run.Error))
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeCronRun, attrs...))
+	}
+}
+
+// executeCronSchedule calls the contract with the sudo message of the schedule
+func (k Keeper) executeCronSchedule(ctx sdk.Context, schedule types.CronSchedule) types.CronRun {
+	run := types.CronRun{Height: ctx.BlockHeight()}
+	contractAddr, err := sdk.AccAddressFromBech32(schedule.Contract)
+	if err == nil {
+		run.GasUsed, err = k.sudoWithGasLimit(ctx, contractAddr, schedule.Msg, schedule.GasLimit)
+	}
+	if err != nil {
+		k.Logger(ctx).Info("cron schedule failed", "name", schedule.Name, "contract", schedule.Contract, "error", err)
+		run.Error = redactError(err).Error()
+		return run
+	}
+	run.Success = true
+	return run
+}
+
+// sudoWithGasLimit calls the contract with a sudo message and its own gas meter. State changes are only committed
+// on success. Returns the gas consumed.
+func (k Keeper) sudoWithGasLimit(ctx sdk.Context, contractAddr sdk.AccAddress, msg []byte, gasLimit storetypes.Gas) (storetypes.Gas, error) {
+	cacheCtx, commit := ctx.WithGasMeter(storetypes.NewGasMeter(gasLimit)).CacheContext()
+	err := k.sudoRecoverOutOfGas(cacheCtx, contractAddr, msg)
+	gasUsed := cacheCtx.GasMeter().GasConsumedToLimit()
+	if err != nil {
+		return gasUsed, err
+	}
+	commit()
+	return gasUsed, nil
+}
+
+// sudoRecoverOutOfGas calls the contract and turns an out of gas panic into an error
+func (k Keeper) sudoRecoverOutOfGas(ctx sdk.Context, contractAddr sdk.AccAddress, msg []byte) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			// if it's not an OutOfGas error, raise it again
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			err = errorsmod.Wrap(sdkerrors.ErrOutOfGas, "sudo hit gas limit")
+		}
+	}()
+	_, err = k.Sudo(ctx, contractAddr, msg)
+	return err
+}
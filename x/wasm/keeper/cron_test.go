@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v2"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestAddRemoveCronSchedule(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+
+	schedule := types.CronSchedule{
+		Name:     "my-schedule",
+		Contract: example.Contract.String(),
+		Msg:      []byte(`{"tick":{}}`),
+		Interval: 2,
+		GasLimit: 100_000,
+	}
+	// when
+	require.NoError(t, k.addCronSchedule(ctx, schedule))
+	// then
+	assert.Equal(t, &schedule, k.GetCronSchedule(ctx, schedule.Name))
+	// and names are unique
+	require.ErrorIs(t, k.addCronSchedule(ctx, schedule), types.ErrDuplicate)
+	// and contract must exist
+	otherSchedule := schedule
+	otherSchedule.Name = "other"
+	otherSchedule.Contract = RandomBech32AccountAddress(t)
+	require.ErrorContains(t, k.addCronSchedule(ctx, otherSchedule), "no such contract")
+
+	// and the number of schedules is limited
+	limitCtx, _ := ctx.CacheContext()
+	for i := 1; i < MaxCronSchedules; i++ {
+		s := schedule
+		s.Name = fmt.Sprintf("schedule-%d", i)
+		require.NoError(t, k.addCronSchedule(limitCtx, s))
+	}
+	otherSchedule.Contract = schedule.Contract
+	require.ErrorIs(t, k.addCronSchedule(limitCtx, otherSchedule), types.ErrLimit)
+
+	// when removed
+	require.NoError(t, k.removeCronSchedule(ctx, schedule.Name))
+	// then
+	assert.Nil(t, k.GetCronSchedule(ctx, schedule.Name))
+	require.ErrorIs(t, k.removeCronSchedule(ctx, schedule.Name), types.ErrNotFound)
+}
+
+func TestRunCronSchedules(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+
+	mock.SudoFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		store.Set(sudoMsg, []byte("called"))
+		switch string(sudoMsg) {
+		case `{"fail":{}}`:
+			return &wasmvmtypes.ContractResult{Err: "failed"}, 0, nil
+		case `{"expensive":{}}`:
+			return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, gasLimit + 1, nil
+		}
+		return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 0, nil
+	}
+	for _, s := range []types.CronSchedule{
+		{Name: "a-succeeds", Msg: []byte(`{"ok":{}}`), Interval: 2, GasLimit: 1_000_000},
+		{Name: "b-fails", Msg: []byte(`{"fail":{}}`), Interval: 2, GasLimit: 1_000_000},
+		{Name: "c-out-of-gas", Msg: []byte(`{"expensive":{}}`), Interval: 2, GasLimit: 200_000},
+		{Name: "d-not-due", Msg: []byte(`{"later":{}}`), Interval: 3, GasLimit: 1_000_000},
+	} {
+		s.Contract = example.Contract.String()
+		require.NoError(t, k.addCronSchedule(parentCtx, s))
+	}
+	ctx := parentCtx.WithBlockHeight(4).WithEventManager(sdk.NewEventManager()).WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	// when
+	require.NoError(t, EndBlocker(ctx, k))
+
+	// then
+	succeeded := k.GetCronSchedule(ctx, "a-succeeds").LastRun
+	require.NotNil(t, succeeded)
+	assert.Equal(t, int64(4), succeeded.Height)
+	assert.True(t, succeeded.Success)
+	assert.Empty(t, succeeded.Error)
+	assert.NotZero(t, succeeded.GasUsed)
+	assert.Equal(t, []byte("called"), k.QueryRaw(ctx, example.Contract, []byte(`{"ok":{}}`)))
+
+	failed := k.GetCronSchedule(ctx, "b-fails").LastRun
+	require.NotNil(t, failed)
+	assert.False(t, failed.Success)
+	assert.Contains(t, failed.Error, "failed")
+	assert.Nil(t, k.QueryRaw(ctx, example.Contract, []byte(`{"fail":{}}`)))
+
+	outOfGas := k.GetCronSchedule(ctx, "c-out-of-gas").LastRun
+	require.NotNil(t, outOfGas)
+	assert.False(t, outOfGas.Success)
+	assert.Equal(t, uint64(200_000), outOfGas.GasUsed)
+	assert.Nil(t, k.QueryRaw(ctx, example.Contract, []byte(`{"expensive":{}}`)))
+
+	assert.Nil(t, k.GetCronSchedule(ctx, "d-not-due").LastRun)
+	assert.Nil(t, k.QueryRaw(ctx, example.Contract, []byte(`{"later":{}}`)))
+
+	var runEvents []sdk.Event
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == types.EventTypeCronRun {
+			runEvents = append(runEvents, e)
+		}
+	}
+	require.Len(t, runEvents, 3)
+	assert.Equal(t, "a-succeeds", runEvents[0].Attributes[0].Value)
+	assert.Equal(t, "true", runEvents[0].Attributes[2].Value)
+	assert.Equal(t, "false", runEvents[1].Attributes[2].Value)
+}
+
+func TestRunCronSchedulesGasBudget(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+
+	// every run spends its full gas limit
+	mock.SudoFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, gasLimit + 1, nil
+	}
+	fitting := int(MaxCronGasPerBlock / types.MaxCronScheduleGasLimit)
+	for i := 0; i <= fitting; i++ {
+		require.NoError(t, k.addCronSchedule(parentCtx, types.CronSchedule{
+			Name:     fmt.Sprintf("schedule-%d", i),
+			Contract: example.Contract.String(),
+			Msg:      []byte(`{}`),
+			Interval: 1,
+			GasLimit: types.MaxCronScheduleGasLimit,
+		}))
+	}
+	ctx := parentCtx.WithBlockHeight(4).WithEventManager(sdk.NewEventManager()).WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	// when
+	require.NoError(t, EndBlocker(ctx, k))
+
+	// then the schedules within the block budget were executed
+	for i := 0; i < fitting; i++ {
+		run := k.GetCronSchedule(ctx, fmt.Sprintf("schedule-%d", i)).LastRun
+		require.NotNil(t, run)
+		assert.Equal(t, uint64(types.MaxCronScheduleGasLimit), run.GasUsed)
+	}
+	// and the last one was skipped
+	skipped := k.GetCronSchedule(ctx, fmt.Sprintf("schedule-%d", fitting)).LastRun
+	require.NotNil(t, skipped)
+	assert.False(t, skipped.Success)
+	assert.Zero(t, skipped.GasUsed)
+	assert.Contains(t, skipped.Error, "cron gas budget")
+}
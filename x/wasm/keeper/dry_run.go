@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// dryRunner runs contract calls without persisting any state changes
+type dryRunner interface {
+	dryRun(ctx sdk.Context, req *types.QuerySimulateExecuteRequest) (*types.QuerySimulateExecuteResponse, error)
+}
+
+var _ dryRunner = Keeper{}
+
+// dryRun executes or instantiates a contract in a cached context that is discarded afterwards. The message is handled
+// by the msg server like in a tx but without signature verification and fees.
+func (k Keeper) dryRun(ctx sdk.Context, req *types.QuerySimulateExecuteRequest) (*types.QuerySimulateExecuteResponse, error) {
+	recorder := types.NewDryRunRecorder()
+	ctx, _ = ctx.CacheContext()
+	ctx = types.WithDryRunRecorder(ctx.WithEventManager(sdk.NewEventManager()), recorder)
+
+	var rsp types.QuerySimulateExecuteResponse
+	msgServer := NewMsgServerImpl(&k)
+	switch {
+	case req.Execute != nil && req.Instantiate == nil:
+		res, err := msgServer.ExecuteContract(ctx, req.Execute)
+		if err != nil {
+			return nil, err
+		}
+		rsp.Data = res.Data
+	case req.Instantiate != nil && req.Execute == nil:
+		res, err := msgServer.InstantiateContract(ctx, req.Instantiate)
+		if err != nil {
+			return nil, err
+		}
+		rsp.Data, rsp.ContractAddress = res.Data, res.Address
+	default:
+		return nil, status.Error(codes.InvalidArgument, "exactly one message must be set")
+	}
+
+	balanceChanges, err := types.BalanceChangesFromEvents(ctx.EventManager().Events())
+	if err != nil {
+		return nil, err
+	}
+	rsp.GasUsed = ctx.GasMeter().GasConsumed()
+	rsp.StateChanges = recorder.StateChanges()
+	rsp.BalanceChanges = balanceChanges
+	rsp.Messages = recorder.Messages()
+	rsp.Replies = recorder.Replies()
+	return &rsp, nil
+}
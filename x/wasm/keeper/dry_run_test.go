@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestSimulateExecute(t *testing.T) {
+	cdc := MakeEncodingConfig(t).Codec
+	ctx, keepers := CreateTestInput(t, false, ReflectCapabilities, WithMessageEncoders(reflectEncoders(cdc)))
+	k := keepers.WasmKeeper
+	q := Querier(k)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 1000))
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, deposit.Add(deposit...)...)
+	bob := RandomAccountAddress(t)
+	reflectID, _, err := keepers.ContractKeeper.Create(ctx, creator, testdata.ReflectContractWasm(), nil)
+	require.NoError(t, err)
+	reflectAddr, _, err := keepers.ContractKeeper.Instantiate(ctx, reflectID, creator, nil, []byte("{}"), "reflect", deposit)
+	require.NoError(t, err)
+	hackatomID := StoreHackatomExampleContract(t, ctx, keepers).CodeID
+
+	bankMsg := wasmvmtypes.CosmosMsg{Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{
+		ToAddress: bob.String(),
+		Amount:    wasmvmtypes.Array[wasmvmtypes.Coin]{{Denom: "denom", Amount: "100"}},
+	}}}
+	bankMsgBz := mustMarshal(t, bankMsg)
+	executeMsg := &types.MsgExecuteContract{
+		Sender:   creator.String(),
+		Contract: reflectAddr.String(),
+		Msg: mustMarshal(t, testdata.ReflectHandleMsg{ReflectSubMsg: &testdata.ReflectSubPayload{
+			Msgs: []wasmvmtypes.SubMsg{{ID: 7, Msg: bankMsg, ReplyOn: wasmvmtypes.ReplyAlways}},
+		}}),
+	}
+
+	// when executed with a submessage
+	gotRsp, err := q.SimulateExecute(ctx, &types.QuerySimulateExecuteRequest{Execute: executeMsg})
+
+	// then
+	require.NoError(t, err)
+	assert.NotZero(t, gotRsp.GasUsed)
+	assert.Empty(t, gotRsp.ContractAddress)
+	require.NotEmpty(t, gotRsp.StateChanges)
+	for _, c := range gotRsp.StateChanges {
+		assert.Equal(t, reflectAddr.String(), c.ContractAddress)
+	}
+	expBalanceChanges := []types.SimulatedBalanceChange{
+		{Address: reflectAddr.String(), Denom: "denom", Amount: sdkmath.NewInt(-100)},
+		{Address: bob.String(), Denom: "denom", Amount: sdkmath.NewInt(100)},
+	}
+	assert.ElementsMatch(t, expBalanceChanges, gotRsp.BalanceChanges)
+	expMessages := []types.SimulatedMessage{
+		{ContractAddress: reflectAddr.String(), Msg: bankMsgBz, SubMessage: true, ID: 7, ReplyOn: "always"},
+	}
+	assert.Equal(t, expMessages, gotRsp.Messages)
+	require.Len(t, gotRsp.Replies, 1)
+	assert.Equal(t, reflectAddr.String(), gotRsp.Replies[0].ContractAddress)
+	assert.Equal(t, uint64(7), gotRsp.Replies[0].ID)
+	assert.Empty(t, gotRsp.Replies[0].Error)
+	// and nothing was persisted
+	assert.True(t, keepers.BankKeeper.GetBalance(ctx, bob, "denom").IsZero())
+
+	// when instantiated
+	initMsg := HackatomExampleInitMsg{Verifier: creator, Beneficiary: bob}.GetBytes(t)
+	gotRsp, err = q.SimulateExecute(ctx, &types.QuerySimulateExecuteRequest{Instantiate: &types.MsgInstantiateContract{
+		Sender: creator.String(),
+		CodeID: hackatomID,
+		Label:  "hackatom",
+		Msg:    initMsg,
+		Funds:  sdk.NewCoins(sdk.NewInt64Coin("denom", 10)),
+	}})
+
+	// then
+	require.NoError(t, err)
+	contractAddr, err := sdk.AccAddressFromBech32(gotRsp.ContractAddress)
+	require.NoError(t, err)
+	require.NotEmpty(t, gotRsp.StateChanges)
+	for _, c := range gotRsp.StateChanges {
+		assert.Equal(t, gotRsp.ContractAddress, c.ContractAddress)
+		assert.Nil(t, c.OldValue)
+		assert.NotEmpty(t, c.NewValue)
+	}
+	expBalanceChanges = []types.SimulatedBalanceChange{
+		{Address: creator.String(), Denom: "denom", Amount: sdkmath.NewInt(-10)},
+		{Address: gotRsp.ContractAddress, Denom: "denom", Amount: sdkmath.NewInt(10)},
+	}
+	assert.ElementsMatch(t, expBalanceChanges, gotRsp.BalanceChanges)
+	assert.Empty(t, gotRsp.Messages)
+	// and nothing was persisted
+	assert.False(t, k.HasContractInfo(ctx, contractAddr))
+
+	// when the call fails
+	_, err = q.SimulateExecute(ctx, &types.QuerySimulateExecuteRequest{Execute: &types.MsgExecuteContract{
+		Sender:   creator.String(),
+		Contract: RandomBech32AccountAddress(t),
+		Msg:      []byte(`{}`),
+	}})
+	// then
+	require.Error(t, err)
+
+	// when both messages are set
+	_, err = q.SimulateExecute(ctx, &types.QuerySimulateExecuteRequest{Execute: executeMsg, Instantiate: &types.MsgInstantiateContract{}})
+	// then
+	require.Error(t, err)
+
+	// when no message is set
+	_, err = q.SimulateExecute(ctx, &types.QuerySimulateExecuteRequest{})
+	// then
+	require.Error(t, err)
+	_, err = q.SimulateExecute(ctx, nil)
+	require.Error(t, err)
+}
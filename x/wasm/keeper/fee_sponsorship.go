@@ -0,0 +1,262 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// FeeSponsorshipEscrowAddress is the account that holds the fee sponsorship balances
+var FeeSponsorshipEscrowAddress = sdk.AccAddress(address.Module(types.ModuleName, []byte("fee_sponsorship")))
+
+// setFeeSponsorship creates or updates the fee sponsorship of a contract. The balance of an existing sponsorship is kept.
+// The contract itself or the caller authorized to modify the contract can set the sponsorship.
+func (k Keeper) setFeeSponsorship(ctx context.Context, contractAddr, caller sdk.AccAddress, maxFeePerSender, maxFeePerBlock sdk.Coins, authZ types.AuthorizationPolicy) error {
+	if err := k.authorizeFeeSponsorship(ctx, contractAddr, caller, authZ); err != nil {
+		return err
+	}
+	sponsorship := k.GetFeeSponsorship(ctx, contractAddr)
+	if sponsorship == nil {
+		sponsorship = &types.FeeSponsorship{Contract: contractAddr.String()}
+	}
+	sponsorship.MaxFeePerSender = maxFeePerSender
+	sponsorship.MaxFeePerBlock = maxFeePerBlock
+	k.mustStoreFeeSponsorship(ctx, *sponsorship)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetFeeSponsorship,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+	))
+	return nil
+}
+
+// fundFeeSponsorship adds the amount from the sender to the balance of the fee sponsorship
+func (k Keeper) fundFeeSponsorship(ctx context.Context, contractAddr, sender sdk.AccAddress, amount sdk.Coins) error {
+	sponsorship := k.GetFeeSponsorship(ctx, contractAddr)
+	if sponsorship == nil {
+		return types.ErrNotFound.Wrapf("fee sponsorship: %s", contractAddr.String())
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.bank.TransferCoins(sdkCtx, sender, FeeSponsorshipEscrowAddress, amount); err != nil {
+		return errorsmod.Wrap(err, "fund fee sponsorship")
+	}
+	sponsorship.Balance = sponsorship.Balance.Add(amount...)
+	k.mustStoreFeeSponsorship(ctx, *sponsorship)
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFundFeeSponsorship,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	))
+	return nil
+}
+
+// removeFeeSponsorship deletes the fee sponsorship of a contract and returns the remaining balance to the contract
+func (k Keeper) removeFeeSponsorship(ctx context.Context, contractAddr, caller sdk.AccAddress, authZ types.AuthorizationPolicy) error {
+	if err := k.authorizeFeeSponsorship(ctx, contractAddr, caller, authZ); err != nil {
+		return err
+	}
+	if k.GetFeeSponsorship(ctx, contractAddr) == nil {
+		return types.ErrNotFound.Wrapf("fee sponsorship: %s", contractAddr.String())
+	}
+	return k.deleteFeeSponsorship(ctx, contractAddr)
+}
+
+// deleteFeeSponsorship removes the fee sponsorship with all usages when it exists and returns the remaining balance to
+// the contract
+func (k Keeper) deleteFeeSponsorship(ctx context.Context, contractAddr sdk.AccAddress) error {
+	sponsorship := k.GetFeeSponsorship(ctx, contractAddr)
+	if sponsorship == nil {
+		return nil
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !sponsorship.Balance.IsZero() {
+		if err := k.bank.TransferCoins(sdkCtx, FeeSponsorshipEscrowAddress, contractAddr, sponsorship.Balance); err != nil {
+			return errorsmod.Wrap(err, "fee sponsorship refund")
+		}
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetFeeSponsorshipKey(contractAddr)); err != nil {
+		return err
+	}
+	var senders []sdk.AccAddress
+	k.iterateFeeSponsorshipUsages(ctx, types.GetFeeSponsorshipUsagesPrefix(contractAddr), func(usage types.FeeSponsorshipUsage) bool {
+		senders = append(senders, sdk.MustAccAddressFromBech32(usage.Sender))
+		return false
+	})
+	for _, sender := range senders {
+		if err := store.Delete(types.GetFeeSponsorshipUsageKey(contractAddr, sender)); err != nil {
+			return err
+		}
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRemoveFeeSponsorship,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, sponsorship.Balance.String()),
+	))
+	return nil
+}
+
+// authorizeFeeSponsorship returns an error when the caller is neither the contract itself nor authorized to modify it
+func (k Keeper) authorizeFeeSponsorship(ctx context.Context, contractAddr, caller sdk.AccAddress, authZ types.AuthorizationPolicy) error {
+	contractInfo := k.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !caller.Equals(contractAddr) && !canModifyContract(authZ, contractInfo, caller) {
+		return unauthorizedModification(contractInfo, "can not modify contract")
+	}
+	return nil
+}
+
+// DeductSponsoredFee pays the tx fee of the sender from the fee sponsorship of the contract. The sender account is
+// created after the payment when it does not exist. Returns false when the contract has no sponsorship or the fee
+// exceeds the balance or the limits.
+func (k Keeper) DeductSponsoredFee(ctx sdk.Context, contractAddr, sender sdk.AccAddress, fee sdk.Coins) (bool, error) {
+	sponsorship := k.GetFeeSponsorship(ctx, contractAddr)
+	if sponsorship == nil {
+		return false, nil
+	}
+	usage := k.GetFeeSponsorshipUsage(ctx, contractAddr, sender)
+	if !sponsorship.CanPay(ctx.BlockHeight(), usage.Spent, fee) {
+		return false, nil
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, FeeSponsorshipEscrowAddress, authtypes.FeeCollectorName, fee); err != nil {
+		return false, errorsmod.Wrap(err, "deduct sponsored fee")
+	}
+	// new users can send the tx, the account is created after the sponsorship paid for it
+	if k.accountKeeper.GetAccount(ctx, sender) == nil {
+		k.accountKeeper.SetAccount(ctx, k.accountKeeper.NewAccountWithAddress(ctx, sender))
+	}
+	sponsorship.BlockSpent = sponsorship.SpentInBlock(ctx.BlockHeight()).Add(fee...)
+	sponsorship.BlockHeight = ctx.BlockHeight()
+	sponsorship.Balance = sponsorship.Balance.Sub(fee...)
+	k.mustStoreFeeSponsorship(ctx, *sponsorship)
+	usage.Spent = usage.Spent.Add(fee...)
+	k.mustStoreFeeSponsorshipUsage(ctx, usage)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSponsoredFee,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(sdk.AttributeKeyFeePayer, sender.String()),
+		sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+	))
+	return true, nil
+}
+
+// GetFeeSponsorship returns the fee sponsorship of the contract or nil when not found
+func (k Keeper) GetFeeSponsorship(ctx context.Context, contractAddr sdk.AccAddress) *types.FeeSponsorship {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.GetFeeSponsorshipKey(contractAddr))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return nil
+	}
+	var sponsorship types.FeeSponsorship
+	k.cdc.MustUnmarshal(bz, &sponsorship)
+	return &sponsorship
+}
+
+// GetFeeSponsorshipUsage returns the total fee that the contract paid for the sender
+func (k Keeper) GetFeeSponsorshipUsage(ctx context.Context, contractAddr, sender sdk.AccAddress) types.FeeSponsorshipUsage {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.GetFeeSponsorshipUsageKey(contractAddr, sender))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return types.FeeSponsorshipUsage{Contract: contractAddr.String(), Sender: sender.String(), Spent: sdk.NewCoins()}
+	}
+	var usage types.FeeSponsorshipUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+	return usage
+}
+
+// IterateFeeSponsorships iterates over all fee sponsorships
+func (k Keeper) IterateFeeSponsorships(ctx context.Context, cb func(types.FeeSponsorship) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.FeeSponsorshipPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var sponsorship types.FeeSponsorship
+		k.cdc.MustUnmarshal(iter.Value(), &sponsorship)
+		// cb returns true to stop early
+		if cb(sponsorship) {
+			return
+		}
+	}
+}
+
+// IterateFeeSponsorshipUsages iterates over the fee usages of all contracts and senders
+func (k Keeper) IterateFeeSponsorshipUsages(ctx context.Context, cb func(types.FeeSponsorshipUsage) bool) {
+	k.iterateFeeSponsorshipUsages(ctx, types.FeeSponsorshipUsagePrefix, cb)
+}
+
+func (k Keeper) iterateFeeSponsorshipUsages(ctx context.Context, keyPrefix []byte, cb func(types.FeeSponsorshipUsage) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var usage types.FeeSponsorshipUsage
+		k.cdc.MustUnmarshal(iter.Value(), &usage)
+		// cb returns true to stop early
+		if cb(usage) {
+			return
+		}
+	}
+}
+
+func (k Keeper) mustStoreFeeSponsorship(ctx context.Context, sponsorship types.FeeSponsorship) {
+	contractAddr := sdk.MustAccAddressFromBech32(sponsorship.Contract)
+	if err := k.storeService.OpenKVStore(ctx).Set(types.GetFeeSponsorshipKey(contractAddr), k.cdc.MustMarshal(&sponsorship)); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) mustStoreFeeSponsorshipUsage(ctx context.Context, usage types.FeeSponsorshipUsage) {
+	key := types.GetFeeSponsorshipUsageKey(sdk.MustAccAddressFromBech32(usage.Contract), sdk.MustAccAddressFromBech32(usage.Sender))
+	if err := k.storeService.OpenKVStore(ctx).Set(key, k.cdc.MustMarshal(&usage)); err != nil {
+		panic(err)
+	}
+}
+
+// importFeeSponsorship stores a fee sponsorship from genesis. The balance must be held by the escrow account already.
+func (k Keeper) importFeeSponsorship(ctx context.Context, sponsorship types.FeeSponsorship) error {
+	if err := sponsorship.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr := sdk.MustAccAddressFromBech32(sponsorship.Contract)
+	if !k.HasContractInfo(ctx, contractAddr) {
+		return types.ErrNoSuchContractFn(sponsorship.Contract).Wrapf("address %s", sponsorship.Contract)
+	}
+	if k.GetFeeSponsorship(ctx, contractAddr) != nil {
+		return errorsmod.Wrapf(types.ErrDuplicate, "fee sponsorship: %s", sponsorship.Contract)
+	}
+	k.mustStoreFeeSponsorship(ctx, sponsorship)
+	return nil
+}
+
+// importFeeSponsorshipUsage stores the fee usage of a sender from genesis
+func (k Keeper) importFeeSponsorshipUsage(ctx context.Context, usage types.FeeSponsorshipUsage) error {
+	if err := usage.ValidateBasic(); err != nil {
+		return err
+	}
+	contractAddr := sdk.MustAccAddressFromBech32(usage.Contract)
+	if k.GetFeeSponsorship(ctx, contractAddr) == nil {
+		return types.ErrNotFound.Wrapf("fee sponsorship: %s", usage.Contract)
+	}
+	k.mustStoreFeeSponsorshipUsage(ctx, usage)
+	return nil
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestSetFundRemoveFeeSponsorship(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	admin := example.CreatorAddr
+	policy := DefaultAuthorizationPolicy{}
+
+	maxPerSender := sdk.NewCoins(sdk.NewInt64Coin("denom", 10))
+	// when unauthorized
+	err := k.setFeeSponsorship(ctx, example.Contract, RandomAccountAddress(t), maxPerSender, nil, policy)
+	// then
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	assert.Nil(t, k.GetFeeSponsorship(ctx, example.Contract))
+
+	// when set by admin
+	require.NoError(t, k.setFeeSponsorship(ctx, example.Contract, admin, maxPerSender, nil, policy))
+	// then
+	assert.Equal(t, &types.FeeSponsorship{Contract: example.Contract.String(), MaxFeePerSender: maxPerSender}, k.GetFeeSponsorship(ctx, example.Contract))
+
+	// when funded by anybody
+	funder := keepers.Faucet.NewFundedRandomAccount(ctx, sdk.NewInt64Coin("denom", 100))
+	require.NoError(t, k.fundFeeSponsorship(ctx, example.Contract, funder, sdk.NewCoins(sdk.NewInt64Coin("denom", 60))))
+	// then
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom", 60)), k.GetFeeSponsorship(ctx, example.Contract).Balance)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom", 60)), keepers.BankKeeper.GetAllBalances(ctx, FeeSponsorshipEscrowAddress))
+
+	// when updated by the contract itself
+	maxPerBlock := sdk.NewCoins(sdk.NewInt64Coin("denom", 20))
+	require.NoError(t, k.setFeeSponsorship(ctx, example.Contract, example.Contract, nil, maxPerBlock, policy))
+	// then the balance is kept
+	got := k.GetFeeSponsorship(ctx, example.Contract)
+	assert.Equal(t, maxPerBlock, got.MaxFeePerBlock)
+	assert.Empty(t, got.MaxFeePerSender)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom", 60)), got.Balance)
+
+	// when removed
+	require.NoError(t, k.removeFeeSponsorship(ctx, example.Contract, admin, policy))
+	// then the balance is returned to the contract
+	assert.Nil(t, k.GetFeeSponsorship(ctx, example.Contract))
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom", 60)), keepers.BankKeeper.GetAllBalances(ctx, example.Contract))
+	require.ErrorIs(t, k.removeFeeSponsorship(ctx, example.Contract, admin, policy), types.ErrNotFound)
+	require.ErrorIs(t, k.fundFeeSponsorship(ctx, example.Contract, funder, sdk.NewCoins(sdk.NewInt64Coin("denom", 1))), types.ErrNotFound)
+}
+
+func TestDeductSponsoredFee(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	funder := keepers.Faucet.NewFundedRandomAccount(parentCtx, sdk.NewInt64Coin("denom", 1000))
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("denom", amount)) }
+	specs := map[string]struct {
+		balance      int64
+		maxPerSender sdk.Coins
+		maxPerBlock  sdk.Coins
+		setup        func(ctx sdk.Context, sender sdk.AccAddress)
+		fee          sdk.Coins
+		expSponsored bool
+	}{
+		"no limits": {
+			balance:      100,
+			fee:          coins(100),
+			expSponsored: true,
+		},
+		"balance exceeded": {
+			balance: 100,
+			fee:     coins(101),
+		},
+		"other denom": {
+			balance: 100,
+			fee:     sdk.NewCoins(sdk.NewInt64Coin("other", 1)),
+		},
+		"within sender limit": {
+			balance:      100,
+			maxPerSender: coins(30),
+			setup: func(ctx sdk.Context, sender sdk.AccAddress) {
+				_, err := k.DeductSponsoredFee(ctx, example.Contract, sender, coins(10))
+				require.NoError(t, err)
+			},
+			fee:          coins(20),
+			expSponsored: true,
+		},
+		"sender limit exceeded": {
+			balance:      100,
+			maxPerSender: coins(30),
+			setup: func(ctx sdk.Context, sender sdk.AccAddress) {
+				_, err := k.DeductSponsoredFee(ctx, example.Contract, sender, coins(10))
+				require.NoError(t, err)
+			},
+			fee: coins(21),
+		},
+		"block limit exceeded": {
+			balance:     100,
+			maxPerBlock: coins(30),
+			setup: func(ctx sdk.Context, _ sdk.AccAddress) {
+				_, err := k.DeductSponsoredFee(ctx, example.Contract, RandomAccountAddress(t), coins(10))
+				require.NoError(t, err)
+			},
+			fee: coins(21),
+		},
+		"block limit reset in new block": {
+			balance:     100,
+			maxPerBlock: coins(30),
+			setup: func(ctx sdk.Context, _ sdk.AccAddress) {
+				_, err := k.DeductSponsoredFee(ctx.WithBlockHeight(ctx.BlockHeight()-1), example.Contract, RandomAccountAddress(t), coins(10))
+				require.NoError(t, err)
+			},
+			fee:          coins(21),
+			expSponsored: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			require.NoError(t, k.setFeeSponsorship(ctx, example.Contract, example.Contract, spec.maxPerSender, spec.maxPerBlock, DefaultAuthorizationPolicy{}))
+			require.NoError(t, k.fundFeeSponsorship(ctx, example.Contract, funder, coins(spec.balance)))
+			sender := RandomAccountAddress(t)
+			if spec.setup != nil {
+				spec.setup(ctx, sender)
+			}
+			before := k.GetFeeSponsorship(ctx, example.Contract)
+			accountBefore := keepers.AccountKeeper.GetAccount(ctx, sender)
+			collectedBefore := keepers.BankKeeper.GetAllBalances(ctx, feeCollector)
+
+			// when
+			sponsored, err := k.DeductSponsoredFee(ctx, example.Contract, sender, spec.fee)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, spec.expSponsored, sponsored)
+			after := k.GetFeeSponsorship(ctx, example.Contract)
+			if !spec.expSponsored {
+				assert.Equal(t, before, after)
+				// and no account was created for free
+				assert.Equal(t, accountBefore, keepers.AccountKeeper.GetAccount(ctx, sender))
+				return
+			}
+			assert.True(t, before.Balance.Sub(spec.fee...).Equal(after.Balance))
+			assert.Equal(t, ctx.BlockHeight(), after.BlockHeight)
+			assert.True(t, after.BlockSpent.IsAllGTE(spec.fee))
+			assert.Equal(t, collectedBefore.Add(spec.fee...), keepers.BankKeeper.GetAllBalances(ctx, feeCollector))
+			assert.True(t, k.GetFeeSponsorshipUsage(ctx, example.Contract, sender).Spent.IsAllGTE(spec.fee))
+			// and the sender account was created
+			assert.NotNil(t, keepers.AccountKeeper.GetAccount(ctx, sender))
+		})
+	}
+}
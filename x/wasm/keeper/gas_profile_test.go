@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"os"
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestGasProfile(t *testing.T) {
+	cdc := MakeEncodingConfig(t).Codec
+	ctx, keepers := CreateTestInput(t, false, ReflectCapabilities, WithMessageEncoders(reflectEncoders(cdc)))
+	keeper := keepers.ContractKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, deposit...)
+	_, bob := keyPubAddr()
+
+	reflectID, _, err := keeper.Create(ctx, creator, testdata.ReflectContractWasm(), nil)
+	require.NoError(t, err)
+	reflectAddr, _, err := keeper.Instantiate(ctx, reflectID, creator, nil, []byte("{}"), "reflect", nil)
+	require.NoError(t, err)
+	hackatomCode, err := os.ReadFile("./testdata/hackatom.wasm")
+	require.NoError(t, err)
+	hackatomID, _, err := keeper.Create(ctx, creator, hackatomCode, nil)
+	require.NoError(t, err)
+	initMsgBz := HackatomExampleInitMsg{Verifier: reflectAddr, Beneficiary: bob}.GetBytes(t)
+	hackatomAddr, _, err := keeper.Instantiate(ctx, hackatomID, creator, nil, initMsgBz, "hackatom", nil)
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		call         func(ctx sdk.Context) error
+		expStep      string
+		expContract  sdk.AccAddress
+		expSubStep   string
+		expSubTarget sdk.AccAddress
+	}{
+		"execute with sub message": {
+			call: func(ctx sdk.Context) error {
+				_, err := keeper.Execute(ctx, reflectAddr, creator, mustMarshal(t, testdata.ReflectHandleMsg{
+					Reflect: &testdata.ReflectPayload{Msgs: []wasmvmtypes.CosmosMsg{{
+						Wasm: &wasmvmtypes.WasmMsg{Execute: &wasmvmtypes.ExecuteMsg{
+							ContractAddr: hackatomAddr.String(),
+							Msg:          []byte(`{"release":{}}`),
+							Funds:        []wasmvmtypes.Coin{},
+						}},
+					}}},
+				}), nil)
+				return err
+			},
+			expStep:      types.GasProfileStepExecute,
+			expContract:  reflectAddr,
+			expSubStep:   types.GasProfileStepExecute,
+			expSubTarget: hackatomAddr,
+		},
+		"instantiate": {
+			call: func(ctx sdk.Context) error {
+				_, _, err := keeper.Instantiate(ctx, hackatomID, creator, nil, initMsgBz, "other", nil)
+				return err
+			},
+			expStep: types.GasProfileStepInstantiate,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			profiler := types.NewGasProfiler()
+
+			// when
+			require.NoError(t, spec.call(types.WithGasProfiler(ctx, profiler)))
+
+			// then
+			profiles := profiler.Profiles()
+			require.Len(t, profiles, 1)
+			got := profiles[0]
+			assert.Equal(t, spec.expStep, got.Step)
+			assert.NotEmpty(t, got.Contract)
+			if spec.expContract != nil {
+				assert.Equal(t, spec.expContract.String(), got.Contract)
+			}
+			assert.Equal(t, ctx.GasMeter().GasConsumed(), got.Gas)
+			var childGas uint64
+			steps := make(map[string]*types.GasProfile, len(got.Children))
+			for _, c := range got.Children {
+				childGas += c.Gas
+				steps[c.Step] = c
+			}
+			assert.LessOrEqual(t, childGas, got.Gas)
+			require.Contains(t, steps, types.GasProfileStepSetup)
+			require.Contains(t, steps, types.GasProfileStepVM)
+			if spec.expSubStep != "" {
+				require.Contains(t, steps, spec.expSubStep)
+				assert.Equal(t, spec.expSubTarget.String(), steps[spec.expSubStep].Contract)
+				assert.NotZero(t, steps[spec.expSubStep].Gas)
+			}
+		})
+	}
+}
+
+func TestGasProfileQuery(t *testing.T) {
+	cdc := MakeEncodingConfig(t).Codec
+	ctx, keepers := CreateTestInput(t, false, ReflectCapabilities, WithMessageEncoders(reflectEncoders(cdc)))
+	example := InstantiateReflectExampleContract(t, ctx, keepers)
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	profiler := types.NewGasProfiler()
+
+	// when
+	_, err := keepers.WasmKeeper.QuerySmart(types.WithGasProfiler(ctx, profiler), example.Contract, mustMarshal(t, testdata.ReflectQueryMsg{
+		Chain: &testdata.ChainQuery{Request: &wasmvmtypes.QueryRequest{Wasm: &wasmvmtypes.WasmQuery{
+			Smart: &wasmvmtypes.SmartQuery{ContractAddr: example.Contract.String(), Msg: []byte(`{"owner":{}}`)},
+		}}},
+	}))
+	require.NoError(t, err)
+
+	// then
+	var query *types.GasProfile
+	for _, p := range profiler.Profiles() {
+		if p.Step == types.GasProfileStepQuery {
+			query = p
+		}
+	}
+	require.NotNil(t, query)
+	assert.Equal(t, example.Contract.String(), query.Contract)
+	assert.NotZero(t, query.Gas)
+	require.Len(t, query.Children, 2)
+	assert.Equal(t, types.GasProfileStepSetup, query.Children[0].Step)
+	assert.Equal(t, types.GasProfileStepVM, query.Children[1].Step)
+}
+
+func TestGasProfileFailedCall(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	specs := map[string]struct {
+		gasLimit storetypes.Gas
+		msg      []byte
+		expErr   *errorsmod.Error
+		expOOG   bool
+	}{
+		"contract error": {
+			gasLimit: 1_000_000,
+			msg:      []byte(`{"unknown":{}}`),
+			expErr:   types.ErrExecuteFailed,
+		},
+		"out of gas": {
+			gasLimit: 20_000,
+			msg:      []byte(`{"release":{}}`),
+			expOOG:   true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			ctx = types.WithGasProfiler(ctx.WithGasMeter(storetypes.NewGasMeter(spec.gasLimit)), types.NewGasProfiler())
+			execute := func() error {
+				_, err := keepers.ContractKeeper.Execute(ctx, example.Contract, example.VerifierAddr, spec.msg, nil)
+				return err
+			}
+			if spec.expOOG {
+				// when
+				defer func() {
+					// then the profile is part of the out of gas descriptor
+					oog, ok := recover().(storetypes.ErrorOutOfGas)
+					require.True(t, ok)
+					assert.Contains(t, oog.Descriptor, `gas profile: [{"step":"execute","contract":"`+example.Contract.String())
+				}()
+				_ = execute()
+				t.Fatal("expected out of gas")
+			}
+
+			// when
+			gotErr := execute()
+
+			// then the profile is part of the error
+			require.ErrorIs(t, gotErr, spec.expErr)
+			assert.Contains(t, gotErr.Error(), `gas profile: [{"step":"execute","contract":"`+example.Contract.String())
+		})
+	}
+}
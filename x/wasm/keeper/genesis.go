@@ -53,6 +53,64 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState, msgRo
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "contract number %d", i)
 		}
+		if contract.Metadata != nil {
+			if err := keeper.importContractMetadata(ctx, contractAddr, *contract.Metadata); err != nil {
+				return nil, errorsmod.Wrapf(err, "metadata of contract number %d", i)
+			}
+		}
+		if err := keeper.setContractStorageDeposit(ctx, contractAddr, contract.StorageDeposit); err != nil {
+			return nil, errorsmod.Wrapf(err, "storage deposit of contract number %d", i)
+		}
+	}
+
+	for i, addr := range data.PurgedContracts {
+		contractAddr, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "address in purged contract number %d", i)
+		}
+		if err := keeper.importPurgedContract(ctx, contractAddr); err != nil {
+			return nil, errorsmod.Wrapf(err, "purged contract number %d", i)
+		}
+	}
+
+	for i, schedule := range data.CronSchedules {
+		if err := keeper.importCronSchedule(ctx, schedule); err != nil {
+			return nil, errorsmod.Wrapf(err, "cron schedule number %d", i)
+		}
+	}
+
+	var maxCallbackID uint64
+	for i, callback := range data.Callbacks {
+		if err := keeper.importCallback(ctx, callback); err != nil {
+			return nil, errorsmod.Wrapf(err, "callback number %d", i)
+		}
+		if callback.ID > maxCallbackID {
+			maxCallbackID = callback.ID
+		}
+	}
+
+	for i, sponsorship := range data.FeeSponsorships {
+		if err := keeper.importFeeSponsorship(ctx, sponsorship); err != nil {
+			return nil, errorsmod.Wrapf(err, "fee sponsorship number %d", i)
+		}
+	}
+
+	for i, usage := range data.FeeSponsorshipUsages {
+		if err := keeper.importFeeSponsorshipUsage(ctx, usage); err != nil {
+			return nil, errorsmod.Wrapf(err, "fee sponsorship usage number %d", i)
+		}
+	}
+
+	for i, approval := range data.MigrationApprovals {
+		if err := keeper.importMigrationApproval(ctx, approval); err != nil {
+			return nil, errorsmod.Wrapf(err, "migration approval number %d", i)
+		}
+	}
+
+	for i, dependency := range data.ContractDependencies {
+		if err := keeper.importContractDependency(ctx, dependency); err != nil {
+			return nil, errorsmod.Wrapf(err, "contract dependency number %d", i)
+		}
 	}
 
 	for i, seq := range data.Sequences {
@@ -70,6 +128,13 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState, msgRo
 	if seqVal <= maxCodeID {
 		return nil, errorsmod.Wrapf(types.ErrInvalid, "seq %s with value: %d must be greater than: %d ", string(types.KeySequenceCodeID), seqVal, maxCodeID)
 	}
+	seqVal, err = keeper.PeekAutoIncrementID(ctx, types.KeySequenceCallbackID)
+	if err != nil {
+		return nil, err
+	}
+	if seqVal <= maxCallbackID {
+		return nil, errorsmod.Wrapf(types.ErrInvalid, "seq %s with value: %d must be greater than: %d ", string(types.KeySequenceCallbackID), seqVal, maxCallbackID)
+	}
 	// ensure next classic address is unused so that we know the sequence is good
 	rCtx, _ := ctx.CacheContext()
 	seqVal, err = keeper.PeekAutoIncrementID(rCtx, types.KeySequenceInstanceID)
@@ -77,7 +142,7 @@ func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState, msgRo
 		return nil, err
 	}
 	addr := keeper.ClassicAddressGenerator()(rCtx, seqVal, nil)
-	if keeper.HasContractInfo(ctx, addr) {
+	if keeper.HasContractInfo(ctx, addr) || keeper.IsContractPurged(ctx, addr) {
 		return nil, errorsmod.Wrapf(types.ErrInvalid, "value: %d for seq %s was used already", seqVal, string(types.KeySequenceInstanceID))
 	}
 
@@ -126,16 +191,59 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 		})
 
 		contractCodeHistory := keeper.GetContractHistory(ctx, addr)
+		storageStats := keeper.GetContractStorageStats(ctx, addr)
 
 		genState.Contracts = append(genState.Contracts, types.Contract{
 			ContractAddress:     addr.String(),
 			ContractInfo:        contract,
 			ContractState:       state,
 			ContractCodeHistory: contractCodeHistory,
+			StorageStats:        &storageStats,
+			Metadata:            keeper.GetContractMetadata(ctx, addr),
+			StorageDeposit:      keeper.GetContractStorageDeposit(ctx, addr),
 		})
 		return false
 	})
 
+	keeper.IteratePurgedContracts(ctx, func(addr sdk.AccAddress) bool {
+		genState.PurgedContracts = append(genState.PurgedContracts, addr.String())
+		return false
+	})
+
+	keeper.IterateCronSchedules(ctx, func(schedule types.CronSchedule) bool {
+		genState.CronSchedules = append(genState.CronSchedules, schedule)
+		return false
+	})
+
+	keeper.IterateCallbacks(ctx, func(callback types.Callback) bool {
+		genState.Callbacks = append(genState.Callbacks, callback)
+		return false
+	})
+
+	keeper.IterateFeeSponsorships(ctx, func(sponsorship types.FeeSponsorship) bool {
+		genState.FeeSponsorships = append(genState.FeeSponsorships, sponsorship)
+		return false
+	})
+
+	keeper.IterateFeeSponsorshipUsages(ctx, func(usage types.FeeSponsorshipUsage) bool {
+		genState.FeeSponsorshipUsages = append(genState.FeeSponsorshipUsages, usage)
+		return false
+	})
+
+	keeper.IterateMigrationApprovals(ctx, func(approval types.MigrationApproval) bool {
+		genState.MigrationApprovals = append(genState.MigrationApprovals, approval)
+		return false
+	})
+
+	keeper.IterateAllContractDependencies(ctx, func(dependency types.ContractDependency) bool {
+		// skip the leftovers of contracts that are still being purged
+		if keeper.HasContractInfo(ctx, sdk.MustAccAddressFromBech32(dependency.Caller)) &&
+			keeper.HasContractInfo(ctx, sdk.MustAccAddressFromBech32(dependency.Callee)) {
+			genState.ContractDependencies = append(genState.ContractDependencies, dependency)
+		}
+		return false
+	})
+
 	for _, k := range [][]byte{types.KeySequenceCodeID, types.KeySequenceInstanceID} {
 		id, err := keeper.PeekAutoIncrementID(ctx, k)
 		if err != nil {
@@ -146,6 +254,17 @@ func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
 			Value: id,
 		})
 	}
+	// the callback sequence is only exported once it was used
+	callbackSeq, err := keeper.PeekAutoIncrementID(ctx, types.KeySequenceCallbackID)
+	if err != nil {
+		panic(err)
+	}
+	if callbackSeq > 1 {
+		genState.Sequences = append(genState.Sequences, types.Sequence{
+			IDKey: types.KeySequenceCallbackID,
+			Value: callbackSeq,
+		})
+	}
 
 	return &genState
 }
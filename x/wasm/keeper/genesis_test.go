@@ -51,6 +51,7 @@ func TestGenesisExportImport(t *testing.T) {
 	err = wasmKeeper.SetParams(srcCtx, types.DefaultParams())
 	require.NoError(t, err)
 
+	var prevContractAddr sdk.AccAddress
 	for i := 0; i < 25; i++ {
 		var (
 			codeInfo          types.CodeInfo
@@ -91,6 +92,10 @@ func TestGenesisExportImport(t *testing.T) {
 		require.NoError(t, wasmKeeper.appendToContractHistory(srcCtx, contractAddr, history...))
 		err = wasmKeeper.importContractState(srcCtx, contractAddr, stateModels)
 		require.NoError(t, err)
+		if prevContractAddr != nil {
+			wasmKeeper.recordContractDependency(srcCtx, prevContractAddr, contractAddr.String())
+		}
+		prevContractAddr = contractAddr
 	}
 	var wasmParams types.Params
 	f.NilChance(0).Fuzz(&wasmParams)
@@ -124,6 +129,10 @@ func TestGenesisExportImport(t *testing.T) {
 		require.NoError(t, err)
 		err = wasmKeeper.addToContractCreatorSecondaryIndex(srcCtx, creatorAddress, history[0].Updated, address)
 		require.NoError(t, err)
+		err = wasmKeeper.addToContractAdminSecondaryIndex(srcCtx, address, info.Admins())
+		require.NoError(t, err)
+		err = wasmKeeper.addToContractLabelSecondaryIndex(srcCtx, address, &info)
+		require.NoError(t, err)
 		return false
 	})
 
@@ -372,6 +381,39 @@ func TestGenesisInit(t *testing.T) {
 				Params: types.DefaultParams(),
 			},
 		},
+		"prevent contract dependency to non existing contract": {
+			src: types.GenesisState{
+				Codes: []types.Code{{
+					CodeID:    1,
+					CodeInfo:  myCodeInfo,
+					CodeBytes: wasmCode,
+				}},
+				Contracts: []types.Contract{
+					{
+						ContractAddress: BuildContractAddressClassic(1, 1).String(),
+						ContractInfo:    types.ContractInfoFixture(func(c *types.ContractInfo) { c.CodeID = 1 }, types.RandCreatedFields),
+						ContractCodeHistory: []types.ContractCodeHistoryEntry{
+							{
+								Operation: types.ContractCodeHistoryOperationTypeMigrate,
+								CodeID:    1,
+								Updated:   &types.AbsoluteTxPosition{BlockHeight: rand.Uint64(), TxIndex: rand.Uint64()},
+								Msg:       []byte(`{}`),
+							},
+						},
+					},
+				},
+				ContractDependencies: []types.ContractDependency{{
+					Caller:       BuildContractAddressClassic(1, 1).String(),
+					Callee:       BuildContractAddressClassic(1, 2).String(),
+					MessageCount: 1,
+				}},
+				Sequences: []types.Sequence{
+					{IDKey: types.KeySequenceCodeID, Value: 2},
+					{IDKey: types.KeySequenceInstanceID, Value: 2},
+				},
+				Params: types.DefaultParams(),
+			},
+		},
 		"prevent duplicate contract address": {
 			src: types.GenesisState{
 				Codes: []types.Code{{
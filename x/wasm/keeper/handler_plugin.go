@@ -57,6 +57,7 @@ func NewDefaultMessageHandler(
 		NewSDKMessageHandler(cdc, router, encoders),
 		NewIBCRawPacketHandler(ics4Wrapper, keeper, channelKeeper, capabilityKeeper),
 		NewBurnCoinMessageHandler(bankKeeper),
+		NewCallbackMessageHandler(keeper),
 	)
 }
 
@@ -468,7 +468,7 @@ func TestBurnCoinMessageHandlerIntegration(t *testing.T) {
 			}}
 
 			// when
-			_, err = k.execute(ctx, example.Contract, example.CreatorAddr, nil, nil)
+			_, err = k.execute(ctx, example.Contract, example.CreatorAddr, nil, nil, DefaultAuthorizationPolicy{})
 
 			// then
 			if spec.expErr {
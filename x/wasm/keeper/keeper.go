@@ -10,6 +10,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	wasmvm "github.com/CosmWasm/wasmvm/v2"
@@ -89,7 +90,9 @@ type Keeper struct {
 	cdc                   codec.Codec
 	accountKeeper         types.AccountKeeper
 	bank                  CoinTransferrer
+	bankKeeper            types.BankKeeper
 	portKeeper            types.PortKeeper
+	channelKeeper         types.ChannelKeeper
 	capabilityKeeper      types.CapabilityKeeper
 	wasmVM                types.WasmEngine
 	wasmVMQueryHandler    WasmVMQueryHandler
@@ -98,13 +101,19 @@ type Keeper struct {
 	// queryGasLimit is the max wasmvm gas that can be spent on executing a query with a contract
 	queryGasLimit        uint64
 	gasRegister          types.GasRegister
+	gasRegisterCache     *gasRegisterCache
 	maxQueryStackSize    uint32
 	maxCallDepth         uint32
 	acceptedAccountTypes map[reflect.Type]struct{}
 	accountPruner        AccountPruner
+	storageDepositPolicy StorageDepositPolicy
 	params               collections.Item[types.Params]
 	// propagate gov authZ to sub-messages
 	propagateGovAuthorization map[types.AuthorizationPolicyAction]struct{}
+	// trackContractDependencies enables recording of calls between contracts
+	trackContractDependencies bool
+	// callTracer is notified about the entry and exit of contract calls
+	callTracer types.CallTracer
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
@@ -130,7 +139,11 @@ func (k Keeper) GetParams(ctx context.Context) types.Params {
 
 // SetParams sets all wasm parameters.
 func (k Keeper) SetParams(ctx context.Context, ps types.Params) error {
-	return k.params.Set(ctx, ps)
+	if err := k.params.Set(ctx, ps); err != nil {
+		return err
+	}
+	k.gasRegisterCache.invalidate(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	return nil
 }
 
 // GetAuthority returns the x/wasm module's authority.
@@ -138,11 +151,63 @@ func (k Keeper) GetAuthority() string {
 	return k.authority
 }
 
-// GetGasRegister returns the x/wasm module's gas register.
+// GetGasRegister returns the x/wasm module's gas register of the keeper configuration.
+// See GetActiveGasRegister for the register that is applied to contract calls.
 func (k Keeper) GetGasRegister() types.GasRegister {
 	return k.gasRegister
 }
 
+// GetActiveGasRegister returns the gas register that is applied to contract calls. It is built from the gas costs in
+// the params or is the gas register of the keeper configuration when the params do not set gas costs.
+// The params read is charged to the gas meter of the context.
+func (k Keeper) GetActiveGasRegister(ctx context.Context) types.GasRegister {
+	if gasCosts := k.GetParams(ctx).GasCosts; gasCosts != nil {
+		return types.NewWasmGasRegister(gasCosts.GasRegisterConfig())
+	}
+	return k.gasRegister
+}
+
+// gasRegisterFor returns the gas register that the GasRegisterDecorator stored in the context. Outside a tx, the
+// active register is resolved once per block height.
+func (k Keeper) gasRegisterFor(ctx context.Context) types.GasRegister {
+	if gasRegister, ok := types.GasRegisterFromContext(ctx); ok {
+		return gasRegister
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return k.gasRegisterCache.getOrLoad(sdkCtx.BlockHeight(), func() types.GasRegister {
+		return k.GetActiveGasRegister(sdkCtx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
+	})
+}
+
+// gasRegisterCache keeps the active gas register of a block height for the contract calls outside a tx,
+// like begin/end blockers, gov proposals and queries
+type gasRegisterCache struct {
+	mu          sync.Mutex
+	height      int64
+	gasRegister types.GasRegister
+	// params were set at this height so that the register is not cached for it, as the update may still be reverted
+	dirtyHeight int64
+}
+
+func (c *gasRegisterCache) getOrLoad(height int64, load func() types.GasRegister) types.GasRegister {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.gasRegister != nil && c.height == height {
+		return c.gasRegister
+	}
+	gasRegister := load()
+	if height != c.dirtyHeight {
+		c.height, c.gasRegister = height, gasRegister
+	}
+	return gasRegister
+}
+
+func (c *gasRegisterCache) invalidate(height int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gasRegister, c.dirtyHeight = nil, height
+}
+
 func (k Keeper) create(ctx context.Context, creator sdk.AccAddress, wasmCode []byte, instantiateAccess *types.AccessConfig, authZ types.AuthorizationPolicy) (codeID uint64, checksum []byte, err error) {
 	if creator == nil {
 		return 0, checksum, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "cannot be nil")
@@ -163,13 +228,23 @@ func (k Keeper) create(ctx context.Context, creator sdk.AccAddress, wasmCode []b
 	}
 
 	if ioutils.IsGzip(wasmCode) {
-		sdkCtx.GasMeter().ConsumeGas(k.gasRegister.UncompressCosts(len(wasmCode)), "Uncompress gzip bytecode")
+		sdkCtx.GasMeter().ConsumeGas(k.gasRegisterFor(ctx).UncompressCosts(len(wasmCode)), "Uncompress gzip bytecode")
 		wasmCode, err = ioutils.Uncompress(wasmCode, int64(types.MaxWasmSize))
 		if err != nil {
 			return 0, checksum, types.ErrCreateFailed.Wrap(errorsmod.Wrap(err, "uncompress wasm archive").Error())
 		}
 	}
 
+	if params := k.GetParams(sdkCtx); len(params.BlockedChecksums) != 0 {
+		checksum, err = wasmvm.CreateChecksum(wasmCode)
+		if err != nil {
+			return 0, checksum, errorsmod.Wrap(types.ErrCreateFailed, err.Error())
+		}
+		if params.IsBlockedChecksum(checksum) {
+			return 0, checksum, types.ErrChecksumBlocked.Wrapf("checksum %X", checksum)
+		}
+	}
+
 	gasLeft := k.runtimeGasForContract(sdkCtx)
 	var gasUsed uint64
 	isSimulation := sdkCtx.ExecMode() == sdk.ExecModeSimulate
@@ -195,6 +270,9 @@ func (k Keeper) create(ctx context.Context, creator sdk.AccAddress, wasmCode []b
 	k.Logger(sdkCtx).Debug("storing new contract", "capabilities", report.RequiredCapabilities, "code_id", codeID)
 	codeInfo := types.NewCodeInfo(checksum, creator, *instantiateAccess)
 	k.mustStoreCodeInfo(sdkCtx, codeID, codeInfo)
+	if err := k.addToCodeSecondaryIndexes(sdkCtx, codeID, codeInfo); err != nil {
+		return 0, checksum, err
+	}
 
 	evt := sdk.NewEvent(
 		types.EventTypeStoreCode,
@@ -244,7 +322,23 @@ func (k Keeper) importCode(ctx context.Context, codeID uint64, codeInfo types.Co
 		return errorsmod.Wrapf(types.ErrDuplicate, "duplicate code: %d", codeID)
 	}
 	// 0x01 | codeID (uint64) -> ContractInfo
-	return store.Set(key, k.cdc.MustMarshal(&codeInfo))
+	if err := store.Set(key, k.cdc.MustMarshal(&codeInfo)); err != nil {
+		return err
+	}
+	return k.addToCodeSecondaryIndexes(ctx, codeID, codeInfo)
+}
+
+// addToCodeSecondaryIndexes adds elements to the indexes for codes-by-checksum and codes-by-creator queries
+func (k Keeper) addToCodeSecondaryIndexes(ctx context.Context, codeID uint64, codeInfo types.CodeInfo) error {
+	creator, err := sdk.AccAddressFromBech32(codeInfo.Creator)
+	if err != nil {
+		return errorsmod.Wrap(err, "creator")
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.GetCodeByChecksumSecondaryIndexKey(codeInfo.CodeHash, codeID), []byte{}); err != nil {
+		return err
+	}
+	return store.Set(types.GetCodeByCreatorSecondaryIndexKey(creator, codeID), []byte{})
 }
 
 func (k Keeper) instantiate(
@@ -256,7 +350,7 @@ func (k Keeper) instantiate(
 	deposit sdk.Coins,
 	addressGenerator AddressGenerator,
 	authPolicy types.AuthorizationPolicy,
-) (sdk.AccAddress, []byte, error) {
+) (contractAddress sdk.AccAddress, data []byte, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "instantiate")
 
 	initMsg, err := ioutils.CompactMsg(rawInitMsg)
@@ -267,24 +361,40 @@ func (k Keeper) instantiate(
 	if creator == nil {
 		return nil, nil, types.ErrEmpty.Wrap("creator")
 	}
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	setupCost := k.gasRegister.SetupContractCost(k.IsPinnedCode(sdkCtx, codeID), len(initMsg))
+	sdkCtx, traceExit := k.traceCall(sdk.UnwrapSDKContext(ctx), types.TracedCall{Entrypoint: types.TraceEntrypointInstantiate, Caller: creator, CodeID: codeID}, initMsg)
+	defer traceExit(&contractAddress, &data, &err)
+	profiler, _ := types.GasProfilerFromContext(ctx)
+	profiler.Enter(types.GasProfileStepInstantiate, nil, sdkCtx.GasMeter())
+	defer profiler.ExitWithError(&err)
+
+	setupCost := k.gasRegisterFor(ctx).SetupContractCost(k.IsPinnedCode(sdkCtx, codeID), len(initMsg))
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: instantiate")
+	profiler.Record(types.GasProfileStepSetup, setupCost)
 
 	codeInfo := k.GetCodeInfo(ctx, codeID)
 	if codeInfo == nil {
 		return nil, nil, types.ErrNoSuchCodeFn(codeID).Wrapf("code id %d", codeID)
 	}
+	if codeInfo.Status == types.CodeStatusDeprecated {
+		return nil, nil, types.ErrCodeDeprecated.Wrapf("code id %d", codeID)
+	}
 	if !authPolicy.CanInstantiateContract(codeInfo.InstantiateConfig, creator) {
 		return nil, nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not instantiate")
 	}
-	contractAddress := addressGenerator(ctx, codeID, codeInfo.CodeHash)
+	contractAddress = addressGenerator(ctx, codeID, codeInfo.CodeHash)
+	profiler.SetContract(contractAddress)
 	if k.HasContractInfo(ctx, contractAddress) {
 		// This case must only happen for instantiate2 because instantiate is based on a counter in state.
 		// So we create an instantiate2 specific error message here even though technically this function
 		// is used for both cases.
 		return nil, nil, types.ErrDuplicate.Wrap("contract address already exists, try a different combination of creator, checksum and salt")
 	}
+	if k.IsContractPurged(sdkCtx, contractAddress) {
+		return nil, nil, types.ErrDuplicate.Wrap("contract address was used by a purged contract, try a different combination of creator, checksum and salt")
+	}
+	if err := k.checkUniqueContractLabel(sdkCtx, creator, label); err != nil {
+		return nil, nil, err
+	}
 
 	// check account
 	// every cosmos module can define custom account types when needed. The cosmos-sdk comes with extension points
@@ -331,13 +441,13 @@ func (k Keeper) instantiate(
 
 	// create prefixed data store
 	// 0x03 | BuildContractAddressClassic (sdk.AccAddress)
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	vmStore := types.NewStoreAdapter(prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(sdkCtx)), prefixStoreKey))
+	vmStore := k.contractStore(sdkCtx, contractAddress)
 
 	// prepare querier
 	querier := k.newQueryHandler(sdkCtx, contractAddress)
 
 	// instantiate wasm contract
+	storageBefore := k.storageStatsSnapshot(sdkCtx, contractAddress)
 	gasLeft := k.runtimeGasForContract(sdkCtx)
 	res, gasUsed, err := k.wasmVM.Instantiate(codeInfo.CodeHash, env, info, initMsg, vmStore, cosmwasmAPI, querier, k.gasMeter(sdkCtx), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(sdkCtx, gasUsed)
@@ -351,6 +461,9 @@ func (k Keeper) instantiate(
 	if res.Err != "" {
 		return nil, nil, types.MarkErrorDeterministic(errorsmod.Wrap(types.ErrInstantiateFailed, res.Err))
 	}
+	if err := k.settleStorageDeposit(sdkCtx, contractAddress, storageBefore, vmStore); err != nil {
+		return nil, nil, err
+	}
 
 	// persist instance first
 	createdAt := types.NewAbsoluteTxPosition(sdkCtx)
@@ -380,6 +493,14 @@ func (k Keeper) instantiate(
 	if err != nil {
 		return nil, nil, err
 	}
+	err = k.addToContractAdminSecondaryIndex(sdkCtx, contractAddress, contractInfo.Admins())
+	if err != nil {
+		return nil, nil, err
+	}
+	err = k.addToContractLabelSecondaryIndex(sdkCtx, contractAddress, &contractInfo)
+	if err != nil {
+		return nil, nil, err
+	}
 	err = k.appendToContractHistory(sdkCtx, contractAddress, historyEntry)
 	if err != nil {
 		return nil, nil, err
@@ -394,7 +515,7 @@ func (k Keeper) instantiate(
 	))
 
 	sdkCtx = types.WithSubMsgAuthzPolicy(sdkCtx, authPolicy.SubMessageAuthorizationPolicy(types.AuthZActionInstantiate))
-	data, err := k.handleContractResponse(sdkCtx, contractAddress, contractInfo.IBCPortID, res.Ok.Messages, res.Ok.Attributes, res.Ok.Data, res.Ok.Events)
+	data, err = k.handleContractResponse(sdkCtx, contractAddress, contractInfo.IBCPortID, res.Ok.Messages, res.Ok.Attributes, res.Ok.Data, res.Ok.Events)
 	if err != nil {
 		return nil, nil, errorsmod.Wrap(err, "dispatch")
 	}
@@ -403,16 +524,25 @@ func (k Keeper) instantiate(
 }
 
 // Execute executes the contract instance
-func (k Keeper) execute(ctx context.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error) {
+func (k Keeper) execute(ctx context.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins, authZ types.AuthorizationPolicy) (data []byte, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "execute")
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
+	sdkCtx, traceExit := k.traceCall(sdk.UnwrapSDKContext(ctx), types.TracedCall{Entrypoint: types.TraceEntrypointExecute, Caller: caller, Callee: contractAddress}, msg)
+	defer traceExit(&contractAddress, &data, &err)
+	profiler, _ := types.GasProfilerFromContext(ctx)
+	profiler.Enter(types.GasProfileStepExecute, contractAddress, sdkCtx.GasMeter())
+	defer profiler.ExitWithError(&err)
+
+	contractInfo, codeInfo, prefixStore, err := k.executableContractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
+	if contractInfo.ExecutePermission != nil && !authZ.CanExecuteContract(*contractInfo.ExecutePermission, caller) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not execute contract")
+	}
 
-	setupCost := k.gasRegister.SetupContractCost(k.IsPinnedCode(ctx, contractInfo.CodeID), len(msg))
+	setupCost := k.gasRegisterFor(ctx).SetupContractCost(k.IsPinnedCode(ctx, contractInfo.CodeID), len(msg))
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: execute")
+	profiler.Record(types.GasProfileStepSetup, setupCost)
 
 	// add more funds
 	if !coins.IsZero() {
@@ -426,6 +556,7 @@ func (k Keeper) execute(ctx context.Context, contractAddress, caller sdk.AccAddr
 
 	// prepare querier
 	querier := k.newQueryHandler(sdkCtx, contractAddress)
+	storageBefore := k.storageStatsSnapshot(sdkCtx, contractAddress)
 	gasLeft := k.runtimeGasForContract(sdkCtx)
 	res, gasUsed, execErr := k.wasmVM.Execute(codeInfo.CodeHash, env, info, msg, prefixStore, cosmwasmAPI, querier, k.gasMeter(sdkCtx), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(sdkCtx, gasUsed)
@@ -439,13 +570,16 @@ func (k Keeper) execute(ctx context.Context, contractAddress, caller sdk.AccAddr
 	if res.Err != "" {
 		return nil, types.MarkErrorDeterministic(errorsmod.Wrap(types.ErrExecuteFailed, res.Err))
 	}
+	if err := k.settleStorageDeposit(sdkCtx, contractAddress, storageBefore, prefixStore); err != nil {
+		return nil, err
+	}
 
 	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeExecute,
 		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
 	))
 
-	data, err := k.handleContractResponse(sdkCtx, contractAddress, contractInfo.IBCPortID, res.Ok.Messages, res.Ok.Attributes, res.Ok.Data, res.Ok.Events)
+	data, err = k.handleContractResponse(sdkCtx, contractAddress, contractInfo.IBCPortID, res.Ok.Messages, res.Ok.Attributes, res.Ok.Data, res.Ok.Events)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "dispatch")
 	}
@@ -460,7 +594,7 @@ func (k Keeper) migrate(
 	newCodeID uint64,
 	rawMsg []byte,
 	authZ types.AuthorizationPolicy,
-) ([]byte, error) {
+) (data []byte, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "migrate")
 
 	msg, err := ioutils.CompactMsg(rawMsg)
@@ -468,20 +602,24 @@ func (k Keeper) migrate(
 		return nil, errorsmod.Wrapf(types.ErrInvalidMsg, "failed to compact migrate msg: %s", err.Error())
 	}
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx, traceExit := k.traceCall(sdk.UnwrapSDKContext(ctx), types.TracedCall{Entrypoint: types.TraceEntrypointMigrate, Caller: caller, Callee: contractAddress}, msg)
+	defer traceExit(&contractAddress, &data, &err)
 
 	contractInfo := k.GetContractInfo(ctx, contractAddress)
 	if contractInfo == nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
 	}
-	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
-		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not migrate")
+	if !canModifyContract(authZ, contractInfo, caller) {
+		return nil, unauthorizedModification(contractInfo, "can not migrate")
 	}
 
 	newCodeInfo := k.GetCodeInfo(ctx, newCodeID)
 	if newCodeInfo == nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown code")
 	}
+	if newCodeInfo.Status == types.CodeStatusDeprecated {
+		return nil, types.ErrCodeDeprecated.Wrapf("code id %d", newCodeID)
+	}
 
 	if !authZ.CanInstantiateContract(newCodeInfo.InstantiateConfig, caller) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "to use new code")
@@ -546,8 +684,6 @@ func (k Keeper) migrate(
 		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
 	))
 
-	var data []byte
-
 	// if migrate entry point was called
 	if response != nil {
 		sdkCtx = types.WithSubMsgAuthzPolicy(sdkCtx, authZ.SubMessageAuthorizationPolicy(types.AuthZActionMigrateContract))
@@ -575,17 +711,22 @@ func (k Keeper) callMigrateEntrypoint(
 	newChecksum wasmvmtypes.Checksum,
 	msg []byte,
 	newCodeID uint64,
-) (*wasmvmtypes.Response, error) {
-	setupCost := k.gasRegister.SetupContractCost(k.IsPinnedCode(sdkCtx, newCodeID), len(msg))
+) (_ *wasmvmtypes.Response, err error) {
+	profiler, _ := types.GasProfilerFromContext(sdkCtx)
+	profiler.Enter(types.GasProfileStepMigrate, contractAddress, sdkCtx.GasMeter())
+	defer profiler.ExitWithError(&err)
+
+	setupCost := k.gasRegisterFor(sdkCtx).SetupContractCost(k.IsPinnedCode(sdkCtx, newCodeID), len(msg))
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: migrate")
+	profiler.Record(types.GasProfileStepSetup, setupCost)
 
 	env := types.NewEnv(sdkCtx, contractAddress)
 
 	// prepare querier
 	querier := k.newQueryHandler(sdkCtx, contractAddress)
 
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	vmStore := types.NewStoreAdapter(prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(sdkCtx)), prefixStoreKey))
+	vmStore := k.contractStore(sdkCtx, contractAddress)
+	storageBefore := k.storageStatsSnapshot(sdkCtx, contractAddress)
 	gasLeft := k.runtimeGasForContract(sdkCtx)
 	res, gasUsed, err := k.wasmVM.Migrate(newChecksum, env, msg, vmStore, cosmwasmAPI, &querier, k.gasMeter(sdkCtx), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(sdkCtx, gasUsed)
@@ -599,6 +740,9 @@ func (k Keeper) callMigrateEntrypoint(
 	if res.Err != "" {
 		return nil, types.MarkErrorDeterministic(errorsmod.Wrap(types.ErrMigrationFailed, res.Err))
 	}
+	if err := k.settleStorageDeposit(sdkCtx, contractAddress, storageBefore, vmStore); err != nil {
+		return nil, err
+	}
 	return res.Ok, nil
 }
 
@@ -610,22 +754,28 @@ func (k Keeper) callMigrateEntrypoint(
 // customized though by passing a new policy with the context. See types.WithSubMsgAuthzPolicy.
 // The policy will be read in msgServer.selectAuthorizationPolicy and used for sub-message executions.
 // This is an extension point for some very advanced scenarios only. Use with care!
-func (k Keeper) Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+func (k Keeper) Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) (data []byte, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "sudo")
+	sdkCtx, traceExit := k.traceCall(sdk.UnwrapSDKContext(ctx), types.TracedCall{Entrypoint: types.TraceEntrypointSudo, Callee: contractAddress}, msg)
+	defer traceExit(&contractAddress, &data, &err)
+	profiler, _ := types.GasProfilerFromContext(ctx)
+	profiler.Enter(types.GasProfileStepSudo, contractAddress, sdkCtx.GasMeter())
+	defer profiler.ExitWithError(&err)
 
-	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
+	contractInfo, codeInfo, prefixStore, err := k.executableContractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
 
-	setupCost := k.gasRegister.SetupContractCost(k.IsPinnedCode(ctx, contractInfo.CodeID), len(msg))
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	setupCost := k.gasRegisterFor(ctx).SetupContractCost(k.IsPinnedCode(ctx, contractInfo.CodeID), len(msg))
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: sudo")
+	profiler.Record(types.GasProfileStepSetup, setupCost)
 
 	env := types.NewEnv(sdkCtx, contractAddress)
 
 	// prepare querier
 	querier := k.newQueryHandler(sdkCtx, contractAddress)
+	storageBefore := k.storageStatsSnapshot(sdkCtx, contractAddress)
 	gasLeft := k.runtimeGasForContract(sdkCtx)
 	res, gasUsed, execErr := k.wasmVM.Sudo(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, k.gasMeter(sdkCtx), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(sdkCtx, gasUsed)
@@ -639,6 +789,9 @@ func (k Keeper) Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []
 	if res.Err != "" {
 		return nil, types.MarkErrorDeterministic(errorsmod.Wrap(types.ErrExecuteFailed, res.Err))
 	}
+	if err := k.settleStorageDeposit(sdkCtx, contractAddress, storageBefore, prefixStore); err != nil {
+		return nil, err
+	}
 
 	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeSudo,
@@ -646,7 +799,7 @@ func (k Keeper) Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []
 	))
 
 	// sudo submessages are executed with the default authorization policy
-	data, err := k.handleContractResponse(sdkCtx, contractAddress, contractInfo.IBCPortID, res.Ok.Messages, res.Ok.Attributes, res.Ok.Data, res.Ok.Events)
+	data, err = k.handleContractResponse(sdkCtx, contractAddress, contractInfo.IBCPortID, res.Ok.Messages, res.Ok.Attributes, res.Ok.Data, res.Ok.Events)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "dispatch")
 	}
@@ -655,15 +808,22 @@ func (k Keeper) Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []
 }
 
 // reply is only called from keeper internal functions (dispatchSubmessages) after processing the submessage
-func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) ([]byte, error) {
-	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
+func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply wasmvmtypes.Reply) (data []byte, err error) {
+	ctx, traceExit := k.traceCall(ctx, types.TracedCall{Entrypoint: types.TraceEntrypointReply, Callee: contractAddress}, reply)
+	defer traceExit(&contractAddress, &data, &err)
+	profiler, _ := types.GasProfilerFromContext(ctx)
+	profiler.Enter(types.GasProfileStepReply, contractAddress, ctx.GasMeter())
+	defer profiler.ExitWithError(&err)
+
+	contractInfo, codeInfo, prefixStore, err := k.executableContractInstance(ctx, contractAddress)
 	if err != nil {
 		return nil, err
 	}
 
 	// always consider this pinned
-	replyCosts := k.gasRegister.ReplyCosts(true, reply)
+	replyCosts := k.gasRegisterFor(ctx).ReplyCosts(true, reply)
 	ctx.GasMeter().ConsumeGas(replyCosts, "Loading CosmWasm module: reply")
+	profiler.Record(types.GasProfileStepSetup, replyCosts)
 
 	env := types.NewEnv(ctx, contractAddress)
 
@@ -671,6 +831,7 @@ func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply was
 	querier := k.newQueryHandler(ctx, contractAddress)
 	gasLeft := k.runtimeGasForContract(ctx)
 
+	storageBefore := k.storageStatsSnapshot(ctx, contractAddress)
 	res, gasUsed, execErr := k.wasmVM.Reply(codeInfo.CodeHash, env, reply, prefixStore, cosmwasmAPI, querier, k.gasMeter(ctx), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if execErr != nil {
@@ -683,13 +844,16 @@ func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply was
 	if res.Err != "" {
 		return nil, types.MarkErrorDeterministic(errorsmod.Wrap(types.ErrExecuteFailed, res.Err))
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddress, storageBefore, prefixStore); err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeReply,
 		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
 	))
 
-	data, err := k.handleContractResponse(ctx, contractAddress, contractInfo.IBCPortID, res.Ok.Messages, res.Ok.Attributes, res.Ok.Data, res.Ok.Events)
+	data, err = k.handleContractResponse(ctx, contractAddress, contractInfo.IBCPortID, res.Ok.Messages, res.Ok.Attributes, res.Ok.Data, res.Ok.Events)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "dispatch")
 	}
@@ -714,6 +878,82 @@ func (k Keeper) addToContractCreatorSecondaryIndex(ctx context.Context, creatorA
 	return store.Set(types.GetContractByCreatorSecondaryIndexKey(creatorAddress, position.Bytes(), contractAddress), []byte{})
 }
 
+// addToContractAdminSecondaryIndex adds element to the index for contracts-by-admin queries for all admins
+func (k Keeper) addToContractAdminSecondaryIndex(ctx context.Context, contractAddress sdk.AccAddress, admins types.AdminSet) error {
+	store := k.storeService.OpenKVStore(ctx)
+	for _, a := range admins.Members {
+		adminAddr, err := sdk.AccAddressFromBech32(a)
+		if err != nil {
+			return errorsmod.Wrap(err, "admin")
+		}
+		if err := store.Set(types.GetContractByAdminSecondaryIndexKey(adminAddr, contractAddress), []byte{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeFromContractAdminSecondaryIndex removes element from the index for contracts-by-admin queries for all admins
+func (k Keeper) removeFromContractAdminSecondaryIndex(ctx context.Context, contractAddress sdk.AccAddress, admins types.AdminSet) error {
+	store := k.storeService.OpenKVStore(ctx)
+	for _, a := range admins.Members {
+		adminAddr, err := sdk.AccAddressFromBech32(a)
+		if err != nil {
+			return errorsmod.Wrap(err, "admin")
+		}
+		if err := store.Delete(types.GetContractByAdminSecondaryIndexKey(adminAddr, contractAddress)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addToContractLabelSecondaryIndex adds element to the index for contracts-by-label queries
+func (k Keeper) addToContractLabelSecondaryIndex(ctx context.Context, contractAddress sdk.AccAddress, contractInfo *types.ContractInfo) error {
+	creator, err := sdk.AccAddressFromBech32(contractInfo.Creator)
+	if err != nil {
+		return errorsmod.Wrap(err, "creator")
+	}
+	return k.storeService.OpenKVStore(ctx).Set(types.GetContractByLabelSecondaryIndexKey(contractInfo.Label, creator, contractAddress), []byte{})
+}
+
+// removeFromContractLabelSecondaryIndex removes element from the index for contracts-by-label queries
+func (k Keeper) removeFromContractLabelSecondaryIndex(ctx context.Context, contractAddress sdk.AccAddress, contractInfo *types.ContractInfo) error {
+	creator, err := sdk.AccAddressFromBech32(contractInfo.Creator)
+	if err != nil {
+		return errorsmod.Wrap(err, "creator")
+	}
+	return k.storeService.OpenKVStore(ctx).Delete(types.GetContractByLabelSecondaryIndexKey(contractInfo.Label, creator, contractAddress))
+}
+
+// checkUniqueContractLabel fails when labels must be unique per creator and the creator has a contract with the label
+func (k Keeper) checkUniqueContractLabel(ctx context.Context, creator sdk.AccAddress, label string) error {
+	if !k.GetParams(ctx).UniqueContractLabels {
+		return nil
+	}
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetContractsByLabelAndCreatorPrefix(label, creator))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	if iter.Valid() {
+		return errorsmod.Wrapf(types.ErrDuplicate, "label %q is used by another contract of the creator", label)
+	}
+	return nil
+}
+
+// IterateContractsByAdmin iterates over all contracts with given admin or admin set member address in order of the
+// contract address.
+func (k Keeper) IterateContractsByAdmin(ctx context.Context, admin sdk.AccAddress, cb func(address sdk.AccAddress) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetContractsByAdminPrefix(admin))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(iter.Key()) {
+			return
+		}
+	}
+}
+
 // IterateContractsByCreator iterates over all contracts with given creator address in order of creation time asc.
 func (k Keeper) IterateContractsByCreator(ctx context.Context, creator sdk.AccAddress, cb func(address sdk.AccAddress) bool) {
 	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetContractsByCreatorPrefix(creator))
@@ -739,18 +979,41 @@ func (k Keeper) IterateContractsByCode(ctx context.Context, codeID uint64, cb fu
 	}
 }
 
+// IterateCodeIDsByChecksum iterates over the ids of all codes with the given checksum in ascending order
+func (k Keeper) IterateCodeIDsByChecksum(ctx context.Context, checksum []byte, cb func(codeID uint64) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetCodesByChecksumPrefix(checksum))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if cb(binary.BigEndian.Uint64(iter.Key())) {
+			return
+		}
+	}
+}
+
 func (k Keeper) setContractAdmin(ctx context.Context, contractAddress, caller, newAdmin sdk.AccAddress, authZ types.AuthorizationPolicy) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	contractInfo := k.GetContractInfo(sdkCtx, contractAddress)
 	if contractInfo == nil {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
 	}
-	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
-		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	if !canModifyContract(authZ, contractInfo, caller) {
+		return unauthorizedModification(contractInfo, "can not modify contract")
+	}
+	if err := k.removeFromContractAdminSecondaryIndex(sdkCtx, contractAddress, contractInfo.Admins()); err != nil {
+		return err
 	}
 	newAdminStr := newAdmin.String()
 	contractInfo.Admin = newAdminStr
+	contractInfo.AdminSet = nil
 	k.mustStoreContractInfo(sdkCtx, contractAddress, contractInfo)
+	if err := k.addToContractAdminSecondaryIndex(sdkCtx, contractAddress, contractInfo.Admins()); err != nil {
+		return err
+	}
+	if err := k.deleteMigrationApprovals(sdkCtx, contractAddress); err != nil {
+		return err
+	}
 	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeUpdateContractAdmin,
 		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
@@ -766,11 +1029,26 @@ func (k Keeper) setContractLabel(ctx context.Context, contractAddress, caller sd
 	if contractInfo == nil {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
 	}
-	if !authZ.CanModifyContract(contractInfo.AdminAddr(), caller) {
-		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "can not modify contract")
+	if !canModifyContract(authZ, contractInfo, caller) {
+		return unauthorizedModification(contractInfo, "can not modify contract")
+	}
+	if newLabel != contractInfo.Label {
+		creator, err := sdk.AccAddressFromBech32(contractInfo.Creator)
+		if err != nil {
+			return errorsmod.Wrap(err, "creator")
+		}
+		if err := k.checkUniqueContractLabel(sdkCtx, creator, newLabel); err != nil {
+			return err
+		}
+	}
+	if err := k.removeFromContractLabelSecondaryIndex(sdkCtx, contractAddress, contractInfo); err != nil {
+		return err
 	}
 	contractInfo.Label = newLabel
 	k.mustStoreContractInfo(sdkCtx, contractAddress, contractInfo)
+	if err := k.addToContractLabelSecondaryIndex(sdkCtx, contractAddress, contractInfo); err != nil {
+		return err
+	}
 	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeUpdateContractLabel,
 		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
@@ -841,11 +1119,13 @@ func (k Keeper) mustGetLastContractHistoryEntry(ctx context.Context, contractAdd
 }
 
 // QuerySmart queries the smart contract itself.
-func (k Keeper) QuerySmart(ctx context.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+func (k Keeper) QuerySmart(ctx context.Context, contractAddr sdk.AccAddress, req []byte) (result []byte, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "query-smart")
+	sdkCtx, traceExit := k.traceCall(sdk.UnwrapSDKContext(ctx), types.TracedCall{Entrypoint: types.TraceEntrypointQuery, Callee: contractAddr}, req)
+	defer traceExit(&contractAddr, &result, &err)
 
 	// checks and increase query stack size
-	sdkCtx, err := checkAndIncreaseQueryStackSize(sdk.UnwrapSDKContext(ctx), k.maxQueryStackSize)
+	sdkCtx, err = checkAndIncreaseQueryStackSize(sdkCtx, k.maxQueryStackSize)
 	if err != nil {
 		return nil, err
 	}
@@ -855,8 +1135,10 @@ func (k Keeper) QuerySmart(ctx context.Context, contractAddr sdk.AccAddress, req
 		return nil, err
 	}
 
-	setupCost := k.gasRegister.SetupContractCost(k.IsPinnedCode(sdkCtx, contractInfo.CodeID), len(req))
+	setupCost := k.gasRegisterFor(ctx).SetupContractCost(k.IsPinnedCode(sdkCtx, contractInfo.CodeID), len(req))
 	sdkCtx.GasMeter().ConsumeGas(setupCost, "Loading CosmWasm module: query")
+	profiler, _ := types.GasProfilerFromContext(ctx)
+	profiler.Record(types.GasProfileStepSetup, setupCost)
 
 	// prepare querier
 	querier := k.newQueryHandler(sdkCtx, contractAddr)
@@ -921,7 +1203,7 @@ func (k Keeper) QueryRaw(ctx context.Context, contractAddress sdk.AccAddress, ke
 }
 
 // internal helper function
-func (k Keeper) contractInstance(ctx context.Context, contractAddress sdk.AccAddress) (types.ContractInfo, types.CodeInfo, wasmvm.KVStore, error) {
+func (k Keeper) contractInstance(ctx context.Context, contractAddress sdk.AccAddress) (types.ContractInfo, types.CodeInfo, *types.StoreAdapter, error) {
 	store := k.storeService.OpenKVStore(ctx)
 
 	contractBz, err := store.Get(types.GetContractAddressKey(contractAddress))
@@ -946,9 +1228,103 @@ func (k Keeper) contractInstance(ctx context.Context, contractAddress sdk.AccAdd
 	}
 	var codeInfo types.CodeInfo
 	k.cdc.MustUnmarshal(codeInfoBz, &codeInfo)
-	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
-	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), prefixStoreKey)
-	return contractInfo, codeInfo, types.NewStoreAdapter(prefixStore), nil
+	return contractInfo, codeInfo, k.contractStore(ctx, contractAddress), nil
+}
+
+// contractStore returns the store of the contract for the wasmvm. All writes update the contract storage stats, which
+// are persisted when the store is flushed after a successful contract call.
+func (k Keeper) contractStore(ctx context.Context, contractAddress sdk.AccAddress) *types.StoreAdapter {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.GetContractStorePrefix(contractAddress))
+	return types.NewTrackingStoreAdapter(prefixStore, &contractStorageTracker{
+		keeper:          k,
+		ctx:             sdk.UnwrapSDKContext(ctx),
+		contractAddress: contractAddress,
+		contractStore:   prefixStore,
+	})
+}
+
+var _ types.StorageUsageTracker = &contractStorageTracker{}
+
+// contractStorageTracker maintains the storage stats of a contract in memory during a contract call. The stats are
+// read on the first write and stored once on flush. All reads and writes are charged to the gas meter of the context.
+type contractStorageTracker struct {
+	keeper          Keeper
+	ctx             sdk.Context
+	contractAddress sdk.AccAddress
+	contractStore   storetypes.KVStore
+	stats           *types.ContractStorageStats
+}
+
+func (t *contractStorageTracker) OnSet(key, value []byte) {
+	stats := t.loadStats()
+	old := t.contractStore.Get(key)
+	if old != nil {
+		stats.Remove(key, old)
+	}
+	stats.Add(key, value)
+	recorder, _ := types.DryRunRecorderFromContext(t.ctx)
+	recorder.RecordStateChange(t.contractAddress, key, old, value)
+}
+
+func (t *contractStorageTracker) OnDelete(key []byte) {
+	old := t.contractStore.Get(key)
+	if old == nil {
+		return
+	}
+	t.loadStats().Remove(key, old)
+	recorder, _ := types.DryRunRecorderFromContext(t.ctx)
+	recorder.RecordStateChange(t.contractAddress, key, old, nil)
+}
+
+// Flush stores the stats when the contract has written to its store
+func (t *contractStorageTracker) Flush() {
+	if t.stats == nil {
+		return
+	}
+	t.keeper.mustStoreContractStorageStats(t.ctx, t.contractAddress, *t.stats)
+	t.stats = nil
+}
+
+func (t *contractStorageTracker) loadStats() *types.ContractStorageStats {
+	if t.stats == nil {
+		stats := t.keeper.GetContractStorageStats(t.ctx, t.contractAddress)
+		t.stats = &stats
+	}
+	return t.stats
+}
+
+// GetContractStorageStats returns the number of keys and bytes stored by the contract
+func (k Keeper) GetContractStorageStats(ctx context.Context, contractAddress sdk.AccAddress) types.ContractStorageStats {
+	var stats types.ContractStorageStats
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.GetContractStorageStatsKey(contractAddress))
+	if err != nil {
+		panic(err)
+	}
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &stats)
+	}
+	return stats
+}
+
+// mustStoreContractStorageStats persists the storage stats of a contract
+func (k Keeper) mustStoreContractStorageStats(ctx context.Context, contractAddress sdk.AccAddress, stats types.ContractStorageStats) {
+	store := k.storeService.OpenKVStore(ctx)
+	err := store.Set(types.GetContractStorageStatsKey(contractAddress), k.cdc.MustMarshal(&stats))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// executableContractInstance is like contractInstance but fails for frozen contracts
+func (k Keeper) executableContractInstance(ctx context.Context, contractAddress sdk.AccAddress) (types.ContractInfo, types.CodeInfo, *types.StoreAdapter, error) {
+	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddress)
+	if err != nil {
+		return types.ContractInfo{}, types.CodeInfo{}, nil, err
+	}
+	if contractInfo.Frozen {
+		return types.ContractInfo{}, types.CodeInfo{}, nil, types.ErrContractFrozen.Wrapf("address %s", contractAddress.String())
+	}
+	return contractInfo, codeInfo, prefixStore, nil
 }
 
 func (k Keeper) LoadAsyncAckPacket(ctx context.Context, portID, channelID string, sequence uint64) (channeltypes.Packet, error) {
@@ -1043,9 +1419,20 @@ func (k Keeper) IterateContractInfo(ctx context.Context, cb func(sdk.AccAddress,
 // IterateContractState iterates through all elements of the key value store for the given contract address and passes
 // them to the provided callback function. The callback method can return true to abort early.
 func (k Keeper) IterateContractState(ctx context.Context, contractAddress sdk.AccAddress, cb func(key, value []byte) bool) {
+	k.IterateContractStateRange(ctx, contractAddress, nil, nil, false, cb)
+}
+
+// IterateContractStateRange iterates through the elements of the key value store for the given contract address with
+// keys in the range [start, end). A nil bound is open. The callback method can return true to abort early.
+func (k Keeper) IterateContractStateRange(ctx context.Context, contractAddress sdk.AccAddress, start, end []byte, reverse bool, cb func(key, value []byte) bool) {
 	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
 	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), prefixStoreKey)
-	iter := prefixStore.Iterator(nil, nil)
+	var iter storetypes.Iterator
+	if reverse {
+		iter = prefixStore.ReverseIterator(start, end)
+	} else {
+		iter = prefixStore.Iterator(start, end)
+	}
 	defer iter.Close()
 
 	for ; iter.Valid(); iter.Next() {
@@ -1058,6 +1445,7 @@ func (k Keeper) IterateContractState(ctx context.Context, contractAddress sdk.Ac
 func (k Keeper) importContractState(ctx context.Context, contractAddress sdk.AccAddress, models []types.Model) error {
 	prefixStoreKey := types.GetContractStorePrefix(contractAddress)
 	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), prefixStoreKey)
+	stats := k.GetContractStorageStats(ctx, contractAddress)
 	for _, model := range models {
 		if model.Value == nil {
 			model.Value = []byte{}
@@ -1066,7 +1454,9 @@ func (k Keeper) importContractState(ctx context.Context, contractAddress sdk.Acc
 			return errorsmod.Wrapf(types.ErrDuplicate, "duplicate key: %x", model.Key)
 		}
 		prefixStore.Set(model.Key, model.Value)
+		stats.Add(model.Key, model.Value)
 	}
+	k.mustStoreContractStorageStats(ctx, contractAddress, stats)
 	return nil
 }
 
@@ -1198,6 +1588,92 @@ func (k Keeper) InitializePinnedCodes(ctx context.Context) error {
 	return nil
 }
 
+// setCodeStatus updates the lifecycle status of a code id.
+func (k Keeper) setCodeStatus(ctx context.Context, codeID uint64, status types.CodeStatus) error {
+	info := k.GetCodeInfo(ctx, codeID)
+	if info == nil {
+		return types.ErrNoSuchCodeFn(codeID).Wrapf("code id %d", codeID)
+	}
+	info.Status = status
+	k.mustStoreCodeInfo(ctx, codeID, *info)
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateCodeStatus,
+		sdk.NewAttribute(types.AttributeKeyCodeStatus, status.String()),
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(codeID, 10)),
+	))
+	return nil
+}
+
+// setContractFrozen freezes or unfreezes a contract. Frozen contracts can not be executed but can still be queried.
+// A freeze by governance can only be lifted by governance. The byGov flag must only be set for the governance authority.
+func (k Keeper) setContractFrozen(ctx context.Context, contractAddress, caller sdk.AccAddress, frozen, byGov bool, authZ types.AuthorizationPolicy) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	contractInfo := k.GetContractInfo(sdkCtx, contractAddress)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !canModifyContract(authZ, contractInfo, caller) {
+		return unauthorizedModification(contractInfo, "can not modify contract")
+	}
+	if !frozen && contractInfo.FrozenByGov && !byGov {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "contract was frozen by governance")
+	}
+	contractInfo.Frozen = frozen
+	contractInfo.FrozenByGov = frozen && (contractInfo.FrozenByGov || byGov)
+	k.mustStoreContractInfo(sdkCtx, contractAddress, contractInfo)
+	if err := k.setFrozenContractIndex(sdkCtx, contractAddress, frozen); err != nil {
+		return err
+	}
+
+	eventType := types.EventTypeUnfreezeContract
+	if frozen {
+		eventType = types.EventTypeFreezeContract
+	}
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+	))
+	return nil
+}
+
+// setContractExecuteConfig updates the permission for executing the contract. A nil config allows everybody.
+func (k Keeper) setContractExecuteConfig(ctx context.Context, contractAddress, caller sdk.AccAddress, newConfig *types.AccessConfig, authZ types.AuthorizationPolicy) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	contractInfo := k.GetContractInfo(sdkCtx, contractAddress)
+	if contractInfo == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unknown contract")
+	}
+	if !canModifyContract(authZ, contractInfo, caller) {
+		return unauthorizedModification(contractInfo, "can not modify contract")
+	}
+	contractInfo.ExecutePermission = newConfig
+	k.mustStoreContractInfo(sdkCtx, contractAddress, contractInfo)
+
+	evt := sdk.NewEvent(
+		types.EventTypeUpdateExecuteConfig,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+	)
+	if newConfig != nil {
+		evt.Attributes = append(evt.Attributes, sdk.NewAttribute(types.AttributeKeyCodePermission, newConfig.Permission.String()).ToKVPair())
+		if addrs := newConfig.AllAuthorizedAddresses(); len(addrs) != 0 {
+			attr := sdk.NewAttribute(types.AttributeKeyAuthorizedAddresses, strings.Join(addrs, ","))
+			evt.Attributes = append(evt.Attributes, attr.ToKVPair())
+		}
+	}
+	sdkCtx.EventManager().EmitEvent(evt)
+	return nil
+}
+
+// setFrozenContractIndex maintains the index for frozen-contracts queries
+func (k Keeper) setFrozenContractIndex(ctx context.Context, contractAddress sdk.AccAddress, frozen bool) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if !frozen {
+		return store.Delete(types.GetFrozenContractIndexKey(contractAddress))
+	}
+	// store 1 byte to not run into `nil` debugging issues
+	return store.Set(types.GetFrozenContractIndexKey(contractAddress), []byte{1})
+}
+
 // setContractInfoExtension updates the extension point data that is stored with the contract info
 func (k Keeper) setContractInfoExtension(ctx context.Context, contractAddr sdk.AccAddress, ext types.ContractInfoExtension) error {
 	info := k.GetContractInfo(ctx, contractAddr)
@@ -1248,8 +1724,10 @@ func (k *Keeper) handleContractResponse(
 	data []byte,
 	evts wasmvmtypes.Array[wasmvmtypes.Event],
 ) ([]byte, error) {
-	attributeGasCost := k.gasRegister.EventCosts(attrs, evts)
+	attributeGasCost := k.gasRegisterFor(ctx).EventCosts(attrs, evts)
 	ctx.GasMeter().ConsumeGas(attributeGasCost, "Custom contract event attributes")
+	profiler, _ := types.GasProfilerFromContext(ctx)
+	profiler.Record(types.GasProfileStepEvents, attributeGasCost)
 	// emit all events from this contract itself
 	if len(attrs) != 0 {
 		wasmEvents, err := newWasmModuleEvent(attrs, contractAddr)
@@ -1276,12 +1754,14 @@ func (k Keeper) runtimeGasForContract(ctx sdk.Context) uint64 {
 	if meter.Limit() == math.MaxUint64 { // infinite gas meter and not out of gas
 		return math.MaxUint64
 	}
-	return k.gasRegister.ToWasmVMGas(meter.Limit() - meter.GasConsumedToLimit())
+	return k.gasRegisterFor(ctx).ToWasmVMGas(meter.Limit() - meter.GasConsumedToLimit())
 }
 
 func (k Keeper) consumeRuntimeGas(ctx sdk.Context, gas uint64) {
-	consumed := k.gasRegister.FromWasmVMGas(gas)
+	consumed := k.gasRegisterFor(ctx).FromWasmVMGas(gas)
 	ctx.GasMeter().ConsumeGas(consumed, "wasm contract")
+	profiler, _ := types.GasProfilerFromContext(ctx)
+	profiler.Record(types.GasProfileStepVM, consumed)
 	// throw OutOfGas error if we ran out (got exactly to zero due to better limit enforcing)
 	if ctx.GasMeter().IsOutOfGas() {
 		panic(storetypes.ErrorOutOfGas{Descriptor: "Wasm engine function execution"})
@@ -1340,6 +1820,9 @@ func (k Keeper) importContract(ctx context.Context, contractAddr sdk.AccAddress,
 	if k.HasContractInfo(ctx, contractAddr) {
 		return errorsmod.Wrapf(types.ErrDuplicate, "contract: %s", contractAddr)
 	}
+	if k.IsContractPurged(ctx, contractAddr) {
+		return errorsmod.Wrapf(types.ErrDuplicate, "purged contract: %s", contractAddr)
+	}
 	if len(historyEntries) == 0 {
 		return types.ErrEmpty.Wrap("contract history")
 	}
@@ -1362,11 +1845,25 @@ func (k Keeper) importContract(ctx context.Context, contractAddr sdk.AccAddress,
 	if err != nil {
 		return err
 	}
+	err = k.addToContractAdminSecondaryIndex(ctx, contractAddr, c.Admins())
+	if err != nil {
+		return err
+	}
+	err = k.addToContractLabelSecondaryIndex(ctx, contractAddr, c)
+	if err != nil {
+		return err
+	}
+	if c.Frozen {
+		err = k.setFrozenContractIndex(ctx, contractAddr, true)
+		if err != nil {
+			return err
+		}
+	}
 	return k.importContractState(ctx, contractAddr, state)
 }
 
 func (k Keeper) newQueryHandler(ctx sdk.Context, contractAddress sdk.AccAddress) QueryHandler {
-	return NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddress, k.gasRegister)
+	return NewQueryHandler(ctx, k.wasmVMQueryHandler, contractAddress, k.gasRegisterFor(ctx))
 }
 
 // MultipliedGasMeter wraps the GasMeter from context and multiplies all reads by out defined multiplier
@@ -1386,7 +1883,7 @@ func (m MultipliedGasMeter) GasConsumed() storetypes.Gas {
 }
 
 func (k Keeper) gasMeter(ctx sdk.Context) MultipliedGasMeter {
-	return NewMultipliedGasMeter(ctx.GasMeter(), k.gasRegister)
+	return NewMultipliedGasMeter(ctx.GasMeter(), k.gasRegisterFor(ctx))
 }
 
 // Logger returns a module-specific logger.
@@ -3,6 +3,7 @@
 package keeper
 
 import (
+	"os"
 	"path/filepath"
 
 	wasmvm "github.com/CosmWasm/wasmvm/v2"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 
+	"github.com/CosmWasm/wasmd/x/wasm/debugsink"
 	"github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
@@ -44,11 +46,15 @@ func NewKeeper(
 		wasmVM:               nil,
 		accountKeeper:        accountKeeper,
 		bank:                 NewBankCoinTransferrer(bankKeeper),
+		bankKeeper:           bankKeeper,
 		accountPruner:        NewVestingCoinBurner(bankKeeper),
+		storageDepositPolicy: NoopStorageDepositPolicy{},
 		portKeeper:           portKeeper,
+		channelKeeper:        channelKeeper,
 		capabilityKeeper:     capabilityKeeper,
 		queryGasLimit:        wasmConfig.SmartQueryGasLimit,
 		gasRegister:          types.NewDefaultWasmGasRegister(),
+		gasRegisterCache:     &gasRegisterCache{},
 		maxQueryStackSize:    types.DefaultMaxQueryStackSize,
 		maxCallDepth:         types.DefaultMaxCallDepth,
 		acceptedAccountTypes: defaultAcceptedAccountTypes,
@@ -64,6 +70,9 @@ func NewKeeper(
 	for _, o := range preOpts {
 		o.apply(keeper)
 	}
+	if wasmConfig.ContractDebugMode && keeper.callTracer == nil {
+		keeper.callTracer = types.NewCallTraceExporter(debugsink.NewJSONLines(os.Stdout))
+	}
 	// always wrap the messenger, even if it was replaced by an option
 	keeper.messenger = callDepthMessageHandler{keeper.messenger, keeper.maxCallDepth}
 	// only set the wasmvm if no one set this in the options
@@ -80,6 +89,10 @@ func NewKeeper(
 		o.apply(keeper)
 	}
 	// not updatable, yet
-	keeper.wasmVMResponseHandler = NewDefaultWasmVMContractResponseHandler(NewMessageDispatcher(keeper.messenger, keeper))
+	dispatcher := NewMessageDispatcher(keeper.messenger, keeper)
+	if keeper.trackContractDependencies {
+		dispatcher.dependencies = keeper
+	}
+	keeper.wasmVMResponseHandler = NewDefaultWasmVMContractResponseHandler(dispatcher)
 	return *keeper
 }
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"bytes"
+	"context"
 	_ "embed"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -16,6 +18,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/rand"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	fuzz "github.com/google/gofuzz"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -306,6 +309,27 @@ func TestCreateDuplicate(t *testing.T) {
 	require.Equal(t, hackatomWasm, storedCode)
 }
 
+func TestCreateWithBlockedChecksum(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := keepers.Faucet.NewFundedRandomAccount(ctx, deposit...)
+	checksum, err := hex.DecodeString(testdata.ChecksumHackatom)
+	require.NoError(t, err)
+
+	params := keepers.WasmKeeper.GetParams(ctx)
+	params.BlockedChecksums = [][]byte{checksum}
+	require.NoError(t, keepers.WasmKeeper.SetParams(ctx, params))
+
+	// when
+	_, _, err = keepers.ContractKeeper.Create(ctx, creator, hackatomWasm, nil)
+	// then
+	require.ErrorIs(t, err, types.ErrChecksumBlocked)
+
+	// and other codes can be stored
+	_, _, err = keepers.ContractKeeper.Create(ctx, creator, testdata.ReflectContractWasm(), nil)
+	require.NoError(t, err)
+}
+
 func TestCreateWithSimulation(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 
@@ -422,7 +446,7 @@ func TestInstantiate(t *testing.T) {
 
 	gasAfter := ctx.GasMeter().GasConsumed()
 	if types.EnableGasVerification {
-		require.Equal(t, uint64(0x1bca5), gasAfter-gasBefore)
+		require.Equal(t, uint64(0x1eae2), gasAfter-gasBefore)
 	}
 
 	// ensure it is stored properly
@@ -2010,7 +2034,7 @@ func TestPinnedContractLoops(t *testing.T) {
 	}
 	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(30_000))
 	require.PanicsWithValue(t, storetypes.ErrorOutOfGas{Descriptor: "ReadFlat"}, func() {
-		_, err := k.execute(ctx, example.Contract, RandomAccountAddress(t), anyMsg, nil)
+		_, err := k.execute(ctx, example.Contract, RandomAccountAddress(t), anyMsg, nil, DefaultAuthorizationPolicy{})
 		require.NoError(t, err)
 	})
 	assert.True(t, ctx.GasMeter().IsOutOfGas())
@@ -2704,6 +2728,46 @@ func TestSetContractLabel(t *testing.T) {
 	}
 }
 
+func TestUniqueContractLabels(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	params := k.GetParams(ctx)
+	params.UniqueContractLabels = true
+	require.NoError(t, k.SetParams(ctx, params))
+
+	codeID := StoreHackatomExampleContract(t, ctx, keepers).CodeID
+	initMsgBz := HackatomExampleInitMsg{Verifier: RandomAccountAddress(t), Beneficiary: RandomAccountAddress(t)}.GetBytes(t)
+	creator, otherCreator := RandomAccountAddress(t), RandomAccountAddress(t)
+
+	contract1, _, err := keepers.ContractKeeper.Instantiate(ctx, codeID, creator, creator, initMsgBz, "first", nil)
+	require.NoError(t, err)
+	contract2, _, err := keepers.ContractKeeper.Instantiate(ctx, codeID, creator, creator, initMsgBz, "second", nil)
+	require.NoError(t, err)
+
+	// when label is used by the creator
+	_, _, err = keepers.ContractKeeper.Instantiate(ctx, codeID, creator, creator, initMsgBz, "first", nil)
+	// then
+	require.ErrorIs(t, err, types.ErrDuplicate)
+
+	// when label is used by other creator
+	_, _, err = keepers.ContractKeeper.Instantiate(ctx, codeID, otherCreator, nil, initMsgBz, "first", nil)
+	// then
+	require.NoError(t, err)
+
+	// when updated to a label of another contract of the creator
+	err = k.setContractLabel(ctx, contract2, creator, "first", DefaultAuthorizationPolicy{})
+	// then
+	require.ErrorIs(t, err, types.ErrDuplicate)
+
+	// when updated to the current label
+	require.NoError(t, k.setContractLabel(ctx, contract1, creator, "first", DefaultAuthorizationPolicy{}))
+
+	// when updated to a free label
+	require.NoError(t, k.setContractLabel(ctx, contract1, creator, "third", DefaultAuthorizationPolicy{}))
+	// then the old label can be used again
+	require.NoError(t, k.setContractLabel(ctx, contract2, creator, "first", DefaultAuthorizationPolicy{}))
+}
+
 func attrsToStringMap(attrs []abci.EventAttribute) map[string]string {
 	r := make(map[string]string, len(attrs))
 	for _, v := range attrs {
@@ -2718,3 +2782,422 @@ func must[t any](s t, err error) t {
 	}
 	return s
 }
+
+func TestFrozenContractCanNotBeCalled(t *testing.T) {
+	var m wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&m)
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := SeedNewContractInstance(t, parentCtx, keepers, &m)
+	k := keepers.WasmKeeper
+	require.NoError(t, k.setContractFrozen(parentCtx, example.Contract, nil, true, true, GovAuthorizationPolicy{}))
+
+	specs := map[string]func(ctx sdk.Context) error{
+		"execute": func(ctx sdk.Context) error {
+			_, err := k.execute(ctx, example.Contract, RandomAccountAddress(t), []byte(`{}`), nil, DefaultAuthorizationPolicy{})
+			return err
+		},
+		"sudo": func(ctx sdk.Context) error {
+			_, err := k.Sudo(ctx, example.Contract, []byte(`{}`))
+			return err
+		},
+		"reply": func(ctx sdk.Context) error {
+			_, err := k.reply(ctx, example.Contract, wasmvmtypes.Reply{})
+			return err
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			// when
+			err := spec(ctx)
+			// then
+			require.ErrorIs(t, err, types.ErrContractFrozen)
+		})
+	}
+}
+
+func TestContractExecuteConfig(t *testing.T) {
+	var m wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&m)
+	m.ExecuteFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 0, nil
+	}
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := SeedNewContractInstance(t, parentCtx, keepers, &m)
+	k := keepers.WasmKeeper
+	allowedAddr, otherAddr := RandomAccountAddress(t), RandomAccountAddress(t)
+	onlyAllowed := types.AccessTypeAnyOfAddresses.With(allowedAddr)
+
+	specs := map[string]struct {
+		config *types.AccessConfig
+		caller sdk.AccAddress
+		authZ  types.AuthorizationPolicy
+		expErr bool
+	}{
+		"no restriction": {
+			caller: otherAddr,
+			authZ:  DefaultAuthorizationPolicy{},
+		},
+		"allowed address": {
+			config: &onlyAllowed,
+			caller: allowedAddr,
+			authZ:  DefaultAuthorizationPolicy{},
+		},
+		"other address": {
+			config: &onlyAllowed,
+			caller: otherAddr,
+			authZ:  DefaultAuthorizationPolicy{},
+			expErr: true,
+		},
+		"nobody": {
+			config: &types.AllowNobody,
+			caller: allowedAddr,
+			authZ:  DefaultAuthorizationPolicy{},
+			expErr: true,
+		},
+		"nobody - gov": {
+			config: &types.AllowNobody,
+			caller: otherAddr,
+			authZ:  GovAuthorizationPolicy{},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			em := sdk.NewEventManager()
+			require.NoError(t, k.setContractExecuteConfig(ctx.WithEventManager(em), example.Contract, example.CreatorAddr, spec.config, DefaultAuthorizationPolicy{}))
+			assert.Equal(t, spec.config, k.GetContractInfo(ctx, example.Contract).ExecutePermission)
+			require.Len(t, em.Events(), 1)
+			assert.Equal(t, types.EventTypeUpdateExecuteConfig, em.Events()[0].Type)
+
+			// when
+			_, err := k.execute(ctx, example.Contract, spec.caller, []byte(`{}`), nil, spec.authZ)
+			// then
+			if spec.expErr {
+				require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+	// and only the admin can change the config
+	err := k.setContractExecuteConfig(parentCtx, example.Contract, otherAddr, &types.AllowNobody, DefaultAuthorizationPolicy{})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	assert.Nil(t, k.GetContractInfo(parentCtx, example.Contract).ExecutePermission)
+}
+
+func TestDeprecatedCodeCanNotBeUsed(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	creator := keepers.Faucet.NewFundedRandomAccount(parentCtx, sdk.NewInt64Coin("denom", 100000))
+	example := InstantiateHackatomExampleContract(t, parentCtx, keepers)
+	newCodeID := StoreHackatomExampleContract(t, parentCtx, keepers).CodeID
+	initMsgBz := HackatomExampleInitMsg{
+		Verifier:    example.VerifierAddr,
+		Beneficiary: example.BeneficiaryAddr,
+	}.GetBytes(t)
+
+	// when
+	require.NoError(t, k.setCodeStatus(parentCtx, example.CodeID, types.CodeStatusDeprecated))
+	require.NoError(t, k.setCodeStatus(parentCtx, newCodeID, types.CodeStatusDeprecated))
+
+	// then
+	ctx, _ := parentCtx.CacheContext()
+	_, _, err := keepers.ContractKeeper.Instantiate(ctx, example.CodeID, creator, nil, initMsgBz, "test", nil)
+	require.ErrorIs(t, err, types.ErrCodeDeprecated)
+	_, err = keepers.ContractKeeper.Migrate(ctx, example.Contract, example.CreatorAddr, newCodeID, []byte(`{}`))
+	require.ErrorIs(t, err, types.ErrCodeDeprecated)
+	// existing instances keep running
+	_, err = keepers.ContractKeeper.Execute(ctx, example.Contract, example.VerifierAddr, []byte(`{"release":{}}`), nil)
+	require.NoError(t, err)
+
+	// and when reactivated
+	require.NoError(t, k.setCodeStatus(parentCtx, example.CodeID, types.CodeStatusActive))
+	_, _, err = keepers.ContractKeeper.Instantiate(parentCtx, example.CodeID, creator, nil, initMsgBz, "test", nil)
+	require.NoError(t, err)
+}
+
+func TestContractStorageStats(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, ctx, keepers, &mock)
+	require.Equal(t, types.ContractStorageStats{}, k.GetContractStorageStats(ctx, example.Contract))
+
+	specs := []struct {
+		name      string
+		storeOp   func(store wasmvm.KVStore)
+		failedRsp bool
+		expStats  types.ContractStorageStats
+	}{
+		{
+			name:     "set new key",
+			storeOp:  func(store wasmvm.KVStore) { store.Set([]byte("a"), []byte("111")) },
+			expStats: types.ContractStorageStats{KeyCount: 1, TotalBytes: 4},
+		},
+		{
+			name:     "overwrite key",
+			storeOp:  func(store wasmvm.KVStore) { store.Set([]byte("a"), []byte("1")) },
+			expStats: types.ContractStorageStats{KeyCount: 1, TotalBytes: 2},
+		},
+		{
+			name:     "set other key",
+			storeOp:  func(store wasmvm.KVStore) { store.Set([]byte("b"), []byte("22")) },
+			expStats: types.ContractStorageStats{KeyCount: 2, TotalBytes: 5},
+		},
+		{
+			name:     "delete key",
+			storeOp:  func(store wasmvm.KVStore) { store.Delete([]byte("a")) },
+			expStats: types.ContractStorageStats{KeyCount: 1, TotalBytes: 3},
+		},
+		{
+			name:     "delete non existing key",
+			storeOp:  func(store wasmvm.KVStore) { store.Delete([]byte("unknown")) },
+			expStats: types.ContractStorageStats{KeyCount: 1, TotalBytes: 3},
+		},
+		{
+			name: "multiple writes in one call",
+			storeOp: func(store wasmvm.KVStore) {
+				store.Set([]byte("c"), []byte("3"))
+				store.Set([]byte("c"), []byte("333"))
+				store.Set([]byte("d"), []byte("4"))
+				store.Delete([]byte("d"))
+			},
+			expStats: types.ContractStorageStats{KeyCount: 2, TotalBytes: 7},
+		},
+		{
+			name:      "failed call",
+			storeOp:   func(store wasmvm.KVStore) { store.Set([]byte("e"), []byte("5")) },
+			failedRsp: true,
+			expStats:  types.ContractStorageStats{KeyCount: 2, TotalBytes: 7},
+		},
+	}
+	for _, spec := range specs {
+		t.Run(spec.name, func(t *testing.T) {
+			mock.ExecuteFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+				spec.storeOp(store)
+				if spec.failedRsp {
+					return &wasmvmtypes.ContractResult{Err: "failed"}, 0, nil
+				}
+				return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 0, nil
+			}
+			// when
+			cacheCtx, commit := ctx.CacheContext()
+			_, err := keepers.ContractKeeper.Execute(cacheCtx, example.Contract, example.CreatorAddr, []byte(`{}`), nil)
+			// then
+			if spec.failedRsp {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				commit()
+			}
+			assert.Equal(t, spec.expStats, k.GetContractStorageStats(ctx, example.Contract))
+			// and matches the contract state
+			var exp types.ContractStorageStats
+			k.IterateContractState(ctx, example.Contract, func(key, value []byte) bool {
+				exp.Add(key, value)
+				return false
+			})
+			assert.Equal(t, exp, spec.expStats)
+		})
+	}
+}
+
+func TestPurgeContract(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	otherContract := SeedNewContractInstance(t, parentCtx, keepers, &mock).Contract
+	otherAddr := RandomAccountAddress(t)
+	beneficiary := RandomAccountAddress(t)
+	k.recordContractDependency(parentCtx, example.Contract, otherContract.String())
+	k.recordContractDependency(parentCtx, otherContract, example.Contract.String())
+	withIBCChannel := func(state channeltypes.State) func(ctx sdk.Context) {
+		return func(ctx sdk.Context) {
+			contractInfo := k.GetContractInfo(ctx, example.Contract)
+			contractInfo.IBCPortID = PortIDForContract(example.Contract)
+			k.mustStoreContractInfo(ctx, example.Contract, contractInfo)
+			keepers.IBCKeeper.ChannelKeeper.SetChannel(ctx, contractInfo.IBCPortID, "channel-0", channeltypes.Channel{State: state})
+		}
+	}
+
+	const stateEntries = 500
+	mock.ExecuteFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		for i := 0; i < stateEntries; i++ {
+			store.Set(sdk.Uint64ToBigEndian(uint64(i)), []byte("value"))
+		}
+		return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 0, nil
+	}
+	_, err := keepers.ContractKeeper.Execute(parentCtx, example.Contract, example.CreatorAddr, []byte(`{}`), nil)
+	require.NoError(t, err)
+	keepers.Faucet.Fund(parentCtx, example.Contract, sdk.NewInt64Coin("denom", 100))
+
+	var sudoCalled bool
+	mock.SudoFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, sudoMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		sudoCalled = true
+		assert.JSONEq(t, `{"purge":{}}`, string(sudoMsg))
+		return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 0, nil
+	}
+
+	specs := map[string]struct {
+		caller       sdk.AccAddress
+		frozen       bool
+		setup        func(ctx sdk.Context)
+		skipHook     bool
+		gasLimit     storetypes.Gas
+		expErr       error
+		expCompleted bool
+	}{
+		"admin with enough gas": {
+			caller:       example.CreatorAddr,
+			gasLimit:     10_000_000,
+			expCompleted: true,
+		},
+		"admin with hook skipped": {
+			caller:       example.CreatorAddr,
+			skipHook:     true,
+			gasLimit:     10_000_000,
+			expCompleted: true,
+		},
+		"admin with state left for end blocker": {
+			caller:   example.CreatorAddr,
+			gasLimit: 400_000,
+		},
+		"other address": {
+			caller:   otherAddr,
+			gasLimit: 10_000_000,
+			expErr:   sdkerrors.ErrUnauthorized,
+		},
+		"frozen contract": {
+			caller:   example.CreatorAddr,
+			frozen:   true,
+			gasLimit: 10_000_000,
+			expErr:   types.ErrContractFrozen,
+		},
+		"open ibc channel": {
+			caller:   example.CreatorAddr,
+			setup:    withIBCChannel(channeltypes.OPEN),
+			gasLimit: 10_000_000,
+			expErr:   sdkerrors.ErrInvalidRequest,
+		},
+		"closed ibc channel": {
+			caller:       example.CreatorAddr,
+			setup:        withIBCChannel(channeltypes.CLOSED),
+			gasLimit:     10_000_000,
+			expCompleted: true,
+		},
+		"frozen contract with hook skipped": {
+			caller:       example.CreatorAddr,
+			frozen:       true,
+			skipHook:     true,
+			gasLimit:     10_000_000,
+			expCompleted: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			sudoCalled = false
+			if spec.frozen {
+				require.NoError(t, k.setContractFrozen(ctx, example.Contract, example.CreatorAddr, true, false, DefaultAuthorizationPolicy{}))
+			}
+			if spec.setup != nil {
+				spec.setup(ctx)
+			}
+			// when
+			completed, gotErr := k.purgeContract(ctx.WithGasMeter(storetypes.NewGasMeter(spec.gasLimit)), example.Contract, spec.caller, beneficiary, spec.skipHook, DefaultAuthorizationPolicy{})
+			// then
+			if spec.expErr != nil {
+				require.ErrorIs(t, gotErr, spec.expErr)
+				assert.True(t, k.HasContractInfo(ctx, example.Contract))
+				assert.False(t, k.IsContractPurged(ctx, example.Contract))
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expCompleted, completed)
+			assert.Equal(t, !spec.skipHook, sudoCalled)
+			assert.False(t, k.HasContractInfo(ctx, example.Contract))
+			assert.True(t, k.IsContractPurged(ctx, example.Contract))
+			assert.Equal(t, !spec.expCompleted, k.HasPendingPurge(ctx, example.Contract))
+			assert.Equal(t, "100denom", keepers.BankKeeper.GetAllBalances(ctx, beneficiary).String())
+			assert.True(t, keepers.BankKeeper.GetAllBalances(ctx, example.Contract).IsZero())
+			var creatorContracts []sdk.AccAddress
+			k.IterateContractsByCreator(ctx, example.CreatorAddr, func(addr sdk.AccAddress) bool {
+				creatorContracts = append(creatorContracts, addr)
+				return false
+			})
+			assert.Empty(t, creatorContracts)
+
+			// and when the end blocker runs
+			require.NoError(t, EndBlocker(ctx, k))
+			// then
+			var stateEntries int
+			k.IterateContractState(ctx, example.Contract, func(key, value []byte) bool {
+				stateEntries++
+				return false
+			})
+			assert.Zero(t, stateEntries)
+			assert.Empty(t, k.GetContractHistory(ctx, example.Contract))
+			assert.Nil(t, k.GetContractDependency(ctx, example.Contract, otherContract))
+			assert.Nil(t, k.GetContractDependency(ctx, otherContract, example.Contract))
+			var dependents int
+			k.IterateContractDependents(ctx, otherContract, func(types.ContractDependency) bool {
+				dependents++
+				return false
+			})
+			assert.Zero(t, dependents)
+			assert.False(t, k.HasPendingPurge(ctx, example.Contract))
+
+			// and the address can not be reused
+			_, _, err := k.instantiate(ctx, example.CodeID, example.CreatorAddr, nil, []byte(`{}`), "test", nil, func(context.Context, uint64, []byte) sdk.AccAddress {
+				return example.Contract
+			}, DefaultAuthorizationPolicy{})
+			require.ErrorIs(t, err, types.ErrDuplicate)
+		})
+	}
+}
+
+func TestGasCostsFromParams(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example := InstantiateHackatomExampleContract(t, ctx, keepers)
+	q := Querier(k)
+
+	querySmartGas := func() storetypes.Gas {
+		ctx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		_, err := k.QuerySmart(ctx, example.Contract, []byte(`{"verifier":{}}`))
+		require.NoError(t, err)
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	// when no gas costs are set in the params
+	assert.Equal(t, types.NewDefaultWasmGasRegister(), k.GetActiveGasRegister(ctx))
+	gotRsp, err := q.GasCosts(ctx, &types.QueryGasCostsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, types.NewGasCosts(types.DefaultGasRegisterConfig()), gotRsp.GasCosts)
+	assert.False(t, gotRsp.FromParams)
+	defaultGas := querySmartGas()
+
+	// when gas costs are set in the params
+	gasCosts := types.NewGasCosts(types.DefaultGasRegisterConfig())
+	gasCosts.InstanceCost += 1000
+	params := k.GetParams(ctx)
+	params.GasCosts = &gasCosts
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// then the register is built from the params
+	assert.Equal(t, types.NewWasmGasRegister(gasCosts.GasRegisterConfig()), k.GetActiveGasRegister(ctx))
+	gotRsp, err = q.GasCosts(ctx, &types.QueryGasCostsRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, gasCosts, gotRsp.GasCosts)
+	assert.True(t, gotRsp.FromParams)
+	// and charged for contract calls
+	assert.Equal(t, defaultGas+1000, querySmartGas())
+	// and the params read is charged when the register is resolved for a tx
+	gasCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	k.GetActiveGasRegister(gasCtx)
+	assert.NotZero(t, gasCtx.GasMeter().GasConsumed())
+}
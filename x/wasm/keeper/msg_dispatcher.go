@@ -41,6 +41,8 @@ type replyer interface {
 type MessageDispatcher struct {
 	messenger Messenger
 	keeper    replyer
+	// dependencies records the calls to other contracts when set
+	dependencies dependencyRecorder
 }
 
 // NewMessageDispatcher constructor
@@ -50,11 +52,14 @@ func NewMessageDispatcher(messenger Messenger, keeper replyer) *MessageDispatche
 
 // DispatchMessages sends all messages.
 func (d MessageDispatcher) DispatchMessages(ctx sdk.Context, contractAddr sdk.AccAddress, ibcPort string, msgs []wasmvmtypes.CosmosMsg) error {
+	recorder, _ := types.DryRunRecorderFromContext(ctx)
 	for _, msg := range msgs {
+		recorder.RecordMessage(contractAddr, msg)
 		events, _, _, err := d.messenger.DispatchMsg(ctx, contractAddr, ibcPort, msg)
 		if err != nil {
 			return err
 		}
+		recordMsgDependency(ctx, d.dependencies, contractAddr, msg)
 		// redispatch all events, (type sdk.EventTypeMessage will be filtered out in the handler)
 		ctx.EventManager().EmitEvents(events)
 	}
@@ -92,12 +97,15 @@ func (d MessageDispatcher) dispatchMsgWithGasLimit(ctx sdk.Context, contractAddr
 // that dispatched them, both on success as well as failure
 func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk.AccAddress, ibcPort string, msgs []wasmvmtypes.SubMsg) ([]byte, error) {
 	var rsp []byte
+	recorder, _ := types.DryRunRecorderFromContext(ctx)
 	for _, msg := range msgs {
 		switch msg.ReplyOn {
 		case wasmvmtypes.ReplySuccess, wasmvmtypes.ReplyError, wasmvmtypes.ReplyAlways, wasmvmtypes.ReplyNever:
 		default:
 			return nil, errorsmod.Wrap(types.ErrInvalid, "replyOn value")
 		}
+		recorder.RecordSubMessage(contractAddr, msg)
+		snapshot := recorder.Snapshot()
 		// first, we build a sub-context which we can use inside the submessages
 		subCtx, commit := ctx.CacheContext()
 		em := sdk.NewEventManager()
@@ -121,6 +129,7 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 		var filteredEvents []sdk.Event
 		if err == nil {
 			commit()
+			recordMsgDependency(ctx, d.dependencies, contractAddr, msg.Msg)
 			filteredEvents = filterEvents(append(em.Events(), events...))
 			ctx.EventManager().EmitEvents(filteredEvents)
 			if msg.Msg.Wasm == nil {
@@ -133,7 +142,10 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 					})
 				}
 			}
-		} // on failure, revert state from sandbox, and ignore events (just skip doing the above)
+		} else {
+			// on failure, revert state from sandbox, and ignore events (just skip doing the above)
+			recorder.Revert(snapshot)
+		}
 
 		// we only callback if requested. Short-circuit here the cases we don't want to
 		if (msg.ReplyOn == wasmvmtypes.ReplySuccess || msg.ReplyOn == wasmvmtypes.ReplyNever) && err != nil {
@@ -191,6 +203,7 @@ func (d MessageDispatcher) DispatchSubmessages(ctx sdk.Context, contractAddr sdk
 
 		// we can ignore any result returned as there is nothing to do with the data
 		// and the events are already in the ctx.EventManager()
+		recorder.RecordReply(contractAddr, reply)
 		rspData, err := d.keeper.reply(ctx, contractAddr, reply)
 		switch {
 		case err != nil:
@@ -121,7 +121,7 @@ func (m msgServer) ExecuteContract(ctx context.Context, msg *types.MsgExecuteCon
 		return nil, errorsmod.Wrap(err, "contract")
 	}
 
-	data, err := m.keeper.execute(ctx, contractAddr, senderAddr, msg.Msg, msg.Funds)
+	data, err := m.keeper.execute(ctx, contractAddr, senderAddr, msg.Msg, msg.Funds, m.selectAuthorizationPolicy(ctx, msg.Sender))
 	if err != nil {
 		return nil, err
 	}
@@ -485,3 +485,310 @@ func (m msgServer) UpdateContractLabel(ctx context.Context, msg *types.MsgUpdate
 
 	return &types.MsgUpdateContractLabelResponse{}, nil
 }
+
+func (m msgServer) FreezeContract(ctx context.Context, msg *types.MsgFreezeContract) (*types.MsgFreezeContractResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+	byGov := msg.Sender == m.keeper.GetAuthority()
+
+	if err := m.keeper.setContractFrozen(ctx, contractAddr, senderAddr, true, byGov, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFreezeContractResponse{}, nil
+}
+
+func (m msgServer) UnfreezeContract(ctx context.Context, msg *types.MsgUnfreezeContract) (*types.MsgUnfreezeContractResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+	byGov := msg.Sender == m.keeper.GetAuthority()
+
+	if err := m.keeper.setContractFrozen(ctx, contractAddr, senderAddr, false, byGov, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnfreezeContractResponse{}, nil
+}
+
+func (m msgServer) SetCodeStatus(ctx context.Context, req *types.MsgSetCodeStatus) (*types.MsgSetCodeStatusResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	authority := m.keeper.GetAuthority()
+	if authority != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalid, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	codeIDs := req.CodeIDs
+	if len(req.Checksum) != 0 {
+		var found bool
+		m.keeper.IterateCodeIDsByChecksum(ctx, req.Checksum, func(codeID uint64) bool {
+			found = true
+			if !contains(codeIDs, codeID) {
+				codeIDs = append(codeIDs, codeID)
+			}
+			return false
+		})
+		if !found {
+			return nil, types.ErrNotFound.Wrapf("code with checksum %X", req.Checksum)
+		}
+	}
+
+	for _, codeID := range codeIDs {
+		if err := m.keeper.setCodeStatus(ctx, codeID, req.Status); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgSetCodeStatusResponse{}, nil
+}
+
+func (m msgServer) PurgeContract(ctx context.Context, msg *types.MsgPurgeContract) (*types.MsgPurgeContractResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+	beneficiaryAddr, err := sdk.AccAddressFromBech32(msg.Beneficiary)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "beneficiary")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	completed, err := m.keeper.purgeContract(ctx, contractAddr, senderAddr, beneficiaryAddr, msg.SkipPurgeHook, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPurgeContractResponse{Completed: completed}, nil
+}
+
+func (m msgServer) AddCronSchedule(ctx context.Context, req *types.MsgAddCronSchedule) (*types.MsgAddCronScheduleResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	authority := m.keeper.GetAuthority()
+	if authority != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalid, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	if err := m.keeper.addCronSchedule(ctx, req.Schedule()); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddCronScheduleResponse{}, nil
+}
+
+func (m msgServer) RemoveCronSchedule(ctx context.Context, req *types.MsgRemoveCronSchedule) (*types.MsgRemoveCronScheduleResponse, error) {
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	authority := m.keeper.GetAuthority()
+	if authority != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalid, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	if err := m.keeper.removeCronSchedule(ctx, req.Name); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveCronScheduleResponse{}, nil
+}
+
+func (m msgServer) SetFeeSponsorship(ctx context.Context, msg *types.MsgSetFeeSponsorship) (*types.MsgSetFeeSponsorshipResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	if err := m.keeper.setFeeSponsorship(ctx, contractAddr, senderAddr, msg.MaxFeePerSender, msg.MaxFeePerBlock, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetFeeSponsorshipResponse{}, nil
+}
+
+func (m msgServer) FundFeeSponsorship(ctx context.Context, msg *types.MsgFundFeeSponsorship) (*types.MsgFundFeeSponsorshipResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	if err := m.keeper.fundFeeSponsorship(ctx, contractAddr, senderAddr, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundFeeSponsorshipResponse{}, nil
+}
+
+func (m msgServer) RemoveFeeSponsorship(ctx context.Context, msg *types.MsgRemoveFeeSponsorship) (*types.MsgRemoveFeeSponsorshipResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	if err := m.keeper.removeFeeSponsorship(ctx, contractAddr, senderAddr, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveFeeSponsorshipResponse{}, nil
+}
+
+func (m msgServer) UpdateExecuteConfig(ctx context.Context, msg *types.MsgUpdateExecuteConfig) (*types.MsgUpdateExecuteConfigResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	if err := m.keeper.setContractExecuteConfig(ctx, contractAddr, senderAddr, msg.NewExecutePermission, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateExecuteConfigResponse{}, nil
+}
+
+func (m msgServer) UpdateAdminSet(ctx context.Context, msg *types.MsgUpdateAdminSet) (*types.MsgUpdateAdminSetResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	if err := m.keeper.setContractAdminSet(ctx, contractAddr, senderAddr, msg.AdminSet, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateAdminSetResponse{}, nil
+}
+
+func (m msgServer) SetContractMetadata(ctx context.Context, msg *types.MsgSetContractMetadata) (*types.MsgSetContractMetadataResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	if err := m.keeper.setContractMetadata(ctx, contractAddr, senderAddr, msg.Metadata, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetContractMetadataResponse{}, nil
+}
+
+func (m msgServer) ApproveMigration(ctx context.Context, msg *types.MsgApproveMigration) (*types.MsgApproveMigrationResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sender")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	policy := m.selectAuthorizationPolicy(ctx, msg.Sender)
+
+	migrated, data, err := m.keeper.approveMigration(ctx, contractAddr, senderAddr, msg.CodeID, msg.Msg, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveMigrationResponse{
+		Migrated: migrated,
+		Data:     data,
+	}, nil
+}
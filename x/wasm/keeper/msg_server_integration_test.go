@@ -1,8 +1,10 @@
 package keeper_test
 
 import (
+	"bytes"
 	_ "embed"
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -1227,3 +1229,187 @@ func TestUpdateContractLabel(t *testing.T) {
 		})
 	}
 }
+
+func TestFreezeContract(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContextLegacy(false, tmproto.Header{Time: time.Now()})
+
+	var (
+		myAddress       sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                      = wasmApp.WasmKeeper.GetAuthority()
+		_, _, otherAddr                = testdata.KeyTestPubAddr()
+	)
+
+	specs := map[string]struct {
+		addr           string
+		unfreezeAddr   string
+		expErr         bool
+		expUnfreezeErr bool
+	}{
+		"authority can freeze contract": {
+			addr: authority,
+		},
+		"admin can not lift freeze by authority": {
+			addr:           authority,
+			unfreezeAddr:   myAddress.String(),
+			expUnfreezeErr: true,
+		},
+		"authority can lift freeze by admin": {
+			addr:         myAddress.String(),
+			unfreezeAddr: authority,
+		},
+		"admin can freeze contract": {
+			addr: myAddress.String(),
+		},
+		"other address cannot freeze contract": {
+			addr:   otherAddr.String(),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// setup
+			msg := &types.MsgStoreAndInstantiateContract{
+				Authority:             authority,
+				WASMByteCode:          wasmContract,
+				InstantiatePermission: &types.AllowEverybody,
+				Admin:                 myAddress.String(),
+				Label:                 "test",
+				Msg:                   []byte(`{}`),
+				Funds:                 sdk.Coins{},
+			}
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+			require.NoError(t, err)
+			var storeAndInstantiateResponse types.MsgStoreAndInstantiateContractResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &storeAndInstantiateResponse))
+			contractAddr, err := sdk.AccAddressFromBech32(storeAndInstantiateResponse.Address)
+			require.NoError(t, err)
+
+			// when
+			msgFreeze := &types.MsgFreezeContract{
+				Sender:   spec.addr,
+				Contract: storeAndInstantiateResponse.Address,
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgFreeze)(ctx, msgFreeze)
+
+			// then
+			execMsg := &types.MsgExecuteContract{
+				Sender:   authority,
+				Contract: storeAndInstantiateResponse.Address,
+				Msg:      []byte(fmt.Sprintf(`{"change_owner":{"owner":%q}}`, authority)),
+			}
+			if spec.expErr {
+				require.Error(t, err)
+				assert.False(t, wasmApp.WasmKeeper.GetContractInfo(ctx, contractAddr).Frozen)
+				_, err = wasmApp.MsgServiceRouter().Handler(execMsg)(ctx, execMsg)
+				require.NoError(t, err)
+				return
+			}
+			require.NoError(t, err)
+			gotInfo := wasmApp.WasmKeeper.GetContractInfo(ctx, contractAddr)
+			assert.True(t, gotInfo.Frozen)
+			assert.Equal(t, spec.addr == authority, gotInfo.FrozenByGov)
+			_, err = wasmApp.MsgServiceRouter().Handler(execMsg)(ctx, execMsg)
+			require.ErrorIs(t, err, types.ErrContractFrozen)
+			// queries still work
+			_, err = wasmApp.WasmKeeper.QuerySmart(ctx, contractAddr, []byte(`{"owner":{}}`))
+			require.NoError(t, err)
+
+			// and when unfrozen
+			msgUnfreeze := &types.MsgUnfreezeContract{
+				Sender:   spec.addr,
+				Contract: storeAndInstantiateResponse.Address,
+			}
+			if spec.unfreezeAddr != "" {
+				msgUnfreeze.Sender = spec.unfreezeAddr
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgUnfreeze)(ctx, msgUnfreeze)
+			if spec.expUnfreezeErr {
+				require.Error(t, err)
+				assert.True(t, wasmApp.WasmKeeper.GetContractInfo(ctx, contractAddr).Frozen)
+				return
+			}
+			require.NoError(t, err)
+			assert.False(t, wasmApp.WasmKeeper.GetContractInfo(ctx, contractAddr).Frozen)
+			_, err = wasmApp.MsgServiceRouter().Handler(execMsg)(ctx, execMsg)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSetCodeStatus(t *testing.T) {
+	wasmApp := app.Setup(t)
+	ctx := wasmApp.BaseApp.NewContext(false)
+
+	var (
+		myAddress sdk.AccAddress = make([]byte, types.ContractAddrLen)
+		authority                = wasmApp.WasmKeeper.GetAuthority()
+	)
+
+	specs := map[string]struct {
+		addr        string
+		useChecksum bool
+		checksum    []byte
+		expErr      bool
+	}{
+		"authority can deprecate codes by id": {
+			addr: authority,
+		},
+		"authority can deprecate codes by checksum": {
+			addr:        authority,
+			useChecksum: true,
+		},
+		"unknown checksum": {
+			addr:        authority,
+			useChecksum: true,
+			checksum:    bytes.Repeat([]byte{1}, wasmvmtypes.ChecksumLen),
+			expErr:      true,
+		},
+		"other address cannot deprecate codes": {
+			addr:   myAddress.String(),
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// setup
+			_, _, sender := testdata.KeyTestPubAddr()
+			msg := types.MsgStoreCodeFixture(func(m *types.MsgStoreCode) {
+				m.WASMByteCode = hackatomContract
+				m.Sender = sender.String()
+			})
+			rsp, err := wasmApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+			require.NoError(t, err)
+			var result types.MsgStoreCodeResponse
+			require.NoError(t, wasmApp.AppCodec().Unmarshal(rsp.Data, &result))
+
+			// when
+			msgSetCodeStatus := &types.MsgSetCodeStatus{
+				Authority: spec.addr,
+				Status:    types.CodeStatusDeprecated,
+			}
+			if spec.useChecksum {
+				msgSetCodeStatus.Checksum = result.Checksum
+				if spec.checksum != nil {
+					msgSetCodeStatus.Checksum = spec.checksum
+				}
+			} else {
+				msgSetCodeStatus.CodeIDs = []uint64{result.CodeID}
+			}
+			_, err = wasmApp.MsgServiceRouter().Handler(msgSetCodeStatus)(ctx, msgSetCodeStatus)
+
+			// then
+			if spec.expErr {
+				require.Error(t, err)
+				assert.Equal(t, types.CodeStatusActive, wasmApp.WasmKeeper.GetCodeInfo(ctx, result.CodeID).Status)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, types.CodeStatusDeprecated, wasmApp.WasmKeeper.GetCodeInfo(ctx, result.CodeID).Status)
+			// and new instances are rejected
+			_, _, err = keeper.NewDefaultPermissionKeeper(wasmApp.WasmKeeper).
+				Instantiate(ctx, result.CodeID, sender, nil, []byte(`{}`), "test", nil)
+			require.ErrorIs(t, err, types.ErrCodeDeprecated)
+		})
+	}
+}
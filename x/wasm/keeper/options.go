@@ -125,6 +125,25 @@ func WithAccountPruner(x AccountPruner) Option {
 	})
 }
 
+// WithStorageDepositPolicy is an optional constructor parameter to set a custom policy that charges contracts
+// for the bytes they store. The default policy does not charge anything.
+func WithStorageDepositPolicy(x StorageDepositPolicy) Option {
+	if x == nil {
+		panic("must not be nil")
+	}
+	return optsFn(func(k *Keeper) {
+		k.storageDepositPolicy = x
+	})
+}
+
+// WithParamsStorageDepositPolicy is an optional constructor parameter to enable the ParamsStorageDepositPolicy
+// with the keeper's coin transferrer.
+func WithParamsStorageDepositPolicy() Option {
+	return postOptsFn(func(k *Keeper) {
+		k.storageDepositPolicy = NewParamsStorageDepositPolicy(k, k.bank)
+	})
+}
+
 func WithVMCacheMetrics(r prometheus.Registerer) Option {
 	return postOptsFn(func(k *Keeper) {
 		NewWasmVMMetricsCollector(k.wasmVM).Register(r)
@@ -132,6 +151,7 @@ func WithVMCacheMetrics(r prometheus.Registerer) Option {
 }
 
 // WithGasRegister set a new gas register to implement custom gas costs.
+// The register is only used when the module params do not set gas costs.
 // When the "gas multiplier" for wasmvm gas conversion is modified inside the new register,
 // make sure to also use `WithApiCosts` option for non default values
 func WithGasRegister(x types.GasRegister) Option {
@@ -164,6 +184,28 @@ func WithMaxCallDepth(m uint32) Option {
 	})
 }
 
+// WithContractDependencyTracking enables recording of the calls between contracts. Wasm execute and migrate messages
+// dispatched by a contract are counted per caller and callee. Queries are not recorded as they must not modify state.
+// Calls to addresses without a contract are not recorded. The records are written to the consensus state and their
+// storage gas is charged to the calling contract, so all nodes of a network must use the same setting.
+func WithContractDependencyTracking() Option {
+	return optsFn(func(k *Keeper) {
+		k.trackContractDependencies = true
+	})
+}
+
+// WithCallTracer sets a tracer that is notified on entry and exit of every contract entrypoint call.
+// A call trace exporter that writes JSON lines to stdout is set by default when the contract debug mode is enabled in
+// the wasm config.
+func WithCallTracer(t types.CallTracer) Option {
+	if t == nil {
+		panic("must not be nil")
+	}
+	return optsFn(func(k *Keeper) {
+		k.callTracer = t
+	})
+}
+
 // WithAcceptedAccountTypesOnContractInstantiation sets the accepted account types. Account types of this list won't be overwritten or cause a failure
 // when they exist for an address on contract instantiation.
 //
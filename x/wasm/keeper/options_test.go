@@ -138,6 +138,19 @@ func TestConstructorOptions(t *testing.T) {
 				assert.Equal(t, VestingCoinBurner{}, k.accountPruner)
 			},
 		},
+		"storage deposit policy": {
+			srcOpt: WithStorageDepositPolicy(ParamsStorageDepositPolicy{}),
+			verify: func(t *testing.T, k Keeper) {
+				assert.Equal(t, ParamsStorageDepositPolicy{}, k.storageDepositPolicy)
+			},
+		},
+		"params storage deposit policy": {
+			srcOpt: WithParamsStorageDepositPolicy(),
+			verify: func(t *testing.T, k Keeper) {
+				assert.IsType(t, ParamsStorageDepositPolicy{}, k.storageDepositPolicy)
+			},
+			isPostOpt: true,
+		},
 		"gov propagation": {
 			srcOpt: WitGovSubMsgAuthZPropagated(types.AuthZActionInstantiate, types.AuthZActionMigrateContract),
 			verify: func(t *testing.T, k Keeper) {
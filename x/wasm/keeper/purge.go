@@ -0,0 +1,264 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+const (
+	// PurgeStateGasLimitPerTx is the max gas spent on deleting contract state within the purge message.
+	// Any state left is deleted in the following blocks.
+	PurgeStateGasLimitPerTx storetypes.Gas = 2_000_000
+	// PurgeStateGasLimitPerBlock is the max gas spent on deleting the state of purged contracts at the end of a block
+	PurgeStateGasLimitPerBlock storetypes.Gas = 10_000_000
+
+	// purgeStateBatchSize is the number of keys that are collected before they are deleted
+	purgeStateBatchSize = 100
+)
+
+// purgeHookMsg is sent as sudo message to the contract before it is purged
+var purgeHookMsg = []byte(`{"purge":{}}`)
+
+// purgeContract removes the contract with all its data. The `purge` sudo entrypoint is called unless skipped, the
+// remaining balance is moved to the beneficiary and a tombstone is left so that the address is never reused.
+// The hook is a regular sudo call, so it fails for frozen contracts and contracts without a `sudo` entrypoint. They
+// can only be purged with the hook skipped.
+// Contracts with an IBC channel that is not closed can not be purged, so that no channel is left bound to the port.
+// The contract state, history and recorded dependencies are deleted within the gas limits of the current tx. When
+// anything is left, it is deleted at the end of the following blocks and false is returned.
+func (k Keeper) purgeContract(ctx context.Context, contractAddress, caller, beneficiary sdk.AccAddress, skipHook bool, authZ types.AuthorizationPolicy) (bool, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	contractInfo := k.GetContractInfo(sdkCtx, contractAddress)
+	if contractInfo == nil {
+		return false, types.ErrNoSuchContractFn(contractAddress.String()).Wrapf("address %s", contractAddress.String())
+	}
+	if !canModifyContract(authZ, contractInfo, caller) {
+		return false, unauthorizedModification(contractInfo, "can not purge contract")
+	}
+	if channelID, ok := k.unclosedChannel(sdkCtx, contractInfo.IBCPortID); ok {
+		return false, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "ibc channel %s not closed", channelID)
+	}
+	if !skipHook {
+		if _, err := k.Sudo(sdkCtx, contractAddress, purgeHookMsg); err != nil {
+			return false, errorsmod.Wrap(err, "purge hook")
+		}
+		// the hook may have modified the contract info
+		contractInfo = k.GetContractInfo(sdkCtx, contractAddress)
+		if contractInfo == nil {
+			return false, types.ErrNoSuchContractFn(contractAddress.String()).Wrapf("address %s", contractAddress.String())
+		}
+	}
+
+	stats := k.storageStatsSnapshot(sdkCtx, contractAddress)
+	if err := k.storageDepositPolicy.OnStorageChange(sdkCtx, contractAddress, stats, types.ContractStorageStats{}); err != nil {
+		return false, err
+	}
+	// pending callbacks and the fee sponsorship are removed first so that their deposits are part of the balance
+	if err := k.removeContractCallbacks(sdkCtx, contractAddress); err != nil {
+		return false, err
+	}
+	if err := k.deleteFeeSponsorship(sdkCtx, contractAddress); err != nil {
+		return false, err
+	}
+	if err := k.deleteMigrationApprovals(sdkCtx, contractAddress); err != nil {
+		return false, err
+	}
+	if balance := k.bankKeeper.GetAllBalances(sdkCtx, contractAddress); !balance.IsZero() {
+		if err := k.bank.TransferCoins(sdkCtx, contractAddress, beneficiary, balance); err != nil {
+			return false, errorsmod.Wrap(err, "transfer balance")
+		}
+	}
+
+	if err := k.deleteContractMetadata(sdkCtx, contractAddress, contractInfo); err != nil {
+		return false, err
+	}
+	// bounded by MaxCronSchedules
+	if err := k.removeContractCronSchedules(sdkCtx, contractAddress); err != nil {
+		return false, err
+	}
+	store := k.storeService.OpenKVStore(sdkCtx)
+	// store 1 byte to not run into `nil` debugging issues
+	if err := store.Set(types.GetContractTombstoneKey(contractAddress), []byte{1}); err != nil {
+		return false, err
+	}
+
+	completed := k.purgeContractState(sdkCtx, contractAddress, min(PurgeStateGasLimitPerTx, sdkCtx.GasMeter().GasRemaining()/2))
+	if !completed {
+		if err := store.Set(types.GetPendingContractPurgeKey(contractAddress), []byte{1}); err != nil {
+			return false, err
+		}
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePurgeContract,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyBeneficiary, beneficiary.String()),
+		sdk.NewAttribute(types.AttributeKeyPurgeCompleted, strconv.FormatBool(completed)),
+	))
+	return completed, nil
+}
+
+// unclosedChannel returns the id of a channel of the port that is not closed
+func (k Keeper) unclosedChannel(ctx sdk.Context, portID string) (string, bool) {
+	if portID == "" {
+		return "", false
+	}
+	for _, channel := range k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, portID) {
+		if channel.PortId == portID && channel.State != channeltypes.CLOSED {
+			return channel.ChannelId, true
+		}
+	}
+	return "", false
+}
+
+// deleteContractMetadata removes the contract info with secondary indexes and storage stats. The history is deleted
+// with the contract state.
+func (k Keeper) deleteContractMetadata(ctx sdk.Context, contractAddress sdk.AccAddress, contractInfo *types.ContractInfo) error {
+	if err := k.removeFromContractCodeSecondaryIndex(ctx, contractAddress, k.mustGetLastContractHistoryEntry(ctx, contractAddress)); err != nil {
+		return err
+	}
+	creator, err := sdk.AccAddressFromBech32(contractInfo.Creator)
+	if err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetContractByCreatorSecondaryIndexKey(creator, contractInfo.Created.Bytes(), contractAddress)); err != nil {
+		return err
+	}
+	if err := k.removeFromContractAdminSecondaryIndex(ctx, contractAddress, contractInfo.Admins()); err != nil {
+		return err
+	}
+	if err := k.removeFromContractLabelSecondaryIndex(ctx, contractAddress, contractInfo); err != nil {
+		return err
+	}
+	if err := k.setFrozenContractIndex(ctx, contractAddress, false); err != nil {
+		return err
+	}
+	if err := store.Delete(types.GetContractStorageStatsKey(contractAddress)); err != nil {
+		return err
+	}
+	if err := store.Delete(types.GetContractMetadataKey(contractAddress)); err != nil {
+		return err
+	}
+
+	return store.Delete(types.GetContractAddressKey(contractAddress))
+}
+
+// purgeContractState deletes the contract state, history and recorded dependencies until the gas limit is reached.
+// Returns true when nothing is left.
+func (k Keeper) purgeContractState(ctx sdk.Context, contractAddress sdk.AccAddress, gasLimit storetypes.Gas) bool {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	start := ctx.GasMeter().GasConsumed()
+	for _, step := range []struct {
+		prefix []byte
+		// onDelete removes the entries that belong to the deleted key
+		onDelete func(key []byte)
+	}{
+		{prefix: types.GetContractStorePrefix(contractAddress)},
+		{prefix: types.GetContractCodeHistoryElementPrefix(contractAddress)},
+		{
+			prefix:   types.GetContractDependenciesPrefix(contractAddress),
+			onDelete: func(callee []byte) { store.Delete(types.GetContractDependentKey(callee, contractAddress)) },
+		},
+		{
+			prefix:   types.GetContractDependentsPrefix(contractAddress),
+			onDelete: func(caller []byte) { store.Delete(types.GetContractDependencyKey(caller, contractAddress)) },
+		},
+	} {
+		prefixStore := prefix.NewStore(store, step.prefix)
+		for {
+			if ctx.GasMeter().GasConsumed()-start >= gasLimit {
+				return false
+			}
+			keys := collectKeys(prefixStore, purgeStateBatchSize)
+			if len(keys) == 0 {
+				break
+			}
+			for _, key := range keys {
+				if ctx.GasMeter().GasConsumed()-start >= gasLimit {
+					return false
+				}
+				prefixStore.Delete(key)
+				if step.onDelete != nil {
+					step.onDelete(key)
+				}
+			}
+		}
+	}
+	return true
+}
+
+// collectKeys returns up to limit keys from the store. A negative limit returns all keys.
+func collectKeys(store storetypes.KVStore, limit int) [][]byte {
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	var keys [][]byte
+	for ; iter.Valid() && len(keys) != limit; iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	return keys
+}
+
+// processPendingPurges deletes the remaining state of purged contracts within the gas limit
+func (k Keeper) processPendingPurges(ctx sdk.Context, gasLimit storetypes.Gas) {
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.PendingContractPurgePrefix)
+	for ctx.GasMeter().GasConsumed() < gasLimit {
+		// the key is deleted when the state is gone, so the next pending purge is always the first one
+		keys := collectKeys(store, 1)
+		if len(keys) == 0 || !k.purgeContractState(ctx, keys[0], gasLimit-ctx.GasMeter().GasConsumed()) {
+			return
+		}
+		store.Delete(keys[0])
+	}
+}
+
+// IsContractPurged returns true when the address belongs to a purged contract
+func (k Keeper) IsContractPurged(ctx context.Context, contractAddress sdk.AccAddress) bool {
+	ok, err := k.storeService.OpenKVStore(ctx).Has(types.GetContractTombstoneKey(contractAddress))
+	if err != nil {
+		panic(err)
+	}
+	return ok
+}
+
+// HasPendingPurge returns true when the purged contract still has state that is deleted in the following blocks
+func (k Keeper) HasPendingPurge(ctx context.Context, contractAddress sdk.AccAddress) bool {
+	ok, err := k.storeService.OpenKVStore(ctx).Has(types.GetPendingContractPurgeKey(contractAddress))
+	if err != nil {
+		panic(err)
+	}
+	return ok
+}
+
+// IteratePurgedContracts iterates over the addresses of all purged contracts
+func (k Keeper) IteratePurgedContracts(ctx context.Context, cb func(sdk.AccAddress) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ContractTombstonePrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(iter.Key()) {
+			return
+		}
+	}
+}
+
+// importPurgedContract stores the tombstone of a purged contract
+func (k Keeper) importPurgedContract(ctx context.Context, contractAddress sdk.AccAddress) error {
+	if k.HasContractInfo(ctx, contractAddress) {
+		return errorsmod.Wrapf(types.ErrDuplicate, "purged contract: %s", contractAddress)
+	}
+	return k.storeService.OpenKVStore(ctx).Set(types.GetContractTombstoneKey(contractAddress), []byte{1})
+}
@@ -1,12 +1,14 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"runtime/debug"
 
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -149,26 +151,69 @@ func (q GrpcQuerier) AllContractState(c context.Context, req *types.QueryAllCont
 			Wrapf("address %s", contractAddr.String())
 	}
 
+	start, end := contractStateRange(req.Prefix, req.Start, req.End)
+	if paginationParams.Key != nil {
+		// the next key is the first entry of the page
+		if paginationParams.Reverse {
+			end = minKey(end, append(bytes.Clone(paginationParams.Key), 0))
+		} else {
+			start = maxKey(start, paginationParams.Key)
+		}
+	}
+
 	r := make([]types.Model, 0)
-	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.GetContractStorePrefix(contractAddr))
-	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(key, value []byte, accumulate bool) (bool, error) {
-		if accumulate {
-			r = append(r, types.Model{
-				Key:   key,
-				Value: value,
-			})
+	pageRes := &query.PageResponse{}
+	if start != nil && end != nil && bytes.Compare(start, end) >= 0 {
+		return &types.QueryAllContractStateResponse{Models: r, Pagination: pageRes}, nil
+	}
+	q.keeper.IterateContractStateRange(ctx, contractAddr, start, end, paginationParams.Reverse, func(key, value []byte) bool {
+		if uint64(len(r)) == paginationParams.Limit {
+			pageRes.NextKey = bytes.Clone(key)
+			return true
 		}
-		return true, nil
+		r = append(r, types.Model{
+			Key:   bytes.Clone(key),
+			Value: bytes.Clone(value),
+		})
+		return false
 	})
-	if err != nil {
-		return nil, err
-	}
 	return &types.QueryAllContractStateResponse{
 		Models:     r,
 		Pagination: pageRes,
 	}, nil
 }
 
+// contractStateRange returns the key range [start, end) that is the intersection of the key prefix and the bounds.
+// A nil value is an open bound.
+func contractStateRange(keyPrefix, start, end []byte) ([]byte, []byte) {
+	if len(keyPrefix) == 0 {
+		return start, end
+	}
+	return maxKey(keyPrefix, start), minKey(storetypes.PrefixEndBytes(keyPrefix), end)
+}
+
+// maxKey returns the greater of two lower bounds. Nil is the lowest key.
+func maxKey(a, b []byte) []byte {
+	if bytes.Compare(a, b) >= 0 {
+		return a
+	}
+	return b
+}
+
+// minKey returns the lesser of two upper bounds. Nil is an open bound.
+func minKey(a, b []byte) []byte {
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	case bytes.Compare(a, b) <= 0:
+		return a
+	default:
+		return b
+	}
+}
+
 func (q GrpcQuerier) RawContractState(c context.Context, req *types.QueryRawContractStateRequest) (*types.QueryRawContractStateResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -188,10 +233,42 @@ func (q GrpcQuerier) RawContractState(c context.Context, req *types.QueryRawCont
 	return &types.QueryRawContractStateResponse{Data: rsp}, nil
 }
 
-func (q GrpcQuerier) SmartContractState(c context.Context, req *types.QuerySmartContractStateRequest) (rsp *types.QuerySmartContractStateResponse, err error) {
+func (q GrpcQuerier) SmartContractState(c context.Context, req *types.QuerySmartContractStateRequest) (*types.QuerySmartContractStateResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
+	ctx := sdk.UnwrapSDKContext(c).WithGasMeter(storetypes.NewGasMeter(q.queryGasLimit))
+	bz, err := q.smartContractState(ctx, *req)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySmartContractStateResponse{Data: bz}, nil
+}
+
+// BatchSmartContractState runs the queries in order with a shared context and gas limit. Failures are returned
+// per query. Once the gas limit is exceeded, all remaining queries fail.
+func (q GrpcQuerier) BatchSmartContractState(c context.Context, req *types.QueryBatchSmartContractStateRequest) (*types.QueryBatchSmartContractStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c).WithGasMeter(storetypes.NewGasMeter(q.queryGasLimit))
+	results := make([]types.SmartQueryResult, len(req.Queries))
+	for i, query := range req.Queries {
+		bz, err := q.smartContractState(ctx, query)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		results[i].Data = bz
+	}
+	return &types.QueryBatchSmartContractStateResponse{
+		Results: results,
+		GasUsed: ctx.GasMeter().GasConsumedToLimit(),
+	}, nil
+}
+
+// smartContractState runs a smart query with the gas meter of the context and recovers from an out-of-gas panic
+func (q GrpcQuerier) smartContractState(ctx sdk.Context, req types.QuerySmartContractStateRequest) (rsp []byte, err error) {
 	if err := req.QueryData.ValidateBasic(); err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid query data")
 	}
@@ -199,7 +276,6 @@ func (q GrpcQuerier) SmartContractState(c context.Context, req *types.QuerySmart
 	if err != nil {
 		return nil, err
 	}
-	ctx := sdk.UnwrapSDKContext(c).WithGasMeter(storetypes.NewGasMeter(q.queryGasLimit))
 	// recover from out-of-gas panic
 	defer func() {
 		if r := recover(); r != nil {
@@ -229,7 +305,7 @@ func (q GrpcQuerier) SmartContractState(c context.Context, req *types.QuerySmart
 		return nil, types.ErrNoSuchContractFn(contractAddr.String()).
 			Wrapf("address %s", contractAddr.String())
 	}
-	return &types.QuerySmartContractStateResponse{Data: bz}, nil
+	return bz, nil
 }
 
 func (q GrpcQuerier) Code(c context.Context, req *types.QueryCodeRequest) (*types.QueryCodeResponse, error) {
@@ -275,6 +351,7 @@ func (q GrpcQuerier) Codes(c context.Context, req *types.QueryCodesRequest) (*ty
 				Creator:               c.Creator,
 				DataHash:              c.CodeHash,
 				InstantiatePermission: c.InstantiateConfig,
+				Status:                c.Status,
 			})
 		}
 		return true, nil
@@ -311,6 +388,7 @@ func queryCode(ctx sdk.Context, codeID uint64, keeper types.ViewKeeper) (*types.
 		Creator:               res.Creator,
 		DataHash:              res.CodeHash,
 		InstantiatePermission: res.InstantiateConfig,
+		Status:                res.Status,
 	}
 
 	code, err := keeper.GetByteCode(ctx, codeID)
@@ -390,6 +468,134 @@ func (q GrpcQuerier) ContractsByCreator(c context.Context, req *types.QueryContr
 	}, nil
 }
 
+func (q GrpcQuerier) ContractsByAdmin(c context.Context, req *types.QueryContractsByAdminRequest) (*types.QueryContractsByAdminResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	contracts := make([]string, 0)
+
+	adminAddress, err := sdk.AccAddressFromBech32(req.AdminAddress)
+	if err != nil {
+		return nil, err
+	}
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.GetContractsByAdminPrefix(adminAddress))
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(key, _ []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			contracts = append(contracts, sdk.AccAddress(key).String())
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractsByAdminResponse{
+		ContractAddresses: contracts,
+		Pagination:        pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) ContractsByLabel(c context.Context, req *types.QueryContractsByLabelRequest) (*types.QueryContractsByLabelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Label == "" && !req.PrefixMatch {
+		return nil, status.Error(codes.InvalidArgument, "empty label")
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	contracts := make([]types.LabeledContract, 0)
+
+	// keys are relative to the search prefix, the label index key starts after the store prefix
+	searchPrefix := types.GetContractsByLabelPrefix(req.Label)[len(types.ContractsByLabelPrefix):]
+	if req.PrefixMatch {
+		searchPrefix = []byte(req.Label)
+	}
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), append(bytes.Clone(types.ContractsByLabelPrefix), searchPrefix...))
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(key, _ []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			label, contractAddr, err := types.ParseContractByLabelSecondaryIndexKey(append(bytes.Clone(searchPrefix), key...))
+			if err != nil {
+				return false, err
+			}
+			contracts = append(contracts, types.LabeledContract{Address: contractAddr.String(), Label: label})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryContractsByLabelResponse{
+		Contracts:  contracts,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) CodesByChecksum(c context.Context, req *types.QueryCodesByChecksumRequest) (*types.QueryCodesByChecksumResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	checksum, err := hex.DecodeString(req.Checksum)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid checksum: %s", err)
+	}
+	if len(checksum) != wasmvmtypes.ChecksumLen {
+		return nil, status.Errorf(codes.InvalidArgument, "checksum must be %d bytes", wasmvmtypes.ChecksumLen)
+	}
+	codeIDs, pageRes, err := q.paginateCodeIDs(c, types.GetCodesByChecksumPrefix(checksum), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryCodesByChecksumResponse{CodeIDs: codeIDs, Pagination: pageRes}, nil
+}
+
+func (q GrpcQuerier) CodesByCreator(c context.Context, req *types.QueryCodesByCreatorRequest) (*types.QueryCodesByCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	creatorAddress, err := sdk.AccAddressFromBech32(req.CreatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	codeIDs, pageRes, err := q.paginateCodeIDs(c, types.GetCodesByCreatorPrefix(creatorAddress), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryCodesByCreatorResponse{CodeIDs: codeIDs, Pagination: pageRes}, nil
+}
+
+// paginateCodeIDs returns the code ids of a code index that ends with the code id in the key
+func (q GrpcQuerier) paginateCodeIDs(c context.Context, indexPrefix []byte, pageReq *query.PageRequest) ([]uint64, *query.PageResponse, error) {
+	paginationParams, err := ensurePaginationParams(pageReq)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	codeIDs := make([]uint64, 0)
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), indexPrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(key, _ []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			codeIDs = append(codeIDs, sdk.BigEndianToUint64(key))
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return codeIDs, pageRes, nil
+}
+
 // max limit to pagination queries
 const maxResultEntries = 100
 
@@ -448,3 +654,367 @@ func (q GrpcQuerier) BuildAddress(c context.Context, req *types.QueryBuildAddres
 		Address: BuildContractAddressPredictable(codeHash, creator, salt, initMsg).String(),
 	}, nil
 }
+
+func (q GrpcQuerier) FrozenContracts(c context.Context, req *types.QueryFrozenContractsRequest) (*types.QueryFrozenContractsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	contracts := make([]string, 0)
+
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.FrozenContractIndexPrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(key, _ []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			contracts = append(contracts, sdk.AccAddress(key).String())
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryFrozenContractsResponse{
+		Contracts:  contracts,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) ContractStorageStats(c context.Context, req *types.QueryContractStorageStatsRequest) (*types.QueryContractStorageStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if !q.keeper.HasContractInfo(ctx, contractAddr) {
+		return nil, types.ErrNoSuchContractFn(contractAddr.String()).
+			Wrapf("address %s", contractAddr.String())
+	}
+	return &types.QueryContractStorageStatsResponse{
+		Stats:   q.keeper.GetContractStorageStats(ctx, contractAddr),
+		Deposit: q.keeper.GetContractStorageDeposit(ctx, contractAddr),
+	}, nil
+}
+
+func (q GrpcQuerier) CronSchedules(c context.Context, req *types.QueryCronSchedulesRequest) (*types.QueryCronSchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	schedules := make([]types.CronSchedule, 0)
+
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.CronSchedulePrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(_, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var schedule types.CronSchedule
+			if err := q.cdc.Unmarshal(value, &schedule); err != nil {
+				return false, err
+			}
+			schedules = append(schedules, schedule)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryCronSchedulesResponse{
+		Schedules:  schedules,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) CronSchedule(c context.Context, req *types.QueryCronScheduleRequest) (*types.QueryCronScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	schedule := q.keeper.GetCronSchedule(sdk.UnwrapSDKContext(c), req.Name)
+	if schedule == nil {
+		return nil, types.ErrNotFound.Wrapf("cron schedule: %s", req.Name)
+	}
+	return &types.QueryCronScheduleResponse{
+		Schedule: *schedule,
+	}, nil
+}
+
+func (q GrpcQuerier) ContractCallbacks(c context.Context, req *types.QueryContractCallbacksRequest) (*types.QueryContractCallbacksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	callbacks := make([]types.Callback, 0)
+
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.GetContractCallbacksPrefix(contractAddr))
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(_, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var callback types.Callback
+			if err := q.cdc.Unmarshal(value, &callback); err != nil {
+				return false, err
+			}
+			callbacks = append(callbacks, callback)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryContractCallbacksResponse{
+		Callbacks:  callbacks,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) FeeSponsorships(c context.Context, req *types.QueryFeeSponsorshipsRequest) (*types.QueryFeeSponsorshipsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	sponsorships := make([]types.FeeSponsorship, 0)
+
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.FeeSponsorshipPrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(_, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var sponsorship types.FeeSponsorship
+			if err := q.cdc.Unmarshal(value, &sponsorship); err != nil {
+				return false, err
+			}
+			sponsorships = append(sponsorships, sponsorship)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryFeeSponsorshipsResponse{
+		Sponsorships: sponsorships,
+		Pagination:   pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) FeeSponsorship(c context.Context, req *types.QueryFeeSponsorshipRequest) (*types.QueryFeeSponsorshipResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	sponsorship := q.keeper.GetFeeSponsorship(sdk.UnwrapSDKContext(c), contractAddr)
+	if sponsorship == nil {
+		return nil, types.ErrNotFound.Wrapf("fee sponsorship: %s", req.Address)
+	}
+	return &types.QueryFeeSponsorshipResponse{
+		Sponsorship: *sponsorship,
+	}, nil
+}
+
+func (q GrpcQuerier) FeeSponsorshipUsages(c context.Context, req *types.QueryFeeSponsorshipUsagesRequest) (*types.QueryFeeSponsorshipUsagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	usages := make([]types.FeeSponsorshipUsage, 0)
+
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.GetFeeSponsorshipUsagesPrefix(contractAddr))
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(_, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var usage types.FeeSponsorshipUsage
+			if err := q.cdc.Unmarshal(value, &usage); err != nil {
+				return false, err
+			}
+			usages = append(usages, usage)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryFeeSponsorshipUsagesResponse{
+		Usages:     usages,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) MigrationApprovals(c context.Context, req *types.QueryMigrationApprovalsRequest) (*types.QueryMigrationApprovalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	approvals := make([]types.MigrationApproval, 0)
+
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), types.GetMigrationApprovalsPrefix(contractAddr))
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(_, value []byte, accumulate bool) (bool, error) {
+		if accumulate {
+			var approval types.MigrationApproval
+			if err := q.cdc.Unmarshal(value, &approval); err != nil {
+				return false, err
+			}
+			approvals = append(approvals, approval)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryMigrationApprovalsResponse{
+		Approvals:  approvals,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) ContractDependencies(c context.Context, req *types.QueryContractDependenciesRequest) (*types.QueryContractDependenciesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	paginationParams, err := ensurePaginationParams(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := q.storeService.OpenKVStore(ctx)
+	dependencies := make([]types.ContractDependency, 0)
+
+	indexPrefix := types.GetContractDependenciesPrefix(contractAddr)
+	if req.Callers {
+		indexPrefix = types.GetContractDependentsPrefix(contractAddr)
+	}
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(store), indexPrefix)
+	pageRes, err := query.FilteredPaginate(prefixStore, paginationParams, func(key, value []byte, accumulate bool) (bool, error) {
+		if !accumulate {
+			return true, nil
+		}
+		if req.Callers {
+			// the reverse index has no values, the record is stored under the caller
+			if value, err = store.Get(types.GetContractDependencyKey(key, contractAddr)); err != nil {
+				return false, err
+			}
+		}
+		var dependency types.ContractDependency
+		if err := q.cdc.Unmarshal(value, &dependency); err != nil {
+			return false, err
+		}
+		dependencies = append(dependencies, dependency)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryContractDependenciesResponse{
+		Dependencies: dependencies,
+		Pagination:   pageRes,
+	}, nil
+}
+
+func (q GrpcQuerier) ContractMetadata(c context.Context, req *types.QueryContractMetadataRequest) (*types.QueryContractMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	metadata := q.keeper.GetContractMetadata(sdk.UnwrapSDKContext(c), contractAddr)
+	if metadata == nil {
+		return nil, types.ErrNotFound.Wrapf("contract metadata: %s", req.Address)
+	}
+	return &types.QueryContractMetadataResponse{
+		Metadata: *metadata,
+	}, nil
+}
+
+func (q GrpcQuerier) GasCosts(c context.Context, req *types.QueryGasCostsRequest) (*types.QueryGasCostsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if gasCosts := q.keeper.GetParams(ctx).GasCosts; gasCosts != nil {
+		return &types.QueryGasCostsResponse{GasCosts: *gasCosts, FromParams: true}, nil
+	}
+	gasRegister, ok := q.keeper.GetGasRegister().(types.WasmGasRegister)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "custom gas register")
+	}
+	return &types.QueryGasCostsResponse{GasCosts: types.NewGasCosts(gasRegister.Config())}, nil
+}
+
+// SimulateExecute runs the contract call with the query gas limit in a dry run. Unlike a tx simulation, the response
+// contains the contract store writes, the balance changes and the dispatched messages.
+func (q GrpcQuerier) SimulateExecute(c context.Context, req *types.QuerySimulateExecuteRequest) (rsp *types.QuerySimulateExecuteResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	runner, ok := q.keeper.(dryRunner)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "dry run not supported")
+	}
+	ctx := sdk.UnwrapSDKContext(c).WithGasMeter(storetypes.NewGasMeter(q.queryGasLimit))
+	// recover from out-of-gas panic
+	defer func() {
+		if r := recover(); r != nil {
+			switch rType := r.(type) {
+			case storetypes.ErrorOutOfGas:
+				err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas,
+					"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
+					rType.Descriptor, ctx.GasMeter().Limit(), ctx.GasMeter().GasConsumed(),
+				)
+			default:
+				err = sdkerrors.ErrPanic
+			}
+			rsp = nil
+			moduleLogger(ctx).
+				Debug("simulate execute",
+					"error", "recovering panic",
+					"stacktrace", string(debug.Stack()))
+		}
+	}()
+	return runner.dryRun(ctx, req)
+}
@@ -1,11 +1,14 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	"sort"
 	"testing"
 	"time"
 
@@ -18,6 +21,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -124,6 +128,94 @@ func TestQueryAllContractState(t *testing.T) {
 	}
 }
 
+func TestQueryAllContractStateRange(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	contractAddr := SeedNewContractInstance(t, ctx, keepers, &mock).Contract
+	fooKey := func(s string) []byte { return append([]byte("\x00\x03foo"), s...) }
+	require.NoError(t, keeper.importContractState(ctx, contractAddr, []types.Model{
+		{Key: []byte("\x00\x03bar1"), Value: []byte(`1`)},
+		{Key: fooKey("1"), Value: []byte(`1`)},
+		{Key: fooKey("2"), Value: []byte(`2`)},
+		{Key: fooKey("3"), Value: []byte(`3`)},
+		{Key: []byte("other"), Value: []byte(`1`)},
+	}))
+
+	q := Querier(keeper)
+	specs := map[string]struct {
+		srcQuery   *types.QueryAllContractStateRequest
+		expKeys    [][]byte
+		expNextKey []byte
+	}{
+		"prefix": {
+			srcQuery: &types.QueryAllContractStateRequest{Prefix: fooKey("")},
+			expKeys:  [][]byte{fooKey("1"), fooKey("2"), fooKey("3")},
+		},
+		"prefix reverse": {
+			srcQuery: &types.QueryAllContractStateRequest{Prefix: fooKey(""), Pagination: &query.PageRequest{Reverse: true}},
+			expKeys:  [][]byte{fooKey("3"), fooKey("2"), fooKey("1")},
+		},
+		"prefix with start and end": {
+			srcQuery: &types.QueryAllContractStateRequest{Prefix: fooKey(""), Start: fooKey("2"), End: fooKey("3")},
+			expKeys:  [][]byte{fooKey("2")},
+		},
+		"start and end outside prefix": {
+			srcQuery: &types.QueryAllContractStateRequest{Prefix: fooKey(""), Start: []byte("\x00"), End: []byte("z")},
+			expKeys:  [][]byte{fooKey("1"), fooKey("2"), fooKey("3")},
+		},
+		"start only": {
+			srcQuery: &types.QueryAllContractStateRequest{Start: fooKey("3")},
+			expKeys:  [][]byte{fooKey("3"), []byte("other")},
+		},
+		"end only": {
+			srcQuery: &types.QueryAllContractStateRequest{End: fooKey("1")},
+			expKeys:  [][]byte{[]byte("\x00\x03bar1")},
+		},
+		"empty range": {
+			srcQuery: &types.QueryAllContractStateRequest{Start: fooKey("3"), End: fooKey("1")},
+		},
+		"prefix with limit": {
+			srcQuery:   &types.QueryAllContractStateRequest{Prefix: fooKey(""), Pagination: &query.PageRequest{Limit: 2}},
+			expKeys:    [][]byte{fooKey("1"), fooKey("2")},
+			expNextKey: fooKey("3"),
+		},
+		"prefix with next key": {
+			srcQuery: &types.QueryAllContractStateRequest{Prefix: fooKey(""), Pagination: &query.PageRequest{Key: fooKey("2")}},
+			expKeys:  [][]byte{fooKey("2"), fooKey("3")},
+		},
+		"prefix reverse with limit": {
+			srcQuery:   &types.QueryAllContractStateRequest{Prefix: fooKey(""), Pagination: &query.PageRequest{Limit: 1, Reverse: true}},
+			expKeys:    [][]byte{fooKey("3")},
+			expNextKey: fooKey("2"),
+		},
+		"prefix reverse with next key": {
+			srcQuery: &types.QueryAllContractStateRequest{Prefix: fooKey(""), Pagination: &query.PageRequest{Key: fooKey("2"), Reverse: true}},
+			expKeys:  [][]byte{fooKey("2"), fooKey("1")},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			spec.srcQuery.Address = contractAddr.String()
+			// when
+			got, err := q.AllContractState(ctx, spec.srcQuery)
+			// then
+			require.NoError(t, err)
+			gotKeys := make([][]byte, 0, len(got.Models))
+			for _, m := range got.Models {
+				gotKeys = append(gotKeys, m.Key)
+			}
+			if spec.expKeys == nil {
+				spec.expKeys = [][]byte{}
+			}
+			assert.Equal(t, spec.expKeys, gotKeys)
+			assert.Equal(t, spec.expNextKey, got.Pagination.NextKey)
+		})
+	}
+}
+
 func TestQuerySmartContractState(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	keeper := keepers.WasmKeeper
@@ -169,6 +261,52 @@ func TestQuerySmartContractState(t *testing.T) {
 	}
 }
 
+func TestQueryBatchSmartContractState(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	contractAddr := exampleContract.Contract.String()
+	randomAddr := RandomBech32AccountAddress(t)
+	verifierQuery := types.QuerySmartContractStateRequest{Address: contractAddr, QueryData: []byte(`{"verifier":{}}`)}
+	expVerifier := fmt.Sprintf(`{"verifier":"%s"}`, exampleContract.VerifierAddr.String())
+
+	q := Querier(keeper)
+	// when
+	got, err := q.BatchSmartContractState(ctx, &types.QueryBatchSmartContractStateRequest{
+		Queries: []types.QuerySmartContractStateRequest{
+			verifierQuery,
+			{Address: contractAddr, QueryData: []byte(`{"raw":{"key":"config"}}`)},
+			{Address: contractAddr, QueryData: []byte(`not a json string`)},
+			{Address: randomAddr, QueryData: []byte(`{"verifier":{}}`)},
+		},
+	})
+	// then
+	require.NoError(t, err)
+	require.Len(t, got.Results, 4)
+	assert.JSONEq(t, expVerifier, string(got.Results[0].Data))
+	assert.Empty(t, got.Results[0].Error)
+	for _, r := range got.Results[1:] {
+		assert.Nil(t, r.Data)
+		assert.NotEmpty(t, r.Error)
+	}
+	assert.NotZero(t, got.GasUsed)
+
+	// when the shared gas limit is exceeded
+	single, err := q.BatchSmartContractState(ctx, &types.QueryBatchSmartContractStateRequest{Queries: []types.QuerySmartContractStateRequest{verifierQuery}})
+	require.NoError(t, err)
+	limit := single.GasUsed * 3 / 2
+	got, err = NewGrpcQuerier(keeper.cdc, keeper.storeService, keeper, limit).BatchSmartContractState(ctx, &types.QueryBatchSmartContractStateRequest{
+		Queries: []types.QuerySmartContractStateRequest{verifierQuery, verifierQuery, verifierQuery},
+	})
+	// then
+	require.NoError(t, err)
+	assert.JSONEq(t, expVerifier, string(got.Results[0].Data))
+	assert.Contains(t, got.Results[1].Error, "out of gas")
+	assert.Contains(t, got.Results[2].Error, "out of gas")
+	assert.Equal(t, limit, got.GasUsed)
+}
+
 func TestQuerySmartContractPanics(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	contractAddr := BuildContractAddressClassic(1, 1)
@@ -271,6 +409,204 @@ func TestQueryRawContractState(t *testing.T) {
 	}
 }
 
+func TestQueryContractStorageStats(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	contractAddr := exampleContract.Contract.String()
+	initialStats := keeper.GetContractStorageStats(ctx, exampleContract.Contract)
+	require.NotZero(t, initialStats.KeyCount)
+	contractModel := []types.Model{
+		{Key: []byte("foo"), Value: []byte(`"bar"`)},
+		{Key: []byte{0x0, 0x1}, Value: []byte(`{"count":8}`)},
+	}
+	require.NoError(t, keeper.importContractState(ctx, exampleContract.Contract, contractModel))
+
+	randomAddr := RandomBech32AccountAddress(t)
+
+	q := Querier(keeper)
+	specs := map[string]struct {
+		srcQuery *types.QueryContractStorageStatsRequest
+		expStats types.ContractStorageStats
+		expErr   error
+	}{
+		"query stats": {
+			srcQuery: &types.QueryContractStorageStatsRequest{Address: contractAddr},
+			expStats: types.ContractStorageStats{
+				KeyCount:   initialStats.KeyCount + 2,
+				TotalBytes: initialStats.TotalBytes + 8 + 13,
+			},
+		},
+		"query with unknown address": {
+			srcQuery: &types.QueryContractStorageStatsRequest{Address: randomAddr},
+			expErr:   types.ErrNoSuchContractFn(randomAddr).Wrapf("address %s", randomAddr),
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			got, err := q.ContractStorageStats(ctx, spec.srcQuery)
+			if spec.expErr != nil {
+				assert.Equal(t, spec.expErr.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, spec.expStats, got.Stats)
+		})
+	}
+}
+
+func TestQueryCronSchedules(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	schedules := []types.CronSchedule{
+		{Name: "a", Contract: exampleContract.Contract.String(), Msg: []byte(`{}`), Interval: 1, GasLimit: 1},
+		{Name: "b", Contract: exampleContract.Contract.String(), Msg: []byte(`{}`), Interval: 2, GasLimit: 2},
+	}
+	for _, s := range schedules {
+		require.NoError(t, keeper.addCronSchedule(ctx, s))
+	}
+
+	q := Querier(keeper)
+	// when
+	gotAll, err := q.CronSchedules(ctx, &types.QueryCronSchedulesRequest{})
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, schedules, gotAll.Schedules)
+
+	// when paginated
+	gotPage, err := q.CronSchedules(ctx, &types.QueryCronSchedulesRequest{Pagination: &query.PageRequest{Limit: 1}})
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, schedules[:1], gotPage.Schedules)
+	assert.NotEmpty(t, gotPage.Pagination.NextKey)
+
+	// when single schedule
+	gotOne, err := q.CronSchedule(ctx, &types.QueryCronScheduleRequest{Name: "b"})
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, schedules[1], gotOne.Schedule)
+
+	// when unknown
+	_, err = q.CronSchedule(ctx, &types.QueryCronScheduleRequest{Name: "unknown"})
+	// then
+	require.ErrorIs(t, err, types.ErrNotFound)
+}
+
+func TestQueryContractCallbacks(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	deposit := sdk.NewCoins(sdk.NewCoin("denom", sdkmath.NewInt(100)))
+	params := keeper.GetParams(ctx)
+	params.CallbackDeposit = deposit
+	require.NoError(t, keeper.SetParams(ctx, params))
+	keepers.Faucet.Fund(ctx, exampleContract.Contract, deposit.MulInt(sdkmath.NewInt(2))...)
+	var callbacks []types.Callback
+	for _, h := range []uint64{10, 20} {
+		id, err := keeper.scheduleCallback(ctx, exampleContract.Contract, types.ScheduleCallbackMsg{Height: uint64(ctx.BlockHeight()) + h})
+		require.NoError(t, err)
+		callbacks = append(callbacks, *keeper.GetCallback(ctx, exampleContract.Contract, id))
+	}
+
+	q := Querier(keeper)
+	// when
+	gotAll, err := q.ContractCallbacks(ctx, &types.QueryContractCallbacksRequest{Address: exampleContract.Contract.String()})
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, callbacks, gotAll.Callbacks)
+
+	// when paginated
+	gotPage, err := q.ContractCallbacks(ctx, &types.QueryContractCallbacksRequest{Address: exampleContract.Contract.String(), Pagination: &query.PageRequest{Limit: 1}})
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, callbacks[:1], gotPage.Callbacks)
+	assert.NotEmpty(t, gotPage.Pagination.NextKey)
+
+	// when other contract
+	gotNone, err := q.ContractCallbacks(ctx, &types.QueryContractCallbacksRequest{Address: RandomBech32AccountAddress(t)})
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, gotNone.Callbacks)
+
+	// when invalid address
+	_, err = q.ContractCallbacks(ctx, &types.QueryContractCallbacksRequest{Address: "invalid"})
+	// then
+	require.Error(t, err)
+}
+
+func TestQueryFeeSponsorships(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	maxFee := sdk.NewCoins(sdk.NewInt64Coin("denom", 10))
+	require.NoError(t, keeper.setFeeSponsorship(ctx, exampleContract.Contract, exampleContract.Contract, maxFee, nil, DefaultAuthorizationPolicy{}))
+	funder := keepers.Faucet.NewFundedRandomAccount(ctx, sdk.NewInt64Coin("denom", 100))
+	require.NoError(t, keeper.fundFeeSponsorship(ctx, exampleContract.Contract, funder, sdk.NewCoins(sdk.NewInt64Coin("denom", 100))))
+	sender := RandomAccountAddress(t)
+	sponsored, err := keeper.DeductSponsoredFee(ctx, exampleContract.Contract, sender, maxFee)
+	require.NoError(t, err)
+	require.True(t, sponsored)
+	exp := *keeper.GetFeeSponsorship(ctx, exampleContract.Contract)
+
+	q := Querier(keeper)
+	// when
+	gotAll, err := q.FeeSponsorships(ctx, &types.QueryFeeSponsorshipsRequest{})
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []types.FeeSponsorship{exp}, gotAll.Sponsorships)
+
+	// when single sponsorship
+	gotOne, err := q.FeeSponsorship(ctx, &types.QueryFeeSponsorshipRequest{Address: exampleContract.Contract.String()})
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, exp, gotOne.Sponsorship)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom", 90)), gotOne.Sponsorship.Balance)
+
+	// when usages
+	gotUsages, err := q.FeeSponsorshipUsages(ctx, &types.QueryFeeSponsorshipUsagesRequest{Address: exampleContract.Contract.String()})
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []types.FeeSponsorshipUsage{{Contract: exampleContract.Contract.String(), Sender: sender.String(), Spent: maxFee}}, gotUsages.Usages)
+
+	// when unknown
+	_, err = q.FeeSponsorship(ctx, &types.QueryFeeSponsorshipRequest{Address: RandomBech32AccountAddress(t)})
+	// then
+	require.ErrorIs(t, err, types.ErrNotFound)
+}
+
+func TestQueryMigrationApprovals(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	keeper := keepers.WasmKeeper
+
+	exampleContract := InstantiateHackatomExampleContract(t, ctx, keepers)
+	member := RandomAccountAddress(t)
+	adminSet := types.AdminSet{Members: []string{member.String(), RandomBech32AccountAddress(t)}, Threshold: 2}
+	require.NoError(t, keeper.setContractAdminSet(ctx, exampleContract.Contract, exampleContract.CreatorAddr, adminSet, DefaultAuthorizationPolicy{}))
+	migrated, _, err := keeper.approveMigration(ctx, exampleContract.Contract, member, exampleContract.CodeID, []byte(`{}`), DefaultAuthorizationPolicy{})
+	require.NoError(t, err)
+	require.False(t, migrated)
+
+	q := Querier(keeper)
+	// when
+	got, err := q.MigrationApprovals(ctx, &types.QueryMigrationApprovalsRequest{Address: exampleContract.Contract.String()})
+	// then
+	require.NoError(t, err)
+	require.Len(t, got.Approvals, 1)
+	assert.Equal(t, *keeper.GetMigrationApproval(ctx, exampleContract.Contract, exampleContract.CodeID, []byte(`{}`)), got.Approvals[0])
+	assert.Equal(t, []string{member.String()}, got.Approvals[0].Approvals)
+
+	// when unknown
+	got, err = q.MigrationApprovals(ctx, &types.QueryMigrationApprovalsRequest{Address: RandomBech32AccountAddress(t)})
+	// then
+	require.NoError(t, err)
+	assert.Empty(t, got.Approvals)
+}
+
 func TestQueryContractsByCode(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 	keeper := keepers.WasmKeeper
@@ -893,6 +1229,184 @@ func TestQueryCodeInfoList(t *testing.T) {
 	require.EqualValues(t, allCodesResponse, got.CodeInfos)
 }
 
+func TestQueryContractsByAdmin(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	example1 := InstantiateHackatomExampleContract(t, ctx, keepers)
+	example2 := InstantiateHackatomExampleContract(t, ctx, keepers)
+	admin, member := example1.CreatorAddr, RandomAccountAddress(t)
+	policy := DefaultAuthorizationPolicy{}
+
+	q := Querier(k)
+	queryContracts := func(addr sdk.AccAddress) []string {
+		res, err := q.ContractsByAdmin(ctx, &types.QueryContractsByAdminRequest{AdminAddress: addr.String()})
+		require.NoError(t, err)
+		return res.ContractAddresses
+	}
+	// instantiated with admin
+	assert.Equal(t, []string{example1.Contract.String()}, queryContracts(admin))
+
+	// when admin is updated
+	require.NoError(t, k.setContractAdmin(ctx, example2.Contract, example2.CreatorAddr, admin, policy))
+	// then
+	assert.ElementsMatch(t, []string{example1.Contract.String(), example2.Contract.String()}, queryContracts(admin))
+	assert.Empty(t, queryContracts(example2.CreatorAddr))
+
+	// when admin set is set
+	adminSet := types.AdminSet{Members: []string{admin.String(), member.String()}, Threshold: 2}
+	require.NoError(t, k.setContractAdminSet(ctx, example1.Contract, admin, adminSet, policy))
+	// then all members are indexed
+	assert.ElementsMatch(t, []string{example1.Contract.String(), example2.Contract.String()}, queryContracts(admin))
+	assert.Equal(t, []string{example1.Contract.String()}, queryContracts(member))
+
+	// when admin is cleared
+	require.NoError(t, k.setContractAdmin(ctx, example2.Contract, admin, nil, policy))
+	// then
+	assert.Equal(t, []string{example1.Contract.String()}, queryContracts(admin))
+
+	// when paginated
+	require.NoError(t, k.setContractAdmin(ctx, example2.Contract, nil, member, GovAuthorizationPolicy{}))
+	res, err := q.ContractsByAdmin(ctx, &types.QueryContractsByAdminRequest{AdminAddress: member.String(), Pagination: &query.PageRequest{Limit: 1}})
+	// then
+	require.NoError(t, err)
+	assert.Len(t, res.ContractAddresses, 1)
+	assert.NotEmpty(t, res.Pagination.NextKey)
+
+	// invalid requests
+	_, err = q.ContractsByAdmin(ctx, nil)
+	require.Error(t, err)
+	_, err = q.ContractsByAdmin(ctx, &types.QueryContractsByAdminRequest{})
+	require.Error(t, err)
+}
+
+func TestQueryContractsByLabel(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	codeID := StoreHackatomExampleContract(t, ctx, keepers).CodeID
+	initMsgBz := HackatomExampleInitMsg{Verifier: RandomAccountAddress(t), Beneficiary: RandomAccountAddress(t)}.GetBytes(t)
+
+	creators := make(map[string]sdk.AccAddress)
+	instantiate := func(label string) types.LabeledContract {
+		creator := RandomAccountAddress(t)
+		addr, _, err := keepers.ContractKeeper.Instantiate(ctx, codeID, creator, creator, initMsgBz, label, nil)
+		require.NoError(t, err)
+		creators[addr.String()] = creator
+		return types.LabeledContract{Address: addr.String(), Label: label}
+	}
+	app1, app2 := instantiate("app"), instantiate("app")
+	appV2 := instantiate("app v2")
+	other := instantiate("other")
+	// the index is ordered by label and creator address
+	sortedApps := []types.LabeledContract{app1, app2}
+	sort.Slice(sortedApps, func(i, j int) bool {
+		return bytes.Compare(creators[sortedApps[i].Address], creators[sortedApps[j].Address]) < 0
+	})
+
+	q := Querier(k)
+	specs := map[string]struct {
+		src    *types.QueryContractsByLabelRequest
+		exp    []types.LabeledContract
+		expErr bool
+	}{
+		"exact": {
+			src: &types.QueryContractsByLabelRequest{Label: "app"},
+			exp: []types.LabeledContract{app1, app2},
+		},
+		"prefix": {
+			src: &types.QueryContractsByLabelRequest{Label: "app", PrefixMatch: true},
+			exp: []types.LabeledContract{app1, app2, appV2},
+		},
+		"all by empty prefix": {
+			src: &types.QueryContractsByLabelRequest{PrefixMatch: true},
+			exp: []types.LabeledContract{app1, app2, appV2, other},
+		},
+		"with pagination limit": {
+			src: &types.QueryContractsByLabelRequest{Label: "app", PrefixMatch: true, Pagination: &query.PageRequest{Limit: 1}},
+			exp: sortedApps[0:1],
+		},
+		"unknown": {
+			src: &types.QueryContractsByLabelRequest{Label: "unknown"},
+			exp: []types.LabeledContract{},
+		},
+		"empty label": {
+			src:    &types.QueryContractsByLabelRequest{},
+			expErr: true,
+		},
+		"nil req": {
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// when
+			got, gotErr := q.ContractsByLabel(ctx, spec.src)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			if spec.src.Pagination != nil {
+				assert.Equal(t, spec.exp, got.Contracts)
+				return
+			}
+			assert.ElementsMatch(t, spec.exp, got.Contracts)
+		})
+	}
+
+	// when label is updated
+	require.NoError(t, k.setContractLabel(ctx, sdk.MustAccAddressFromBech32(other.Address), nil, "app v3", GovAuthorizationPolicy{}))
+	// then
+	got, err := q.ContractsByLabel(ctx, &types.QueryContractsByLabelRequest{Label: "other"})
+	require.NoError(t, err)
+	assert.Empty(t, got.Contracts)
+	got, err = q.ContractsByLabel(ctx, &types.QueryContractsByLabelRequest{Label: "app v3"})
+	require.NoError(t, err)
+	assert.Equal(t, []types.LabeledContract{{Address: other.Address, Label: "app v3"}}, got.Contracts)
+}
+
+func TestQueryCodesByChecksumAndCreator(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example1 := StoreHackatomExampleContract(t, ctx, keepers)
+	example2 := StoreHackatomExampleContract(t, ctx, keepers)
+	otherCode := StoreReflectContract(t, ctx, keepers)
+
+	q := Querier(keepers.WasmKeeper)
+	// when queried by checksum
+	gotByChecksum, err := q.CodesByChecksum(ctx, &types.QueryCodesByChecksumRequest{Checksum: hex.EncodeToString(example1.Checksum)})
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{example1.CodeID, example2.CodeID}, gotByChecksum.CodeIDs)
+
+	// when queried by creator
+	gotByCreator, err := q.CodesByCreator(ctx, &types.QueryCodesByCreatorRequest{CreatorAddress: example2.CreatorAddr.String()})
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{example2.CodeID}, gotByCreator.CodeIDs)
+	gotByCreator, err = q.CodesByCreator(ctx, &types.QueryCodesByCreatorRequest{CreatorAddress: otherCode.CreatorAddr.String()})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{otherCode.CodeID}, gotByCreator.CodeIDs)
+
+	// when paginated
+	gotByChecksum, err = q.CodesByChecksum(ctx, &types.QueryCodesByChecksumRequest{Checksum: hex.EncodeToString(example1.Checksum), Pagination: &query.PageRequest{Limit: 1}})
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{example1.CodeID}, gotByChecksum.CodeIDs)
+	assert.NotEmpty(t, gotByChecksum.Pagination.NextKey)
+
+	// invalid requests
+	_, err = q.CodesByChecksum(ctx, nil)
+	require.Error(t, err)
+	_, err = q.CodesByChecksum(ctx, &types.QueryCodesByChecksumRequest{Checksum: "not hex"})
+	require.Error(t, err)
+	_, err = q.CodesByChecksum(ctx, &types.QueryCodesByChecksumRequest{Checksum: "aabb"})
+	require.Error(t, err)
+	_, err = q.CodesByCreator(ctx, nil)
+	require.Error(t, err)
+	_, err = q.CodesByCreator(ctx, &types.QueryCodesByCreatorRequest{CreatorAddress: "invalid"})
+	require.Error(t, err)
+}
+
 func TestQueryContractsByCreatorList(t *testing.T) {
 	ctx, keepers := CreateTestInput(t, false, AvailableCapabilities)
 
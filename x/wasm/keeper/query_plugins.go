@@ -60,6 +60,9 @@ func (q QueryHandler) Query(request wasmvmtypes.QueryRequest, gasLimit uint64) (
 	defer func() {
 		q.Ctx.GasMeter().ConsumeGas(subCtx.GasMeter().GasConsumed(), "contract sub-query")
 	}()
+	profiler, _ := types.GasProfilerFromContext(subCtx)
+	profiler.Enter(types.GasProfileStepQuery, queriedContract(request), subCtx.GasMeter())
+	defer profiler.Exit()
 
 	res, err := q.Plugins.HandleQuery(subCtx, q.Caller, request)
 	if err == nil {
@@ -78,6 +81,26 @@ func (q QueryHandler) Query(request wasmvmtypes.QueryRequest, gasLimit uint64) (
 	return nil, redactError(err)
 }
 
+// queriedContract returns the address of the contract for wasm queries or nil
+func queriedContract(request wasmvmtypes.QueryRequest) sdk.AccAddress {
+	var addr string
+	switch {
+	case request.Wasm == nil:
+		return nil
+	case request.Wasm.Smart != nil:
+		addr = request.Wasm.Smart.ContractAddr
+	case request.Wasm.Raw != nil:
+		addr = request.Wasm.Raw.ContractAddr
+	case request.Wasm.ContractInfo != nil:
+		addr = request.Wasm.ContractInfo.ContractAddr
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return nil
+	}
+	return contractAddr
+}
+
 func (q QueryHandler) GasConsumed() uint64 {
 	return q.gasRegister.ToWasmVMGas(q.Ctx.GasMeter().GasConsumed())
 }
@@ -27,9 +27,11 @@ func (k Keeper) OnOpenChannel(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBCChannelOpenMsg,
-) (string, error) {
+) (_ string, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-open-channel")
-	_, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+	ctx, traceExit := k.traceCall(ctx, types.TracedCall{Entrypoint: types.TraceEntrypointIBCChannelOpen, Callee: contractAddr}, msg)
+	defer traceExit(&contractAddr, nil, &err)
+	_, codeInfo, prefixStore, err := k.executableContractInstance(ctx, contractAddr)
 	if err != nil {
 		return "", err
 	}
@@ -37,12 +39,16 @@ func (k Keeper) OnOpenChannel(
 	env := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
+	storageBefore := k.storageStatsSnapshot(ctx, contractAddr)
 	gasLeft := k.runtimeGasForContract(ctx)
 	res, gasUsed, execErr := k.wasmVM.IBCChannelOpen(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
 	if execErr != nil {
 		return "", errorsmod.Wrap(types.ErrExecuteFailed, execErr.Error())
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, storageBefore, prefixStore); err != nil {
+		return "", err
+	}
 	if res != nil && res.Ok != nil {
 		return res.Ok.Version, nil
 	}
@@ -60,9 +66,11 @@ func (k Keeper) OnConnectChannel(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBCChannelConnectMsg,
-) error {
+) (err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-connect-channel")
-	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+	ctx, traceExit := k.traceCall(ctx, types.TracedCall{Entrypoint: types.TraceEntrypointIBCChannelConnect, Callee: contractAddr}, msg)
+	defer traceExit(&contractAddr, nil, &err)
+	contractInfo, codeInfo, prefixStore, err := k.executableContractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
 	}
@@ -70,6 +78,7 @@ func (k Keeper) OnConnectChannel(
 	env := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
+	storageBefore := k.storageStatsSnapshot(ctx, contractAddr)
 	gasLeft := k.runtimeGasForContract(ctx)
 	res, gasUsed, execErr := k.wasmVM.IBCChannelConnect(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
@@ -83,6 +92,9 @@ func (k Keeper) OnConnectChannel(
 	if res.Err != "" {
 		return types.MarkErrorDeterministic(errorsmod.Wrap(types.ErrExecuteFailed, res.Err))
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, storageBefore, prefixStore); err != nil {
+		return err
+	}
 
 	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res.Ok)
 }
@@ -97,10 +109,12 @@ func (k Keeper) OnCloseChannel(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBCChannelCloseMsg,
-) error {
+) (err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-close-channel")
+	ctx, traceExit := k.traceCall(ctx, types.TracedCall{Entrypoint: types.TraceEntrypointIBCChannelClose, Callee: contractAddr}, msg)
+	defer traceExit(&contractAddr, nil, &err)
 
-	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+	contractInfo, codeInfo, prefixStore, err := k.executableContractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
 	}
@@ -108,6 +122,7 @@ func (k Keeper) OnCloseChannel(
 	params := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
+	storageBefore := k.storageStatsSnapshot(ctx, contractAddr)
 	gasLeft := k.runtimeGasForContract(ctx)
 	res, gasUsed, execErr := k.wasmVM.IBCChannelClose(codeInfo.CodeHash, params, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
@@ -121,6 +136,9 @@ func (k Keeper) OnCloseChannel(
 	if res.Err != "" {
 		return types.MarkErrorDeterministic(errorsmod.Wrap(types.ErrExecuteFailed, res.Err))
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, storageBefore, prefixStore); err != nil {
+		return err
+	}
 
 	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res.Ok)
 }
@@ -135,9 +153,11 @@ func (k Keeper) OnRecvPacket(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBCPacketReceiveMsg,
-) (ibcexported.Acknowledgement, error) {
+) (_ ibcexported.Acknowledgement, err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-recv-packet")
-	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+	ctx, traceExit := k.traceCall(ctx, types.TracedCall{Entrypoint: types.TraceEntrypointIBCPacketReceive, Callee: contractAddr}, msg)
+	defer traceExit(&contractAddr, nil, &err)
+	contractInfo, codeInfo, prefixStore, err := k.executableContractInstance(ctx, contractAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -145,6 +165,7 @@ func (k Keeper) OnRecvPacket(
 	env := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
+	storageBefore := k.storageStatsSnapshot(ctx, contractAddr)
 	gasLeft := k.runtimeGasForContract(ctx)
 	res, gasUsed, execErr := k.wasmVM.IBCPacketReceive(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
@@ -164,6 +185,9 @@ func (k Keeper) OnRecvPacket(
 			Response: &channeltypes.Acknowledgement_Error{Error: res.Err},
 		}, nil
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, storageBefore, prefixStore); err != nil {
+		return nil, err
+	}
 	// note submessage reply results can overwrite the `Acknowledgement` data
 	data, err := k.handleContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res.Ok.Messages, res.Ok.Attributes, res.Ok.Acknowledgement, res.Ok.Events)
 	if err != nil {
@@ -208,9 +232,11 @@ func (k Keeper) OnAckPacket(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBCPacketAckMsg,
-) error {
+) (err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-ack-packet")
-	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+	ctx, traceExit := k.traceCall(ctx, types.TracedCall{Entrypoint: types.TraceEntrypointIBCPacketAck, Callee: contractAddr}, msg)
+	defer traceExit(&contractAddr, nil, &err)
+	contractInfo, codeInfo, prefixStore, err := k.executableContractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
 	}
@@ -218,6 +244,7 @@ func (k Keeper) OnAckPacket(
 	env := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
+	storageBefore := k.storageStatsSnapshot(ctx, contractAddr)
 	gasLeft := k.runtimeGasForContract(ctx)
 	res, gasUsed, execErr := k.wasmVM.IBCPacketAck(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
@@ -231,6 +258,9 @@ func (k Keeper) OnAckPacket(
 	if res.Err != "" {
 		return types.MarkErrorDeterministic(errorsmod.Wrap(types.ErrExecuteFailed, res.Err))
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, storageBefore, prefixStore); err != nil {
+		return err
+	}
 
 	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res.Ok)
 }
@@ -242,10 +272,12 @@ func (k Keeper) OnTimeoutPacket(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBCPacketTimeoutMsg,
-) error {
+) (err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-timeout-packet")
+	ctx, traceExit := k.traceCall(ctx, types.TracedCall{Entrypoint: types.TraceEntrypointIBCPacketTimeout, Callee: contractAddr}, msg)
+	defer traceExit(&contractAddr, nil, &err)
 
-	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+	contractInfo, codeInfo, prefixStore, err := k.executableContractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
 	}
@@ -253,6 +285,7 @@ func (k Keeper) OnTimeoutPacket(
 	env := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
+	storageBefore := k.storageStatsSnapshot(ctx, contractAddr)
 	gasLeft := k.runtimeGasForContract(ctx)
 	res, gasUsed, execErr := k.wasmVM.IBCPacketTimeout(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
@@ -266,6 +299,9 @@ func (k Keeper) OnTimeoutPacket(
 	if res.Err != "" {
 		return types.MarkErrorDeterministic(errorsmod.Wrap(types.ErrExecuteFailed, res.Err))
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, storageBefore, prefixStore); err != nil {
+		return err
+	}
 
 	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res.Ok)
 }
@@ -276,10 +312,12 @@ func (k Keeper) IBCSourceCallback(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBCSourceCallbackMsg,
-) error {
+) (err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-source-chain-callback")
+	ctx, traceExit := k.traceCall(ctx, types.TracedCall{Entrypoint: types.TraceEntrypointIBCSourceCallback, Callee: contractAddr}, msg)
+	defer traceExit(&contractAddr, nil, &err)
 
-	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+	contractInfo, codeInfo, prefixStore, err := k.executableContractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
 	}
@@ -287,6 +325,7 @@ func (k Keeper) IBCSourceCallback(
 	env := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
+	storageBefore := k.storageStatsSnapshot(ctx, contractAddr)
 	gasLeft := k.runtimeGasForContract(ctx)
 	res, gasUsed, execErr := k.wasmVM.IBCSourceCallback(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
@@ -300,6 +339,9 @@ func (k Keeper) IBCSourceCallback(
 	if res.Err != "" {
 		return types.MarkErrorDeterministic(errorsmod.Wrap(types.ErrExecuteFailed, res.Err))
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, storageBefore, prefixStore); err != nil {
+		return err
+	}
 
 	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res.Ok)
 }
@@ -310,10 +352,12 @@ func (k Keeper) IBCDestinationCallback(
 	ctx sdk.Context,
 	contractAddr sdk.AccAddress,
 	msg wasmvmtypes.IBCDestinationCallbackMsg,
-) error {
+) (err error) {
 	defer telemetry.MeasureSince(time.Now(), "wasm", "contract", "ibc-destination-chain-callback")
+	ctx, traceExit := k.traceCall(ctx, types.TracedCall{Entrypoint: types.TraceEntrypointIBCDestinationCallback, Callee: contractAddr}, msg)
+	defer traceExit(&contractAddr, nil, &err)
 
-	contractInfo, codeInfo, prefixStore, err := k.contractInstance(ctx, contractAddr)
+	contractInfo, codeInfo, prefixStore, err := k.executableContractInstance(ctx, contractAddr)
 	if err != nil {
 		return err
 	}
@@ -321,6 +365,7 @@ func (k Keeper) IBCDestinationCallback(
 	env := types.NewEnv(ctx, contractAddr)
 	querier := k.newQueryHandler(ctx, contractAddr)
 
+	storageBefore := k.storageStatsSnapshot(ctx, contractAddr)
 	gasLeft := k.runtimeGasForContract(ctx)
 	res, gasUsed, execErr := k.wasmVM.IBCDestinationCallback(codeInfo.CodeHash, env, msg, prefixStore, cosmwasmAPI, querier, ctx.GasMeter(), gasLeft, costJSONDeserialization)
 	k.consumeRuntimeGas(ctx, gasUsed)
@@ -334,6 +379,9 @@ func (k Keeper) IBCDestinationCallback(
 	if res.Err != "" {
 		return types.MarkErrorDeterministic(errorsmod.Wrap(types.ErrExecuteFailed, res.Err))
 	}
+	if err := k.settleStorageDeposit(ctx, contractAddr, storageBefore, prefixStore); err != nil {
+		return err
+	}
 
 	return k.handleIBCBasicContractResponse(ctx, contractAddr, contractInfo.IBCPortID, res.Ok)
 }
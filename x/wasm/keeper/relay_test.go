@@ -745,3 +745,50 @@ func stripTypes(events sdk.Events) []string {
 	}
 	return r
 }
+
+func TestIBCEntryPointsRejectFrozenContract(t *testing.T) {
+	var m wasmtesting.MockWasmEngine
+	wasmtesting.MakeIBCInstantiable(&m)
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	example := SeedNewContractInstance(t, parentCtx, keepers, &m)
+	k := keepers.WasmKeeper
+	require.NoError(t, k.setContractFrozen(parentCtx, example.Contract, nil, true, true, GovAuthorizationPolicy{}))
+
+	specs := map[string]func(ctx sdk.Context) error{
+		"open channel": func(ctx sdk.Context) error {
+			_, err := k.OnOpenChannel(ctx, example.Contract, wasmvmtypes.IBCChannelOpenMsg{})
+			return err
+		},
+		"connect channel": func(ctx sdk.Context) error {
+			return k.OnConnectChannel(ctx, example.Contract, wasmvmtypes.IBCChannelConnectMsg{})
+		},
+		"close channel": func(ctx sdk.Context) error {
+			return k.OnCloseChannel(ctx, example.Contract, wasmvmtypes.IBCChannelCloseMsg{})
+		},
+		"receive packet": func(ctx sdk.Context) error {
+			_, err := k.OnRecvPacket(ctx, example.Contract, wasmvmtypes.IBCPacketReceiveMsg{})
+			return err
+		},
+		"ack packet": func(ctx sdk.Context) error {
+			return k.OnAckPacket(ctx, example.Contract, wasmvmtypes.IBCPacketAckMsg{})
+		},
+		"timeout packet": func(ctx sdk.Context) error {
+			return k.OnTimeoutPacket(ctx, example.Contract, wasmvmtypes.IBCPacketTimeoutMsg{})
+		},
+		"source callback": func(ctx sdk.Context) error {
+			return k.IBCSourceCallback(ctx, example.Contract, wasmvmtypes.IBCSourceCallbackMsg{})
+		},
+		"destination callback": func(ctx sdk.Context) error {
+			return k.IBCDestinationCallback(ctx, example.Contract, wasmvmtypes.IBCDestinationCallbackMsg{})
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := parentCtx.CacheContext()
+			// when
+			err := spec(ctx)
+			// then
+			require.ErrorIs(t, err, types.ErrContractFrozen)
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// StorageDepositEscrowAddress is the account that holds the storage deposits of all contracts
+var StorageDepositEscrowAddress = sdk.AccAddress(address.Module(types.ModuleName, []byte("storage_deposit")))
+
+// StorageDepositPolicy is an extension point to charge contracts for the bytes they store.
+type StorageDepositPolicy interface {
+	// OnStorageChange is called after a successful contract call that modified the number of bytes stored by the contract.
+	// Returning an error rejects the contract call with all its state changes.
+	OnStorageChange(ctx sdk.Context, contractAddr sdk.AccAddress, before, after types.ContractStorageStats) error
+}
+
+var _ StorageDepositPolicy = NoopStorageDepositPolicy{}
+
+// NoopStorageDepositPolicy default implementation that does not charge any deposit
+type NoopStorageDepositPolicy struct{}
+
+// OnStorageChange does nothing
+func (NoopStorageDepositPolicy) OnStorageChange(sdk.Context, sdk.AccAddress, types.ContractStorageStats, types.ContractStorageStats) error {
+	return nil
+}
+
+type storageDepositSource interface {
+	GetParams(ctx context.Context) types.Params
+	GetContractStorageDeposit(ctx context.Context, contractAddr sdk.AccAddress) sdk.Coins
+	setContractStorageDeposit(ctx context.Context, contractAddr sdk.AccAddress, amount sdk.Coins) error
+}
+
+var _ StorageDepositPolicy = ParamsStorageDepositPolicy{}
+
+// ParamsStorageDepositPolicy charges the `StorageDepositPerByte` amount from the params for each byte that the contract
+// stores additionally. The deposit is moved from the contract account to the StorageDepositEscrowAddress and recorded
+// for the contract. When the storage shrinks, the recorded deposit is refunded proportionally to the bytes that were
+// released, so that a contract never gets back more than it has deposited, independent of rate changes.
+type ParamsStorageDepositPolicy struct {
+	deposits storageDepositSource
+	bank     CoinTransferrer
+}
+
+// NewParamsStorageDepositPolicy constructor
+func NewParamsStorageDepositPolicy(deposits storageDepositSource, bank CoinTransferrer) ParamsStorageDepositPolicy {
+	if deposits == nil || bank == nil {
+		panic("must not be nil")
+	}
+	return ParamsStorageDepositPolicy{deposits: deposits, bank: bank}
+}
+
+// OnStorageChange charges or refunds the deposit for the changed number of bytes
+func (p ParamsStorageDepositPolicy) OnStorageChange(ctx sdk.Context, contractAddr sdk.AccAddress, before, after types.ContractStorageStats) error {
+	deposit := p.deposits.GetContractStorageDeposit(ctx, contractAddr)
+	switch {
+	case after.TotalBytes > before.TotalBytes:
+		rate := p.deposits.GetParams(ctx).StorageDepositPerByte
+		if rate.IsZero() {
+			return nil
+		}
+		amount := depositFor(rate, after.TotalBytes-before.TotalBytes)
+		if err := p.bank.TransferCoins(ctx, contractAddr, StorageDepositEscrowAddress, amount); err != nil {
+			return types.ErrStorageDeposit.Wrapf("%s for %d bytes: %s", amount, after.TotalBytes-before.TotalBytes, err)
+		}
+		return p.deposits.setContractStorageDeposit(ctx, contractAddr, deposit.Add(amount...))
+	case after.TotalBytes < before.TotalBytes:
+		amount := refundFor(deposit, before.TotalBytes-after.TotalBytes, before.TotalBytes)
+		if amount.IsZero() {
+			return nil
+		}
+		if err := p.bank.TransferCoins(ctx, StorageDepositEscrowAddress, contractAddr, amount); err != nil {
+			return errorsmod.Wrap(err, "storage deposit refund")
+		}
+		return p.deposits.setContractStorageDeposit(ctx, contractAddr, deposit.Sub(amount...))
+	}
+	return nil
+}
+
+func depositFor(rate sdk.Coins, bytes uint64) sdk.Coins {
+	return rate.MulInt(sdkmath.NewIntFromUint64(bytes))
+}
+
+// refundFor returns the share of the deposit for the released bytes of the total bytes, rounded down. All of the
+// deposit is returned when all bytes are released.
+func refundFor(deposit sdk.Coins, released, total uint64) sdk.Coins {
+	if released >= total {
+		return deposit
+	}
+	refund := sdk.NewCoins()
+	for _, c := range deposit {
+		amount := c.Amount.Mul(sdkmath.NewIntFromUint64(released)).Quo(sdkmath.NewIntFromUint64(total))
+		refund = refund.Add(sdk.NewCoin(c.Denom, amount))
+	}
+	return refund
+}
+
+// GetContractStorageDeposit returns the storage deposit that is held in escrow for the contract
+func (k Keeper) GetContractStorageDeposit(ctx context.Context, contractAddr sdk.AccAddress) sdk.Coins {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.GetContractStorageDepositKey(contractAddr))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+	var deposit types.ContractStorageDeposit
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return deposit.Amount
+}
+
+// setContractStorageDeposit persists the storage deposit of the contract. The record is removed for a zero amount.
+func (k Keeper) setContractStorageDeposit(ctx context.Context, contractAddr sdk.AccAddress, amount sdk.Coins) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if amount.IsZero() {
+		return store.Delete(types.GetContractStorageDepositKey(contractAddr))
+	}
+	return store.Set(types.GetContractStorageDepositKey(contractAddr), k.cdc.MustMarshal(&types.ContractStorageDeposit{Amount: amount}))
+}
+
+// storageStatsSnapshot returns the current storage stats of the contract without consuming gas
+func (k Keeper) storageStatsSnapshot(ctx sdk.Context, contractAddr sdk.AccAddress) types.ContractStorageStats {
+	return k.GetContractStorageStats(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), contractAddr)
+}
+
+// settleStorageDeposit persists the storage stats of a contract call and applies the storage deposit policy for the
+// changes
+func (k Keeper) settleStorageDeposit(ctx sdk.Context, contractAddr sdk.AccAddress, before types.ContractStorageStats, store *types.StoreAdapter) error {
+	store.Flush()
+	after := k.storageStatsSnapshot(ctx, contractAddr)
+	if after.TotalBytes == before.TotalBytes {
+		return nil
+	}
+	return k.storageDepositPolicy.OnStorageChange(ctx, contractAddr, before, after)
+}
@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"testing"
+
+	wasmvm "github.com/CosmWasm/wasmvm/v2"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper/wasmtesting"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestParamsStorageDepositPolicy(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithParamsStorageDepositPolicy())
+	k := keepers.WasmKeeper
+	params := k.GetParams(parentCtx)
+	params.StorageDepositPerByte = sdk.NewCoins(sdk.NewInt64Coin("denom", 2))
+	require.NoError(t, k.SetParams(parentCtx, params))
+
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	myStoreOp := func(op func(store wasmvm.KVStore)) {
+		mock.ExecuteFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+			op(store)
+			return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 0, nil
+		}
+	}
+	execute := func(ctx sdk.Context) error {
+		_, err := keepers.ContractKeeper.Execute(ctx, example.Contract, example.CreatorAddr, []byte(`{}`), nil)
+		return err
+	}
+	balance := func(ctx sdk.Context, addr sdk.AccAddress) int64 {
+		return keepers.BankKeeper.GetBalance(ctx, addr, "denom").Amount.Int64()
+	}
+
+	// when storing 10 bytes without funds
+	myStoreOp(func(store wasmvm.KVStore) { store.Set([]byte("a"), []byte("123456789")) })
+	ctx, _ := parentCtx.CacheContext()
+	err := execute(ctx)
+	// then
+	require.ErrorIs(t, err, types.ErrStorageDeposit)
+
+	// when storing 10 bytes with funds
+	keepers.Faucet.Fund(parentCtx, example.Contract, sdk.NewInt64Coin("denom", 100))
+	ctx, _ = parentCtx.CacheContext()
+	require.NoError(t, execute(ctx))
+	// then deposit is charged
+	assert.Equal(t, int64(80), balance(ctx, example.Contract))
+	assert.Equal(t, int64(20), balance(ctx, StorageDepositEscrowAddress))
+
+	// and when storage shrinks
+	myStoreOp(func(store wasmvm.KVStore) { store.Set([]byte("a"), []byte("1234")) })
+	require.NoError(t, execute(ctx))
+	// then deposit is partially refunded
+	assert.Equal(t, int64(90), balance(ctx, example.Contract))
+	assert.Equal(t, int64(10), balance(ctx, StorageDepositEscrowAddress))
+
+	// and when deleted
+	myStoreOp(func(store wasmvm.KVStore) { store.Delete([]byte("a")) })
+	require.NoError(t, execute(ctx))
+	// then deposit is refunded
+	assert.Equal(t, int64(100), balance(ctx, example.Contract))
+	assert.Equal(t, int64(0), balance(ctx, StorageDepositEscrowAddress))
+	assert.True(t, k.GetContractStorageDeposit(ctx, example.Contract).IsZero())
+
+	// and when storing 10 bytes again
+	myStoreOp(func(store wasmvm.KVStore) { store.Set([]byte("a"), []byte("123456789")) })
+	require.NoError(t, execute(ctx))
+	// and the rate is raised before the storage shrinks
+	params.StorageDepositPerByte = sdk.NewCoins(sdk.NewInt64Coin("denom", 5))
+	require.NoError(t, k.SetParams(ctx, params))
+	myStoreOp(func(store wasmvm.KVStore) { store.Set([]byte("a"), []byte("1234")) })
+	require.NoError(t, execute(ctx))
+	// then the deposit is refunded proportionally and not with the new rate
+	assert.Equal(t, int64(90), balance(ctx, example.Contract))
+	assert.Equal(t, int64(10), balance(ctx, StorageDepositEscrowAddress))
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom", 10)), k.GetContractStorageDeposit(ctx, example.Contract))
+}
+
+func TestParamsStorageDepositPolicyWithoutDeposit(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities, WithParamsStorageDepositPolicy())
+	k := keepers.WasmKeeper
+
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	myStoreOp := func(op func(store wasmvm.KVStore)) {
+		mock.ExecuteFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+			op(store)
+			return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 0, nil
+		}
+	}
+	// state stored while the rate is zero
+	myStoreOp(func(store wasmvm.KVStore) { store.Set([]byte("a"), []byte("123456789")) })
+	_, err := keepers.ContractKeeper.Execute(parentCtx, example.Contract, example.CreatorAddr, []byte(`{}`), nil)
+	require.NoError(t, err)
+	params := k.GetParams(parentCtx)
+	params.StorageDepositPerByte = sdk.NewCoins(sdk.NewInt64Coin("denom", 2))
+	require.NoError(t, k.SetParams(parentCtx, params))
+
+	// when deleted
+	myStoreOp(func(store wasmvm.KVStore) { store.Delete([]byte("a")) })
+	_, err = keepers.ContractKeeper.Execute(parentCtx, example.Contract, example.CreatorAddr, []byte(`{}`), nil)
+	// then
+	require.NoError(t, err)
+	assert.True(t, keepers.BankKeeper.GetAllBalances(parentCtx, example.Contract).IsZero())
+
+	// and when stored again and purged
+	myStoreOp(func(store wasmvm.KVStore) { store.Set([]byte("a"), []byte("123456789")) })
+	keepers.Faucet.Fund(parentCtx, example.Contract, sdk.NewInt64Coin("denom", 100))
+	_, err = keepers.ContractKeeper.Execute(parentCtx, example.Contract, example.CreatorAddr, []byte(`{}`), nil)
+	require.NoError(t, err)
+	beneficiary := RandomAccountAddress(t)
+	_, err = k.purgeContract(parentCtx, example.Contract, example.CreatorAddr, beneficiary, true, DefaultAuthorizationPolicy{})
+	// then the deposit is refunded to the beneficiary
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), keepers.BankKeeper.GetBalance(parentCtx, beneficiary, "denom").Amount.Int64())
+	assert.True(t, keepers.BankKeeper.GetAllBalances(parentCtx, StorageDepositEscrowAddress).IsZero())
+	assert.True(t, k.GetContractStorageDeposit(parentCtx, example.Contract).IsZero())
+}
+
+func TestNoopStorageDepositPolicy(t *testing.T) {
+	parentCtx, keepers := CreateTestInput(t, false, AvailableCapabilities)
+	k := keepers.WasmKeeper
+	params := k.GetParams(parentCtx)
+	params.StorageDepositPerByte = sdk.NewCoins(sdk.NewInt64Coin("denom", 2))
+	require.NoError(t, k.SetParams(parentCtx, params))
+
+	var mock wasmtesting.MockWasmEngine
+	wasmtesting.MakeInstantiable(&mock)
+	example := SeedNewContractInstance(t, parentCtx, keepers, &mock)
+	mock.ExecuteFn = func(codeID wasmvm.Checksum, env wasmvmtypes.Env, info wasmvmtypes.MessageInfo, executeMsg []byte, store wasmvm.KVStore, goapi wasmvm.GoAPI, querier wasmvm.Querier, gasMeter wasmvm.GasMeter, gasLimit uint64, deserCost wasmvmtypes.UFraction) (*wasmvmtypes.ContractResult, uint64, error) {
+		store.Set([]byte("a"), []byte("123456789"))
+		return &wasmvmtypes.ContractResult{Ok: &wasmvmtypes.Response{}}, 0, nil
+	}
+
+	// when
+	_, err := keepers.ContractKeeper.Execute(parentCtx, example.Contract, example.CreatorAddr, []byte(`{}`), nil)
+	// then
+	require.NoError(t, err)
+	assert.True(t, keepers.BankKeeper.GetAllBalances(parentCtx, StorageDepositEscrowAddress).IsZero())
+}
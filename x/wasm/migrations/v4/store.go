@@ -0,0 +1,43 @@
+package v4
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// StoreStorageStatsFn stores the storage stats of a contract
+type StoreStorageStatsFn func(ctx context.Context, contractAddress sdk.AccAddress, stats types.ContractStorageStats)
+
+// Keeper abstract keeper
+type wasmKeeper interface {
+	IterateContractInfo(ctx context.Context, cb func(sdk.AccAddress, types.ContractInfo) bool)
+	IterateContractState(ctx context.Context, contractAddress sdk.AccAddress, cb func(key, value []byte) bool)
+}
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper              wasmKeeper
+	storeStorageStatsFn StoreStorageStatsFn
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k wasmKeeper, fn StoreStorageStatsFn) Migrator {
+	return Migrator{keeper: k, storeStorageStatsFn: fn}
+}
+
+// Migrate4to5 migrates from version 4 to 5. The storage stats are calculated for all existing contracts.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	m.keeper.IterateContractInfo(ctx, func(contractAddr sdk.AccAddress, _ types.ContractInfo) bool {
+		var stats types.ContractStorageStats
+		m.keeper.IterateContractState(ctx, contractAddr, func(key, value []byte) bool {
+			stats.Add(key, value)
+			return false
+		})
+		m.storeStorageStatsFn(ctx, contractAddr, stats)
+		return false
+	})
+	return nil
+}
@@ -0,0 +1,40 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestMigrate4To5(t *testing.T) {
+	AvailableCapabilities := []string{"iterator", "staking", "stargate", "cosmwasm_1_1"}
+	ctx, keepers := keeper.CreateTestInput(t, false, AvailableCapabilities)
+	wasmKeeper := keepers.WasmKeeper
+
+	example1 := keeper.InstantiateHackatomExampleContract(t, ctx, keepers)
+	example2 := keeper.InstantiateHackatomExampleContract(t, ctx, keepers)
+
+	stats1 := wasmKeeper.GetContractStorageStats(ctx, example1.Contract)
+	require.NotZero(t, stats1.KeyCount)
+
+	// remove stats
+	ctx.KVStore(keepers.WasmStoreKey).Delete(types.GetContractStorageStatsKey(example1.Contract))
+	ctx.KVStore(keepers.WasmStoreKey).Delete(types.GetContractStorageStatsKey(example2.Contract))
+
+	// migrator
+	err := keeper.NewMigrator(*wasmKeeper, nil).Migrate4to5(ctx)
+	require.NoError(t, err)
+
+	// check new store
+	assert.Equal(t, stats1, wasmKeeper.GetContractStorageStats(ctx, example1.Contract))
+	var exp types.ContractStorageStats
+	wasmKeeper.IterateContractState(ctx, example2.Contract, func(key, value []byte) bool {
+		exp.Add(key, value)
+		return false
+	})
+	assert.Equal(t, exp, wasmKeeper.GetContractStorageStats(ctx, example2.Contract))
+}
@@ -0,0 +1,38 @@
+package v5
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// AddToAdminIndexFn creates the secondary index entries for the admins of the contract
+type AddToAdminIndexFn func(ctx context.Context, contractAddress sdk.AccAddress, admins types.AdminSet) error
+
+// Keeper abstract keeper
+type wasmKeeper interface {
+	IterateContractInfo(ctx context.Context, cb func(sdk.AccAddress, types.ContractInfo) bool)
+}
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper            wasmKeeper
+	addToAdminIndexFn AddToAdminIndexFn
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k wasmKeeper, fn AddToAdminIndexFn) Migrator {
+	return Migrator{keeper: k, addToAdminIndexFn: fn}
+}
+
+// Migrate5to6 migrates from version 5 to 6. The contracts by admin index is built for all existing contracts.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	var err error
+	m.keeper.IterateContractInfo(ctx, func(contractAddr sdk.AccAddress, contractInfo types.ContractInfo) bool {
+		err = m.addToAdminIndexFn(ctx, contractAddr, contractInfo.Admins())
+		return err != nil
+	})
+	return err
+}
@@ -0,0 +1,40 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestMigrate5To6(t *testing.T) {
+	AvailableCapabilities := []string{"iterator", "staking", "stargate", "cosmwasm_1_1"}
+	ctx, keepers := keeper.CreateTestInput(t, false, AvailableCapabilities)
+	wasmKeeper := keepers.WasmKeeper
+
+	example1 := keeper.InstantiateHackatomExampleContract(t, ctx, keepers)
+	example2 := keeper.InstantiateHackatomExampleContract(t, ctx, keepers)
+	admin := example1.CreatorAddr
+
+	// remove index entries
+	ctx.KVStore(keepers.WasmStoreKey).Delete(types.GetContractByAdminSecondaryIndexKey(admin, example1.Contract))
+	ctx.KVStore(keepers.WasmStoreKey).Delete(types.GetContractByAdminSecondaryIndexKey(example2.CreatorAddr, example2.Contract))
+
+	// migrator
+	err := keeper.NewMigrator(*wasmKeeper, nil).Migrate5to6(ctx)
+	require.NoError(t, err)
+
+	// check new store
+	var got []sdk.AccAddress
+	wasmKeeper.IterateContractsByAdmin(ctx, admin, func(address sdk.AccAddress) bool {
+		got = append(got, address)
+		return false
+	})
+	assert.Equal(t, []sdk.AccAddress{example1.Contract}, got)
+	assert.True(t, ctx.KVStore(keepers.WasmStoreKey).Has(types.GetContractByAdminSecondaryIndexKey(example2.CreatorAddr, example2.Contract)))
+}
@@ -0,0 +1,38 @@
+package v6
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// AddToLabelIndexFn creates the secondary index entry for the label of the contract
+type AddToLabelIndexFn func(ctx context.Context, contractAddress sdk.AccAddress, contractInfo *types.ContractInfo) error
+
+// Keeper abstract keeper
+type wasmKeeper interface {
+	IterateContractInfo(ctx context.Context, cb func(sdk.AccAddress, types.ContractInfo) bool)
+}
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper            wasmKeeper
+	addToLabelIndexFn AddToLabelIndexFn
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k wasmKeeper, fn AddToLabelIndexFn) Migrator {
+	return Migrator{keeper: k, addToLabelIndexFn: fn}
+}
+
+// Migrate6to7 migrates from version 6 to 7. The contracts by label index is built for all existing contracts.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	var err error
+	m.keeper.IterateContractInfo(ctx, func(contractAddr sdk.AccAddress, contractInfo types.ContractInfo) bool {
+		err = m.addToLabelIndexFn(ctx, contractAddr, &contractInfo)
+		return err != nil
+	})
+	return err
+}
@@ -0,0 +1,31 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestMigrate6To7(t *testing.T) {
+	AvailableCapabilities := []string{"iterator", "staking", "stargate", "cosmwasm_1_1"}
+	ctx, keepers := keeper.CreateTestInput(t, false, AvailableCapabilities)
+	wasmKeeper := keepers.WasmKeeper
+
+	example := keeper.InstantiateHackatomExampleContract(t, ctx, keepers)
+	label := wasmKeeper.GetContractInfo(ctx, example.Contract).Label
+	indexKey := types.GetContractByLabelSecondaryIndexKey(label, example.CreatorAddr, example.Contract)
+
+	// remove index entry
+	ctx.KVStore(keepers.WasmStoreKey).Delete(indexKey)
+
+	// migrator
+	err := keeper.NewMigrator(*wasmKeeper, nil).Migrate6to7(ctx)
+	require.NoError(t, err)
+
+	// check new store
+	assert.True(t, ctx.KVStore(keepers.WasmStoreKey).Has(indexKey))
+}
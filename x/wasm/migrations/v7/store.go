@@ -0,0 +1,39 @@
+package v7
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// AddToCodeIndexesFn creates the secondary index entries for the checksum and creator of the code
+type AddToCodeIndexesFn func(ctx context.Context, codeID uint64, codeInfo types.CodeInfo) error
+
+// Keeper abstract keeper
+type wasmKeeper interface {
+	IterateCodeInfos(ctx context.Context, cb func(uint64, types.CodeInfo) bool)
+}
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper             wasmKeeper
+	addToCodeIndexesFn AddToCodeIndexesFn
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k wasmKeeper, fn AddToCodeIndexesFn) Migrator {
+	return Migrator{keeper: k, addToCodeIndexesFn: fn}
+}
+
+// Migrate7to8 migrates from version 7 to 8. The codes by checksum and codes by creator indexes are built for all
+// existing codes.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	var err error
+	m.keeper.IterateCodeInfos(ctx, func(codeID uint64, codeInfo types.CodeInfo) bool {
+		err = m.addToCodeIndexesFn(ctx, codeID, codeInfo)
+		return err != nil
+	})
+	return err
+}
@@ -0,0 +1,33 @@
+package v7_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+func TestMigrate7To8(t *testing.T) {
+	AvailableCapabilities := []string{"iterator", "staking", "stargate", "cosmwasm_1_1"}
+	ctx, keepers := keeper.CreateTestInput(t, false, AvailableCapabilities)
+	wasmKeeper := keepers.WasmKeeper
+
+	example := keeper.StoreHackatomExampleContract(t, ctx, keepers)
+	checksumKey := types.GetCodeByChecksumSecondaryIndexKey(example.Checksum, example.CodeID)
+	creatorKey := types.GetCodeByCreatorSecondaryIndexKey(example.CreatorAddr, example.CodeID)
+
+	// remove index entries
+	ctx.KVStore(keepers.WasmStoreKey).Delete(checksumKey)
+	ctx.KVStore(keepers.WasmStoreKey).Delete(creatorKey)
+
+	// migrator
+	err := keeper.NewMigrator(*wasmKeeper, nil).Migrate7to8(ctx)
+	require.NoError(t, err)
+
+	// check new store
+	assert.True(t, ctx.KVStore(keepers.WasmStoreKey).Has(checksumKey))
+	assert.True(t, ctx.KVStore(keepers.WasmStoreKey).Has(creatorKey))
+}
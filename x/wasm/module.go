@@ -43,6 +43,9 @@ const (
 	flagWasmQueryGasLimit          = "wasm.query_gas_limit"
 	flagWasmSimulationGasLimit     = "wasm.simulation_gas_limit"
 	flagWasmSkipWasmVMVersionCheck = "wasm.skip_wasmvm_version_check"
+	flagWasmEventIndex             = "wasm.event_index"
+	flagWasmContractDebugSink      = "wasm.contract_debug_sink"
+	flagWasmContractDebugGRPCAddr  = "wasm.contract_debug_grpc_address"
 )
 
 // AppModuleBasic defines the basic application module used by the wasm module.
@@ -57,6 +60,10 @@ func (b AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, serv
 	if err != nil {
 		panic(err)
 	}
+	err = types.RegisterEventIndexHandlerClient(context.Background(), serveMux, types.NewEventIndexClient(clientCtx))
+	if err != nil {
+		panic(err)
+	}
 }
 
 // Name returns the wasm module's name.
@@ -98,7 +105,10 @@ func (b AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry)
 }
 
 // ____________________________________________________________________________
-var _ appmodule.AppModule = AppModule{}
+var (
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
+)
 
 // AppModule implements an application module for the wasm module.
 type AppModule struct {
@@ -144,7 +154,7 @@ func (am AppModule) IsAppModule() { // marker
 // module. It should be incremented on each consensus-breaking change
 // introduced by the module. To avoid wrong/empty versions, the initial version
 // should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 4 }
+func (AppModule) ConsensusVersion() uint64 { return 8 }
 
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
@@ -163,6 +173,27 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(err)
 	}
+	err = cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5)
+	if err != nil {
+		panic(err)
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6)
+	if err != nil {
+		panic(err)
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6to7)
+	if err != nil {
+		panic(err)
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 7, m.Migrate7to8)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// EndBlock runs the scheduled contract calls and callbacks and deletes the remaining state of purged contracts
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return keeper.EndBlocker(ctx, am.keeper)
 }
 
 // RegisterInvariants registers the wasm module invariants.
@@ -206,8 +237,9 @@ func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.Wei
 	return simulation.ProposalMsgs(am.bankKeeper, am.keeper)
 }
 
-// RegisterStoreDecoder registers a decoder for supply module's types
-func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {
+// RegisterStoreDecoder registers a decoder for wasm module's types
+func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simulation.NewDecodeStore(am.cdc)
 }
 
 // WeightedOperations returns the all the gov module operations with their respective weights.
@@ -223,6 +255,9 @@ func AddModuleInitFlags(startCmd *cobra.Command) {
 	startCmd.Flags().Uint32(flagWasmMemoryCacheSize, defaults.MemoryCacheSize, "Sets the size in MiB (NOT bytes) of an in-memory cache for Wasm modules. Set to 0 to disable.")
 	startCmd.Flags().Uint64(flagWasmQueryGasLimit, defaults.SmartQueryGasLimit, "Set the max gas that can be spent on executing a query with a Wasm contract")
 	startCmd.Flags().String(flagWasmSimulationGasLimit, "", "Set the max gas that can be spent when executing a simulation TX")
+	startCmd.Flags().Bool(flagWasmEventIndex, defaults.EventIndex, "Index custom contract events in a database local to the node")
+	startCmd.Flags().String(flagWasmContractDebugSink, defaults.ContractDebugSink, "Set the sink of the contract call trees with --trace: stdout, file or memory. Contract prints are not routed to the sink")
+	startCmd.Flags().String(flagWasmContractDebugGRPCAddr, defaults.ContractDebugGRPCAddress, "Set the loopback address of the gRPC endpoint to tail the memory contract debug sink")
 	startCmd.Flags().Bool(flagWasmSkipWasmVMVersionCheck, false, "Skip check that ensures that libwasmvm version (the Rust project) and wasmvm version (the Go project) match")
 
 	preCheck := func(cmd *cobra.Command, _ []string) error {
@@ -262,6 +297,21 @@ func ReadWasmConfig(opts servertypes.AppOptions) (types.WasmConfig, error) {
 			cfg.SimulationGasLimit = &limit
 		}
 	}
+	if v := opts.Get(flagWasmEventIndex); v != nil {
+		if cfg.EventIndex, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagWasmContractDebugSink); v != nil {
+		if cfg.ContractDebugSink, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagWasmContractDebugGRPCAddr); v != nil {
+		if cfg.ContractDebugGRPCAddress, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
 	// attach contract debugging to global "trace" flag
 	if v := opts.Get(server.FlagTrace); v != nil {
 		if cfg.ContractDebugMode, err = cast.ToBoolE(v); err != nil {
@@ -502,6 +502,28 @@ func TestReadWasmConfig(t *testing.T) {
 				ContractDebugMode:  true,
 			},
 		},
+		"set event index via opts": {
+			src: AppOptionsMock{
+				"wasm.event_index": true,
+			},
+			exp: types.WasmConfig{
+				SmartQueryGasLimit: defaults.SmartQueryGasLimit,
+				MemoryCacheSize:    defaults.MemoryCacheSize,
+				EventIndex:         true,
+			},
+		},
+		"set contract debug sink via opts": {
+			src: AppOptionsMock{
+				"wasm.contract_debug_sink":         "memory",
+				"wasm.contract_debug_grpc_address": "127.0.0.1:9095",
+			},
+			exp: types.WasmConfig{
+				SmartQueryGasLimit:       defaults.SmartQueryGasLimit,
+				MemoryCacheSize:          defaults.MemoryCacheSize,
+				ContractDebugSink:        "memory",
+				ContractDebugGRPCAddress: "127.0.0.1:9095",
+			},
+		},
 		"all defaults when no options set": {
 			src: AppOptionsMock{},
 			exp: defaults,
@@ -512,15 +534,21 @@ func TestReadWasmConfig(t *testing.T) {
 		},
 		"custom config template values": {
 			src: withViper(types.ConfigTemplate(types.WasmConfig{
-				SimulationGasLimit: &one,
-				SmartQueryGasLimit: 2,
-				MemoryCacheSize:    3,
+				SimulationGasLimit:       &one,
+				SmartQueryGasLimit:       2,
+				MemoryCacheSize:          3,
+				EventIndex:               true,
+				ContractDebugSink:        "file",
+				ContractDebugGRPCAddress: "127.0.0.1:9095",
 			})),
 			exp: types.WasmConfig{
-				SimulationGasLimit: &one,
-				SmartQueryGasLimit: 2,
-				MemoryCacheSize:    3,
-				ContractDebugMode:  false,
+				SimulationGasLimit:       &one,
+				SmartQueryGasLimit:       2,
+				MemoryCacheSize:          3,
+				ContractDebugMode:        false,
+				EventIndex:               true,
+				ContractDebugSink:        "file",
+				ContractDebugGRPCAddress: "127.0.0.1:9095",
 			},
 		},
 	}
@@ -0,0 +1,52 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/CosmWasm/wasmd/x/wasm/cwstorage"
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's values to the corresponding wasm
+// types. Contract state is decoded as cw-storage-plus entries.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.HasPrefix(kvA.Key, types.CodeKeyPrefix):
+			var codeA, codeB types.CodeInfo
+			cdc.MustUnmarshal(kvA.Value, &codeA)
+			cdc.MustUnmarshal(kvB.Value, &codeB)
+			return fmt.Sprintf("%v\n%v", codeA, codeB)
+
+		case bytes.HasPrefix(kvA.Key, types.ContractKeyPrefix):
+			var contractA, contractB types.ContractInfo
+			cdc.MustUnmarshal(kvA.Value, &contractA)
+			cdc.MustUnmarshal(kvB.Value, &contractB)
+			return fmt.Sprintf("%v\n%v", contractA, contractB)
+
+		case bytes.HasPrefix(kvA.Key, types.ContractStorePrefix) && len(kvA.Key) > len(types.ContractStorePrefix)+types.ContractAddrLen:
+			keyOffset := len(types.ContractStorePrefix) + types.ContractAddrLen
+			return fmt.Sprintf("%s\n%s", decodeContractState(kvA, keyOffset), decodeContractState(kvB, keyOffset))
+
+		case bytes.HasPrefix(kvA.Key, types.SequenceKeyPrefix):
+			return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+		default:
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+		}
+	}
+}
+
+func decodeContractState(pair kv.Pair, keyOffset int) string {
+	bz, err := json.Marshal(cwstorage.Decode(pair.Key[keyOffset:], pair.Value))
+	if err != nil {
+		panic(err)
+	}
+	return string(bz)
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic performs stateless validation of the admin set
+func (a AdminSet) ValidateBasic() error {
+	if err := validateBech32Addresses(a.Members); err != nil {
+		return errorsmod.Wrap(err, "members")
+	}
+	if a.Threshold == 0 || int(a.Threshold) > len(a.Members) {
+		return errorsmod.Wrap(ErrInvalid, "threshold")
+	}
+	return nil
+}
+
+// Contains returns true when the address is a member of the set
+func (a AdminSet) Contains(addr sdk.AccAddress) bool {
+	return containsAddress(a.Members, addr)
+}
+
+// ValidateBasic performs stateless validation of the migration approval
+func (m MigrationApproval) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if m.CodeID == 0 {
+		return errorsmod.Wrap(ErrEmpty, "code id")
+	}
+	if err := m.Msg.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "payload msg")
+	}
+	if err := validateBech32Addresses(m.Approvals); err != nil {
+		return errorsmod.Wrap(err, "approvals")
+	}
+	return nil
+}
+
+// HasApproved returns true when the address approved the migration before
+func (m MigrationApproval) HasApproved(addr sdk.AccAddress) bool {
+	return containsAddress(m.Approvals, addr)
+}
+
+// ProposalID returns the identifier of the proposed migration
+func (m MigrationApproval) ProposalID() []byte {
+	return MigrationProposalID(m.CodeID, m.Msg)
+}
+
+// IsExpired returns true when the block time in unix nanoseconds is after the expiry
+func (m MigrationApproval) IsExpired(blockTime uint64) bool {
+	return blockTime > m.ExpiresAt
+}
+
+// MigrationProposalID returns the identifier of a migration to the code id with the msg. Approvals for the same
+// contract are stored per proposal.
+func MigrationProposalID(codeID uint64, msg RawContractMessage) []byte {
+	h := sha256.New()
+	h.Write(sdk.Uint64ToBigEndian(codeID))
+	h.Write(msg)
+	return h.Sum(nil)
+}
+
+func containsAddress(addrs []string, addr sdk.AccAddress) bool {
+	for _, v := range addrs {
+		if a, err := sdk.AccAddressFromBech32(v); err == nil && a.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAdminSetValidateBasic(t *testing.T) {
+	memberA := sdk.AccAddress(make([]byte, 20)).String()
+	memberB := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String()
+	specs := map[string]struct {
+		src    AdminSet
+		expErr bool
+	}{
+		"all good": {
+			src: AdminSet{Members: []string{memberA, memberB}, Threshold: 2},
+		},
+		"no members": {
+			src:    AdminSet{Threshold: 1},
+			expErr: true,
+		},
+		"invalid member": {
+			src:    AdminSet{Members: []string{memberA, badAddress}, Threshold: 1},
+			expErr: true,
+		},
+		"duplicate member": {
+			src:    AdminSet{Members: []string{memberA, memberA}, Threshold: 1},
+			expErr: true,
+		},
+		"zero threshold": {
+			src:    AdminSet{Members: []string{memberA}},
+			expErr: true,
+		},
+		"threshold exceeds members": {
+			src:    AdminSet{Members: []string{memberA, memberB}, Threshold: 3},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestContractInfoAdmins(t *testing.T) {
+	admin := sdk.AccAddress(make([]byte, 20))
+	other := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20))
+	specs := map[string]struct {
+		src       ContractInfo
+		expAdmins AdminSet
+		expMember bool
+	}{
+		"no admin": {
+			src: ContractInfoFixture(),
+		},
+		"single admin": {
+			src:       ContractInfoFixture(func(c *ContractInfo) { c.Admin = admin.String() }),
+			expAdmins: AdminSet{Members: []string{admin.String()}, Threshold: 1},
+			expMember: true,
+		},
+		"admin set": {
+			src: ContractInfoFixture(func(c *ContractInfo) {
+				c.AdminSet = &AdminSet{Members: []string{other.String(), admin.String()}, Threshold: 2}
+			}),
+			expAdmins: AdminSet{Members: []string{other.String(), admin.String()}, Threshold: 2},
+			expMember: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			got := spec.src.Admins()
+			assert.Equal(t, spec.expAdmins, got)
+			assert.Equal(t, spec.expMember, got.Contains(admin))
+		})
+	}
+}
@@ -28,8 +28,15 @@ const (
 type AuthorizationPolicy interface {
 	CanCreateCode(chainConfigs ChainAccessConfigs, actor types.AccAddress, contractConfig AccessConfig) bool
 	CanInstantiateContract(c AccessConfig, actor types.AccAddress) bool
+	CanExecuteContract(c AccessConfig, actor types.AccAddress) bool
 	CanModifyContract(admin, actor types.AccAddress) bool
 	CanModifyCodeAccessConfig(creator, actor types.AccAddress, isSubset bool) bool
 	// SubMessageAuthorizationPolicy returns authorization policy to be used for submessages. Must never be nil
 	SubMessageAuthorizationPolicy(entrypoint AuthorizationPolicyAction) AuthorizationPolicy
 }
+
+// AdminSetAuthorizationPolicy is an optional extension of an AuthorizationPolicy for contracts with an admin set.
+// Policies without this extension do not authorize any modification of such contracts.
+type AdminSetAuthorizationPolicy interface {
+	CanModifyContractWithAdminSet(admins AdminSet, actor types.AccAddress) bool
+}
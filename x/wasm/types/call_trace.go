@@ -0,0 +1,195 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// contract entrypoints of a call trace
+const (
+	TraceEntrypointInstantiate            = "instantiate"
+	TraceEntrypointExecute                = "execute"
+	TraceEntrypointMigrate                = "migrate"
+	TraceEntrypointSudo                   = "sudo"
+	TraceEntrypointReply                  = "reply"
+	TraceEntrypointQuery                  = "query"
+	TraceEntrypointIBCChannelOpen         = "ibc_channel_open"
+	TraceEntrypointIBCChannelConnect      = "ibc_channel_connect"
+	TraceEntrypointIBCChannelClose        = "ibc_channel_close"
+	TraceEntrypointIBCPacketReceive       = "ibc_packet_receive"
+	TraceEntrypointIBCPacketAck           = "ibc_packet_ack"
+	TraceEntrypointIBCPacketTimeout       = "ibc_packet_timeout"
+	TraceEntrypointIBCSourceCallback      = "ibc_source_callback"
+	TraceEntrypointIBCDestinationCallback = "ibc_destination_callback"
+)
+
+// TracedCall is a call of a contract entrypoint
+type TracedCall struct {
+	Entrypoint string
+	// Caller is the sender of the message. It is not set for calls that are not made by an account, like sudo,
+	// reply, IBC or query calls.
+	Caller sdk.AccAddress
+	// Callee is the contract address. It is not set on entry of an instantiation.
+	Callee sdk.AccAddress
+	// CodeID is the code of the callee
+	CodeID uint64
+	Msg    []byte
+}
+
+// CallTracer is notified on entry and exit of every contract entrypoint call. The calls of other contracts that are
+// made via sub-messages, replies or queries are entered and exited between the entry and exit of the calling
+// entrypoint.
+type CallTracer interface {
+	// OnEnter is called before the entrypoint is run. The returned context is used for the call so that the tracer
+	// can keep state per call.
+	OnEnter(ctx sdk.Context, call TracedCall) sdk.Context
+	// OnExit is called with the context returned by OnEnter when the entrypoint returns. The error is not redacted.
+	// For instantiations, the callee is the new contract address.
+	OnExit(ctx sdk.Context, callee sdk.AccAddress, result []byte, err error)
+}
+
+// CallTrace is a node of the call tree of a contract call. The gas used includes the gas of the children.
+type CallTrace struct {
+	Entrypoint string          `json:"entrypoint"`
+	Caller     string          `json:"caller,omitempty"`
+	Callee     string          `json:"callee,omitempty"`
+	CodeID     uint64          `json:"code_id,omitempty"`
+	Msg        json.RawMessage `json:"msg,omitempty"`
+	GasUsed    uint64          `json:"gas_used"`
+	Result     []byte          `json:"result,omitempty"`
+	Error      string          `json:"error,omitempty"`
+	Children   []*CallTrace    `json:"children,omitempty"`
+}
+
+// DebugSink receives the contract debug output. Records are written one at a time in the order of their sequence.
+type DebugSink interface {
+	Write(record DebugRecord) error
+}
+
+var _ CallTracer = &CallTraceExporter{}
+
+// CallTraceExporter is a call tracer that builds the call tree of each top level contract call and writes it as JSON
+// to the debug sink when the call returns. It is safe for concurrent calls.
+type CallTraceExporter struct {
+	mx       sync.Mutex
+	sink     DebugSink
+	sequence uint64
+}
+
+// NewCallTraceExporter constructor
+func NewCallTraceExporter(sink DebugSink) *CallTraceExporter {
+	if sink == nil {
+		panic("sink must not be nil")
+	}
+	return &CallTraceExporter{sink: sink}
+}
+
+type callTraceFrame struct {
+	node   *CallTrace
+	parent *callTraceFrame
+	meter  storetypes.GasMeter
+	start  storetypes.Gas
+}
+
+// OnEnter adds a node for the call to the call tree of the context
+func (e *CallTraceExporter) OnEnter(ctx sdk.Context, call TracedCall) sdk.Context {
+	node := &CallTrace{Entrypoint: call.Entrypoint, CodeID: call.CodeID, Msg: traceMsg(call.Msg)}
+	if call.Caller != nil {
+		node.Caller = call.Caller.String()
+	}
+	if call.Callee != nil {
+		node.Callee = call.Callee.String()
+	}
+	parent, _ := ctx.Value(contextKeyCallTrace).(*callTraceFrame)
+	if parent != nil {
+		parent.node.Children = append(parent.node.Children, node)
+	}
+	frame := &callTraceFrame{node: node, parent: parent, meter: ctx.GasMeter(), start: ctx.GasMeter().GasConsumed()}
+	return ctx.WithValue(contextKeyCallTrace, frame)
+}
+
+// OnExit completes the node of the call. The call tree is written when the top level call returns, tagged with the
+// contract and code of the call, the block height, the tx hash and the exec mode. Calls are traced in all exec modes,
+// so that the same call can be written for the mempool checks, a simulation and the block.
+func (e *CallTraceExporter) OnExit(ctx sdk.Context, callee sdk.AccAddress, result []byte, err error) {
+	frame, ok := ctx.Value(contextKeyCallTrace).(*callTraceFrame)
+	if !ok {
+		return
+	}
+	node := frame.node
+	if callee != nil {
+		node.Callee = callee.String()
+	}
+	if consumed := frame.meter.GasConsumed(); consumed > frame.start {
+		node.GasUsed = consumed - frame.start
+	}
+	node.Result = result
+	if err != nil {
+		node.Error = err.Error()
+	}
+	if frame.parent != nil {
+		return
+	}
+	setNestedCallers(node)
+	record := DebugRecord{
+		Height:          ctx.BlockHeight(),
+		ContractAddress: node.Callee,
+		CodeID:          node.CodeID,
+		Output:          mustMarshalJSON(node),
+		ExecMode:        execModeName(ctx.ExecMode()),
+	}
+	if len(ctx.TxBytes()) != 0 {
+		record.TxHash = fmt.Sprintf("%X", cmttypes.Tx(ctx.TxBytes()).Hash())
+	}
+	e.mx.Lock()
+	defer e.mx.Unlock()
+	e.sequence++
+	record.Sequence = e.sequence
+	if err := e.sink.Write(record); err != nil {
+		ctx.Logger().Error("failed to write contract debug output", "error", err)
+	}
+}
+
+var execModeNames = map[sdk.ExecMode]string{
+	sdk.ExecModeCheck:               "check",
+	sdk.ExecModeReCheck:             "recheck",
+	sdk.ExecModeSimulate:            "simulate",
+	sdk.ExecModePrepareProposal:     "prepare_proposal",
+	sdk.ExecModeProcessProposal:     "process_proposal",
+	sdk.ExecModeVoteExtension:       "vote_extension",
+	sdk.ExecModeVerifyVoteExtension: "verify_vote_extension",
+	sdk.ExecModeFinalize:            "finalize",
+}
+
+func execModeName(m sdk.ExecMode) string {
+	if name, ok := execModeNames[m]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", m)
+}
+
+// setNestedCallers sets the caller of nested calls without sender to the calling contract. This is done when the
+// tree is complete, as the address of an instantiated contract is only known on exit.
+func setNestedCallers(node *CallTrace) {
+	for _, c := range node.Children {
+		if c.Caller == "" {
+			c.Caller = node.Callee
+		}
+		setNestedCallers(c)
+	}
+}
+
+// traceMsg returns the message when it is JSON, otherwise the bytes as JSON string
+func traceMsg(msg []byte) json.RawMessage {
+	if len(msg) == 0 || json.Valid(msg) {
+		return msg
+	}
+	return mustMarshalJSON(msg)
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCallTraceExporter(t *testing.T) {
+	myContract, otherContract, sender := sdk.AccAddress("myContract"), sdk.AccAddress("otherContract"), sdk.AccAddress("sender")
+	var sink recordingDebugSink
+	e := NewCallTraceExporter(&sink)
+	ctx := sdk.Context{}.WithContext(context.Background()).
+		WithBlockHeight(7).
+		WithTxBytes([]byte("myTx")).
+		WithExecMode(sdk.ExecModeFinalize).
+		WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	// when
+	execCtx := e.OnEnter(ctx, TracedCall{Entrypoint: TraceEntrypointInstantiate, Caller: sender, CodeID: 1, Msg: []byte(`{"foo":"bar"}`)})
+	execCtx.GasMeter().ConsumeGas(10, "setup")
+	queryCtx := e.OnEnter(execCtx, TracedCall{Entrypoint: TraceEntrypointQuery, Callee: otherContract, CodeID: 2, Msg: []byte{0x1}})
+	queryCtx.GasMeter().ConsumeGas(5, "vm")
+	e.OnExit(queryCtx, otherContract, nil, errors.New("testing"))
+	assert.Empty(t, sink)
+	e.OnExit(execCtx, myContract, []byte("data"), nil)
+	simCtx := ctx.WithExecMode(sdk.ExecModeSimulate)
+	e.OnExit(e.OnEnter(simCtx, TracedCall{Entrypoint: TraceEntrypointExecute, Callee: otherContract, CodeID: 2}), otherContract, nil, nil)
+
+	// then
+	require.Len(t, sink, 2)
+	got := sink[0]
+	assert.Equal(t, uint64(1), got.Sequence)
+	assert.Equal(t, int64(7), got.Height)
+	assert.Equal(t, fmt.Sprintf("%X", cmttypes.Tx("myTx").Hash()), got.TxHash)
+	assert.Equal(t, myContract.String(), got.ContractAddress)
+	assert.Equal(t, uint64(1), got.CodeID)
+	assert.Equal(t, "finalize", got.ExecMode)
+	exp := `{
+	"entrypoint":"instantiate","caller":"` + sender.String() + `","callee":"` + myContract.String() + `","code_id":1,
+	"msg":{"foo":"bar"},"gas_used":15,"result":"ZGF0YQ==",
+	"children":[{
+		"entrypoint":"query","caller":"` + myContract.String() + `","callee":"` + otherContract.String() + `","code_id":2,
+		"msg":"AQ==","gas_used":5,"error":"testing"
+	}]
+}`
+	assert.JSONEq(t, exp, string(got.Output))
+	assert.Equal(t, uint64(2), sink[1].Sequence)
+	assert.Equal(t, otherContract.String(), sink[1].ContractAddress)
+	assert.Equal(t, "simulate", sink[1].ExecMode)
+}
+
+func TestCallTraceExporterWithoutEnter(t *testing.T) {
+	var sink recordingDebugSink
+	e := NewCallTraceExporter(&sink)
+	ctx := sdk.Context{}.WithContext(context.Background())
+	e.OnExit(ctx, sdk.AccAddress("myContract"), nil, nil)
+	assert.Empty(t, sink)
+}
+
+type recordingDebugSink []DebugRecord
+
+func (s *recordingDebugSink) Write(record DebugRecord) error {
+	*s = append(*s, record)
+	return nil
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxCallbackPayloadSize is the largest payload that a contract can store with a callback
+const MaxCallbackPayloadSize = 1024 // extension point for chains to customize via compile flag.
+
+// CallbackCustomMsg is the `CosmosMsg::Custom` payload that contracts send to schedule or cancel callbacks to themselves
+type CallbackCustomMsg struct {
+	ScheduleCallback *ScheduleCallbackMsg `json:"schedule_callback,omitempty"`
+	CancelCallback   *CancelCallbackMsg   `json:"cancel_callback,omitempty"`
+}
+
+// ScheduleCallbackMsg schedules a callback at a block height or time. Exactly one of both must be set.
+type ScheduleCallbackMsg struct {
+	// Height is the block height of the execution
+	Height uint64 `json:"height,omitempty"`
+	// Time is the earliest block time of the execution in unix nanoseconds
+	Time wasmvmtypes.Uint64 `json:"time,omitempty"`
+	// Payload is passed to the contract with the sudo message
+	Payload []byte `json:"payload,omitempty"`
+}
+
+// CancelCallbackMsg removes a pending callback
+type CancelCallbackMsg struct {
+	ID uint64 `json:"id"`
+}
+
+// ScheduleCallbackResponse is returned as data to the contract that scheduled the callback
+type ScheduleCallbackResponse struct {
+	ID uint64 `json:"id"`
+}
+
+// CallbackSudoMsg is the sudo message that the contract receives when the callback is executed
+type CallbackSudoMsg struct {
+	ScheduledCallback *ScheduledCallback `json:"scheduled_callback,omitempty"`
+}
+
+// ScheduledCallback contains the callback data for the contract
+type ScheduledCallback struct {
+	ID      uint64 `json:"id"`
+	Payload []byte `json:"payload,omitempty"`
+}
+
+// ValidateBasic performs stateless validation of the callback
+func (c Callback) ValidateBasic() error {
+	if c.ID == 0 {
+		return errorsmod.Wrap(ErrEmpty, "id")
+	}
+	if _, err := sdk.AccAddressFromBech32(c.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if (c.Height == 0) == (c.Time == 0) {
+		return errorsmod.Wrap(ErrInvalid, "either height or time must be set")
+	}
+	if len(c.Payload) > MaxCallbackPayloadSize {
+		return errorsmod.Wrapf(ErrLimit, "payload cannot be longer than %d bytes", MaxCallbackPayloadSize)
+	}
+	if err := c.Deposit.Validate(); err != nil {
+		return errorsmod.Wrap(err, "deposit")
+	}
+	return nil
+}
@@ -28,6 +28,19 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRemoveCodeUploadParamsAddresses{}, "wasm/MsgRemoveCodeUploadParamsAddresses", nil)
 	cdc.RegisterConcrete(&MsgStoreAndMigrateContract{}, "wasm/MsgStoreAndMigrateContract", nil)
 	cdc.RegisterConcrete(&MsgUpdateContractLabel{}, "wasm/MsgUpdateContractLabel", nil)
+	cdc.RegisterConcrete(&MsgFreezeContract{}, "wasm/MsgFreezeContract", nil)
+	cdc.RegisterConcrete(&MsgUnfreezeContract{}, "wasm/MsgUnfreezeContract", nil)
+	cdc.RegisterConcrete(&MsgSetCodeStatus{}, "wasm/MsgSetCodeStatus", nil)
+	cdc.RegisterConcrete(&MsgPurgeContract{}, "wasm/MsgPurgeContract", nil)
+	cdc.RegisterConcrete(&MsgAddCronSchedule{}, "wasm/MsgAddCronSchedule", nil)
+	cdc.RegisterConcrete(&MsgRemoveCronSchedule{}, "wasm/MsgRemoveCronSchedule", nil)
+	cdc.RegisterConcrete(&MsgSetFeeSponsorship{}, "wasm/MsgSetFeeSponsorship", nil)
+	cdc.RegisterConcrete(&MsgFundFeeSponsorship{}, "wasm/MsgFundFeeSponsorship", nil)
+	cdc.RegisterConcrete(&MsgRemoveFeeSponsorship{}, "wasm/MsgRemoveFeeSponsorship", nil)
+	cdc.RegisterConcrete(&MsgUpdateExecuteConfig{}, "wasm/MsgUpdateExecuteConfig", nil)
+	cdc.RegisterConcrete(&MsgUpdateAdminSet{}, "wasm/MsgUpdateAdminSet", nil)
+	cdc.RegisterConcrete(&MsgApproveMigration{}, "wasm/MsgApproveMigration", nil)
+	cdc.RegisterConcrete(&MsgSetContractMetadata{}, "wasm/MsgSetContractMetadata", nil)
 
 	cdc.RegisterInterface((*ContractInfoExtension)(nil), nil)
 
@@ -83,6 +96,19 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgRemoveCodeUploadParamsAddresses{},
 		&MsgStoreAndMigrateContract{},
 		&MsgUpdateContractLabel{},
+		&MsgFreezeContract{},
+		&MsgUnfreezeContract{},
+		&MsgSetCodeStatus{},
+		&MsgPurgeContract{},
+		&MsgAddCronSchedule{},
+		&MsgRemoveCronSchedule{},
+		&MsgSetFeeSponsorship{},
+		&MsgFundFeeSponsorship{},
+		&MsgRemoveFeeSponsorship{},
+		&MsgUpdateExecuteConfig{},
+		&MsgUpdateAdminSet{},
+		&MsgApproveMigration{},
+		&MsgSetContractMetadata{},
 	)
 	registry.RegisterInterface("cosmwasm.wasm.v1.ContractInfoExtension", (*ContractInfoExtension)(nil))
 
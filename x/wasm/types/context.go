@@ -18,6 +18,12 @@ const (
 	contextKeySubMsgAuthzPolicy = iota
 	// gas register
 	contextKeyGasRegister = iota
+	// gas profiler for simulations
+	contextKeyGasProfiler = iota
+	// recorder of contract calls in a dry run
+	contextKeyDryRunRecorder = iota
+	// call tree of contract calls of the call tracer
+	contextKeyCallTrace = iota
 
 	contextKeyCallDepth contextKey = iota
 )
@@ -81,3 +87,35 @@ func GasRegisterFromContext(ctx context.Context) (GasRegister, bool) {
 	val, ok := ctx.Value(contextKeyGasRegister).(GasRegister)
 	return val, ok
 }
+
+// WithGasProfiler stores the gas profiler into the context returned
+func WithGasProfiler(ctx sdk.Context, p *GasProfiler) sdk.Context {
+	if p == nil {
+		panic("gas profiler must not be nil")
+	}
+	return ctx.WithValue(contextKeyGasProfiler, p)
+}
+
+// GasProfilerFromContext reads the gas profiler from the context
+func GasProfilerFromContext(ctx context.Context) (*GasProfiler, bool) {
+	val, ok := ctx.Value(contextKeyGasProfiler).(*GasProfiler)
+	return val, ok
+}
+
+// WithDryRunRecorder stores the dry run recorder into the context returned
+func WithDryRunRecorder(ctx sdk.Context, r *DryRunRecorder) sdk.Context {
+	if r == nil {
+		panic("dry run recorder must not be nil")
+	}
+	return ctx.WithValue(contextKeyDryRunRecorder, r)
+}
+
+// DryRunRecorderFromContext reads the dry run recorder from the context. An sdk context without a base context, as
+// used by the message dispatcher in unit tests, has no recorder.
+func DryRunRecorderFromContext(ctx context.Context) (*DryRunRecorder, bool) {
+	if sdkCtx, ok := ctx.(sdk.Context); ok && sdkCtx.Context() == nil {
+		return nil, false
+	}
+	val, ok := ctx.Value(contextKeyDryRunRecorder).(*DryRunRecorder)
+	return val, ok
+}
@@ -0,0 +1,897 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmwasm/wasm/v1/contract_debug.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = proto.Marshal
+	_ = fmt.Errorf
+	_ = math.Inf
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TailDebugRecordsRequest is the request type for the ContractDebug/Tail RPC
+// method
+type TailDebugRecordsRequest struct {
+	// ContractAddress filters the records by the contract of the top level call
+	// when set
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// Follow keeps the stream open for new records
+	Follow bool `protobuf:"varint,2,opt,name=follow,proto3" json:"follow,omitempty"`
+}
+
+func (m *TailDebugRecordsRequest) Reset()         { *m = TailDebugRecordsRequest{} }
+func (m *TailDebugRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*TailDebugRecordsRequest) ProtoMessage()    {}
+func (*TailDebugRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_584d3b4850db247d, []int{0}
+}
+
+func (m *TailDebugRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *TailDebugRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TailDebugRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *TailDebugRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TailDebugRecordsRequest.Merge(m, src)
+}
+
+func (m *TailDebugRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *TailDebugRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TailDebugRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TailDebugRecordsRequest proto.InternalMessageInfo
+
+// DebugRecord is the debug output of a top level contract call.
+// Messages that contracts print with `deps.api.debug` are not part of the
+// output: wasmvm writes them to stdout and has no hook to capture them.
+type DebugRecord struct {
+	// Sequence is the position of the record in the output of the node
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Height is the block height
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// TxHash is the hex encoded hash of the transaction. Empty for calls that
+	// were made outside of a transaction, like queries.
+	TxHash string `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// ContractAddress is the address of the contract of the top level call
+	ContractAddress string `protobuf:"bytes,4,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// CodeID is the code of the contract of the top level call
+	CodeID uint64 `protobuf:"varint,5,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// Output is the JSON encoded call tree of the contract call
+	Output RawContractMessage `protobuf:"bytes,6,opt,name=output,proto3,casttype=RawContractMessage" json:"output,omitempty"`
+	// ExecMode is the execution mode of the call: "finalize" for calls in a
+	// block, "check", "recheck" or "simulate" for the same calls in the mempool
+	// checks and simulations. Queries outside of a transaction run in "check"
+	// mode.
+	ExecMode string `protobuf:"bytes,7,opt,name=exec_mode,json=execMode,proto3" json:"exec_mode,omitempty"`
+}
+
+func (m *DebugRecord) Reset()         { *m = DebugRecord{} }
+func (m *DebugRecord) String() string { return proto.CompactTextString(m) }
+func (*DebugRecord) ProtoMessage()    {}
+func (*DebugRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_584d3b4850db247d, []int{1}
+}
+
+func (m *DebugRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *DebugRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DebugRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *DebugRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DebugRecord.Merge(m, src)
+}
+
+func (m *DebugRecord) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *DebugRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DebugRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DebugRecord proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*TailDebugRecordsRequest)(nil), "cosmwasm.wasm.v1.TailDebugRecordsRequest")
+	proto.RegisterType((*DebugRecord)(nil), "cosmwasm.wasm.v1.DebugRecord")
+}
+
+func init() {
+	proto.RegisterFile("cosmwasm/wasm/v1/contract_debug.proto", fileDescriptor_584d3b4850db247d)
+}
+
+var fileDescriptor_584d3b4850db247d = []byte{
+	// 430 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x4d, 0x6e, 0xd3, 0x40,
+	0x18, 0xb5, 0xdb, 0xe0, 0xa4, 0x03, 0x88, 0x6a, 0x54, 0xb5, 0x26, 0x08, 0x37, 0x2a, 0x02, 0x85,
+	0x05, 0x36, 0x85, 0x13, 0x90, 0x74, 0xd1, 0x2e, 0xba, 0x19, 0x90, 0x90, 0xd8, 0x58, 0x93, 0x99,
+	0x0f, 0xdb, 0x52, 0x9c, 0x2f, 0x78, 0xc6, 0x49, 0xb8, 0x05, 0xdc, 0x82, 0x03, 0x70, 0x88, 0x2e,
+	0x2b, 0x56, 0xac, 0x2a, 0x70, 0x6e, 0xc1, 0x0a, 0xcd, 0xd8, 0xae, 0x22, 0x7e, 0x16, 0xdd, 0x8c,
+	0xfd, 0xe6, 0xbd, 0x79, 0xef, 0x69, 0xbe, 0x21, 0x8f, 0x05, 0xaa, 0x7c, 0xc9, 0x55, 0x1e, 0xd9,
+	0x65, 0x71, 0x1c, 0x09, 0x9c, 0xe9, 0x82, 0x0b, 0x1d, 0x4b, 0x98, 0x94, 0x49, 0x38, 0x2f, 0x50,
+	0x23, 0xdd, 0x6d, 0x65, 0xa1, 0x5d, 0x16, 0xc7, 0xfd, 0xbd, 0x04, 0x13, 0xb4, 0x64, 0x64, 0xfe,
+	0x6a, 0x5d, 0xff, 0xbe, 0xd1, 0xa1, 0x8a, 0x6b, 0xa2, 0x06, 0x35, 0x75, 0xb4, 0x20, 0x07, 0x6f,
+	0x78, 0x36, 0x3d, 0x31, 0xae, 0x0c, 0x04, 0x16, 0x52, 0x31, 0xf8, 0x50, 0x82, 0xd2, 0x74, 0x4c,
+	0x76, 0xaf, 0x53, 0xb9, 0x94, 0x05, 0x28, 0xe5, 0xbb, 0x03, 0x77, 0xb8, 0x33, 0xf2, 0xbf, 0x7d,
+	0x7d, 0xb6, 0xd7, 0xd8, 0xbc, 0xaa, 0x99, 0xd7, 0xba, 0xc8, 0x66, 0x09, 0xbb, 0xd7, 0x9e, 0x68,
+	0xb6, 0xe9, 0x3e, 0xf1, 0xde, 0xe3, 0x74, 0x8a, 0x4b, 0x7f, 0x6b, 0xe0, 0x0e, 0x7b, 0xac, 0x41,
+	0x47, 0x9f, 0xb7, 0xc8, 0xed, 0x8d, 0x50, 0xda, 0x27, 0x3d, 0x65, 0x72, 0x67, 0x02, 0x6c, 0x48,
+	0x87, 0x5d, 0x63, 0xe3, 0x91, 0x42, 0x96, 0xa4, 0xda, 0x7a, 0x6c, 0xb3, 0x06, 0xd1, 0x03, 0xd2,
+	0xd5, 0xab, 0x38, 0xe5, 0x2a, 0xf5, 0xb7, 0x4d, 0x2f, 0xe6, 0xe9, 0xd5, 0x29, 0x57, 0xe9, 0x3f,
+	0x9b, 0x77, 0x6e, 0xda, 0xfc, 0x11, 0xe9, 0x0a, 0x94, 0x10, 0x67, 0xd2, 0xbf, 0x65, 0x0a, 0x8d,
+	0x48, 0x75, 0x75, 0xe8, 0x8d, 0x51, 0xc2, 0xd9, 0x09, 0xf3, 0x0c, 0x75, 0x26, 0x69, 0x48, 0x3c,
+	0x2c, 0xf5, 0xbc, 0xd4, 0xbe, 0x37, 0x70, 0x87, 0x77, 0x46, 0xfb, 0xbf, 0xae, 0x0e, 0x29, 0xe3,
+	0xcb, 0x71, 0x63, 0x76, 0x0e, 0x4a, 0xf1, 0x04, 0x58, 0xa3, 0xa2, 0x0f, 0xc8, 0x0e, 0xac, 0x40,
+	0xc4, 0x39, 0x4a, 0xf0, 0xbb, 0xb6, 0x74, 0xcf, 0x6c, 0x9c, 0xa3, 0x84, 0x17, 0x82, 0xdc, 0x6d,
+	0xcf, 0xd9, 0xab, 0xa1, 0x8c, 0x74, 0xcc, 0x70, 0xe8, 0xd3, 0xf0, 0xcf, 0x41, 0x87, 0xff, 0x19,
+	0x5a, 0xff, 0xe1, 0xdf, 0xd2, 0x0d, 0xd9, 0x73, 0x77, 0x74, 0x7a, 0xf1, 0x33, 0x70, 0xbe, 0x54,
+	0x81, 0x73, 0x51, 0x05, 0xee, 0x65, 0x15, 0xb8, 0x3f, 0xaa, 0xc0, 0xfd, 0xb4, 0x0e, 0x9c, 0xcb,
+	0x75, 0xe0, 0x7c, 0x5f, 0x07, 0xce, 0xbb, 0x27, 0x49, 0xa6, 0xd3, 0x72, 0x12, 0x0a, 0xcc, 0xa3,
+	0x31, 0xaa, 0xfc, 0x6d, 0xfb, 0x0e, 0x65, 0xb4, 0xb2, 0xdf, 0x48, 0x7f, 0x9c, 0x83, 0x9a, 0x78,
+	0xf6, 0x05, 0xbd, 0xfc, 0x3d, 0x00, 0xcf, 0xdb, 0xe1, 0xcb, 0xad, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ context.Context
+	_ grpc.ClientConn
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ContractDebugClient is the client API for ContractDebug service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ContractDebugClient interface {
+	// Tail streams the buffered debug records, oldest first, and the new records
+	// when follow is set
+	Tail(ctx context.Context, in *TailDebugRecordsRequest, opts ...grpc.CallOption) (ContractDebug_TailClient, error)
+}
+
+type contractDebugClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewContractDebugClient(cc grpc1.ClientConn) ContractDebugClient {
+	return &contractDebugClient{cc}
+}
+
+func (c *contractDebugClient) Tail(ctx context.Context, in *TailDebugRecordsRequest, opts ...grpc.CallOption) (ContractDebug_TailClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ContractDebug_serviceDesc.Streams[0], "/cosmwasm.wasm.v1.ContractDebug/Tail", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &contractDebugTailClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ContractDebug_TailClient interface {
+	Recv() (*DebugRecord, error)
+	grpc.ClientStream
+}
+
+type contractDebugTailClient struct {
+	grpc.ClientStream
+}
+
+func (x *contractDebugTailClient) Recv() (*DebugRecord, error) {
+	m := new(DebugRecord)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ContractDebugServer is the server API for ContractDebug service.
+type ContractDebugServer interface {
+	// Tail streams the buffered debug records, oldest first, and the new records
+	// when follow is set
+	Tail(*TailDebugRecordsRequest, ContractDebug_TailServer) error
+}
+
+// UnimplementedContractDebugServer can be embedded to have forward compatible implementations.
+type UnimplementedContractDebugServer struct{}
+
+func (*UnimplementedContractDebugServer) Tail(req *TailDebugRecordsRequest, srv ContractDebug_TailServer) error {
+	return status.Errorf(codes.Unimplemented, "method Tail not implemented")
+}
+
+func RegisterContractDebugServer(s grpc1.Server, srv ContractDebugServer) {
+	s.RegisterService(&_ContractDebug_serviceDesc, srv)
+}
+
+func _ContractDebug_Tail_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TailDebugRecordsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ContractDebugServer).Tail(m, &contractDebugTailServer{stream})
+}
+
+type ContractDebug_TailServer interface {
+	Send(*DebugRecord) error
+	grpc.ServerStream
+}
+
+type contractDebugTailServer struct {
+	grpc.ServerStream
+}
+
+func (x *contractDebugTailServer) Send(m *DebugRecord) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _ContractDebug_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmwasm.wasm.v1.ContractDebug",
+	HandlerType: (*ContractDebugServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Tail",
+			Handler:       _ContractDebug_Tail_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cosmwasm/wasm/v1/contract_debug.proto",
+}
+
+func (m *TailDebugRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TailDebugRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TailDebugRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Follow {
+		i--
+		if m.Follow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintContractDebug(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DebugRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DebugRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DebugRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExecMode) > 0 {
+		i -= len(m.ExecMode)
+		copy(dAtA[i:], m.ExecMode)
+		i = encodeVarintContractDebug(dAtA, i, uint64(len(m.ExecMode)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Output) > 0 {
+		i -= len(m.Output)
+		copy(dAtA[i:], m.Output)
+		i = encodeVarintContractDebug(dAtA, i, uint64(len(m.Output)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintContractDebug(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintContractDebug(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintContractDebug(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintContractDebug(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintContractDebug(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintContractDebug(dAtA []byte, offset int, v uint64) int {
+	offset -= sovContractDebug(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *TailDebugRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovContractDebug(uint64(l))
+	}
+	if m.Follow {
+		n += 2
+	}
+	return n
+}
+
+func (m *DebugRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovContractDebug(uint64(m.Sequence))
+	}
+	if m.Height != 0 {
+		n += 1 + sovContractDebug(uint64(m.Height))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovContractDebug(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovContractDebug(uint64(l))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovContractDebug(uint64(m.CodeID))
+	}
+	l = len(m.Output)
+	if l > 0 {
+		n += 1 + l + sovContractDebug(uint64(l))
+	}
+	l = len(m.ExecMode)
+	if l > 0 {
+		n += 1 + l + sovContractDebug(uint64(l))
+	}
+	return n
+}
+
+func sovContractDebug(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozContractDebug(x uint64) (n int) {
+	return sovContractDebug(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *TailDebugRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowContractDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TailDebugRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TailDebugRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContractDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Follow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Follow = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipContractDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthContractDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *DebugRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowContractDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DebugRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DebugRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContractDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContractDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthContractDebug
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Output = append(m.Output[:0], dAtA[iNdEx:postIndex]...)
+			if m.Output == nil {
+				m.Output = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowContractDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthContractDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthContractDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecMode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipContractDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthContractDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipContractDebug(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowContractDebug
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowContractDebug
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowContractDebug
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthContractDebug
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupContractDebug
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthContractDebug
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthContractDebug        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowContractDebug          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupContractDebug = fmt.Errorf("proto: unexpected end of group")
+)
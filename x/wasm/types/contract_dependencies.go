@@ -0,0 +1,21 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic performs stateless validation of the recorded calls between two contracts
+func (d ContractDependency) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(d.Caller); err != nil {
+		return errorsmod.Wrap(err, "caller")
+	}
+	if _, err := sdk.AccAddressFromBech32(d.Callee); err != nil {
+		return errorsmod.Wrap(err, "callee")
+	}
+	if d.LastHeight < 0 {
+		return errorsmod.Wrap(ErrInvalid, "last height")
+	}
+	return nil
+}
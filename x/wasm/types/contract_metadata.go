@@ -0,0 +1,98 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strings"
+	"unicode"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	// MaxContractMetadataNameSize is the longest name of a contract metadata
+	MaxContractMetadataNameSize = 128 // extension point for chains to customize via compile flag.
+
+	// MaxContractMetadataDescriptionSize is the longest description of a contract metadata
+	MaxContractMetadataDescriptionSize = 1024 // extension point for chains to customize via compile flag.
+
+	// MaxContractMetadataURLSize is the longest URL of a contract metadata
+	MaxContractMetadataURLSize = 256 // extension point for chains to customize via compile flag.
+
+	// MaxContractMetadataAuditLinks is the max number of audit links of a contract metadata
+	MaxContractMetadataAuditLinks = 10 // extension point for chains to customize via compile flag.
+)
+
+// ValidateBasic performs stateless validation of the contract metadata
+func (m ContractMetadata) ValidateBasic() error {
+	if err := validateMetadataText(m.Name, MaxContractMetadataNameSize); err != nil {
+		return errorsmod.Wrap(err, "name")
+	}
+	if err := validateMetadataText(m.Description, MaxContractMetadataDescriptionSize); err != nil {
+		return errorsmod.Wrap(err, "description")
+	}
+	if err := validateMetadataURL(m.Homepage, true); err != nil {
+		return errorsmod.Wrap(err, "homepage")
+	}
+	if len(m.AuditLinks) > MaxContractMetadataAuditLinks {
+		return errorsmod.Wrapf(ErrLimit, "audit links: cannot be more than %d", MaxContractMetadataAuditLinks)
+	}
+	for i, link := range m.AuditLinks {
+		if link == "" {
+			return errorsmod.Wrapf(ErrEmpty, "audit link %d", i)
+		}
+		if err := validateMetadataURL(link, true); err != nil {
+			return errorsmod.Wrapf(err, "audit link %d", i)
+		}
+	}
+	if err := validateMetadataURL(m.SchemaURI, false); err != nil {
+		return errorsmod.Wrap(err, "schema uri")
+	}
+	if m.SchemaHash != "" {
+		if bz, err := hex.DecodeString(m.SchemaHash); err != nil || len(bz) != sha256.Size {
+			return errorsmod.Wrap(ErrInvalid, "schema hash: must be a hex encoded sha256 hash")
+		}
+	}
+	return nil
+}
+
+// IsEmpty returns true when no field is set
+func (m ContractMetadata) IsEmpty() bool {
+	return m.Name == "" && m.Description == "" && m.Homepage == "" && len(m.AuditLinks) == 0 &&
+		m.SchemaURI == "" && m.SchemaHash == ""
+}
+
+// validateMetadataText accepts empty or trimmed text with printable characters and new lines only
+func validateMetadataText(s string, maxSize int) error {
+	if len(s) > maxSize {
+		return ErrLimit.Wrapf("cannot be longer than %d characters", maxSize)
+	}
+	if s != strings.TrimSpace(s) {
+		return ErrInvalid.Wrap("must not start/end with whitespaces")
+	}
+	for _, r := range s {
+		if !unicode.IsPrint(r) && r != '\n' {
+			return ErrInvalid.Wrap("must have printable characters only")
+		}
+	}
+	return nil
+}
+
+// validateMetadataURL accepts empty or absolute URLs. Web URLs must use the http or https scheme.
+func validateMetadataURL(s string, web bool) error {
+	if s == "" {
+		return nil
+	}
+	if len(s) > MaxContractMetadataURLSize {
+		return ErrLimit.Wrapf("cannot be longer than %d characters", MaxContractMetadataURLSize)
+	}
+	u, err := url.Parse(s)
+	if err != nil || !u.IsAbs() || (u.Host == "" && u.Opaque == "") {
+		return ErrInvalid.Wrap("must be an absolute URL")
+	}
+	if web && u.Scheme != "http" && u.Scheme != "https" {
+		return ErrInvalid.Wrap("must be an http(s) URL")
+	}
+	return nil
+}
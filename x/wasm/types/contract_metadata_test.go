@@ -0,0 +1,95 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractMetadataValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		src    ContractMetadata
+		expErr bool
+	}{
+		"all set": {
+			src: ContractMetadata{
+				Name:        "My Contract",
+				Description: "A contract\nwith two lines",
+				Homepage:    "https://example.com",
+				AuditLinks:  []string{"https://example.com/audit1.pdf", "http://example.com/audit2"},
+				SchemaURI:   "ipfs://bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi",
+				SchemaHash:  strings.Repeat("ab", 32),
+			},
+		},
+		"empty": {
+			src: ContractMetadata{},
+		},
+		"name too long": {
+			src:    ContractMetadata{Name: strings.Repeat("a", MaxContractMetadataNameSize+1)},
+			expErr: true,
+		},
+		"name with whitespace suffix": {
+			src:    ContractMetadata{Name: "foo "},
+			expErr: true,
+		},
+		"name not printable": {
+			src:    ContractMetadata{Name: "foo\x00bar"},
+			expErr: true,
+		},
+		"description too long": {
+			src:    ContractMetadata{Description: strings.Repeat("a", MaxContractMetadataDescriptionSize+1)},
+			expErr: true,
+		},
+		"homepage relative": {
+			src:    ContractMetadata{Homepage: "example.com"},
+			expErr: true,
+		},
+		"homepage not http": {
+			src:    ContractMetadata{Homepage: "ftp://example.com"},
+			expErr: true,
+		},
+		"homepage too long": {
+			src:    ContractMetadata{Homepage: "https://example.com/" + strings.Repeat("a", MaxContractMetadataURLSize)},
+			expErr: true,
+		},
+		"audit link empty": {
+			src:    ContractMetadata{AuditLinks: []string{""}},
+			expErr: true,
+		},
+		"too many audit links": {
+			src:    ContractMetadata{AuditLinks: make([]string, MaxContractMetadataAuditLinks+1)},
+			expErr: true,
+		},
+		"schema uri relative": {
+			src:    ContractMetadata{SchemaURI: "/schema.json"},
+			expErr: true,
+		},
+		"schema hash not hex": {
+			src:    ContractMetadata{SchemaHash: strings.Repeat("x", 64)},
+			expErr: true,
+		},
+		"schema hash wrong length": {
+			src:    ContractMetadata{SchemaHash: "abcd"},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestContractMetadataIsEmpty(t *testing.T) {
+	assert.True(t, ContractMetadata{}.IsEmpty())
+	assert.True(t, ContractMetadata{AuditLinks: []string{}}.IsEmpty())
+	assert.False(t, ContractMetadata{Name: "foo"}.IsEmpty())
+	assert.False(t, ContractMetadata{AuditLinks: []string{"https://example.com"}}.IsEmpty())
+}
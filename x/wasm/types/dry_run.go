@@ -0,0 +1,214 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// DryRunRecorder collects the contract store writes, messages and replies of a contract call that runs in a dry run.
+// All methods can be called on a nil recorder and do nothing then.
+type DryRunRecorder struct {
+	writes   []SimulatedStateChange
+	messages []SimulatedMessage
+	replies  []SimulatedReply
+}
+
+// DryRunSnapshot is the position of a recorder that the records can be reverted to
+type DryRunSnapshot struct {
+	writes, messages, replies int
+}
+
+// NewDryRunRecorder constructor
+func NewDryRunRecorder() *DryRunRecorder {
+	return &DryRunRecorder{}
+}
+
+// RecordStateChange records a write to a contract store. A nil value is recorded as delete.
+func (r *DryRunRecorder) RecordStateChange(contractAddr sdk.AccAddress, key, oldValue, newValue []byte) {
+	if r == nil {
+		return
+	}
+	r.writes = append(r.writes, SimulatedStateChange{
+		ContractAddress: contractAddr.String(),
+		Key:             bytes.Clone(key),
+		OldValue:        bytes.Clone(oldValue),
+		NewValue:        bytes.Clone(newValue),
+		Deleted:         newValue == nil,
+	})
+}
+
+// RecordMessage records a message that is dispatched by a contract
+func (r *DryRunRecorder) RecordMessage(contractAddr sdk.AccAddress, msg wasmvmtypes.CosmosMsg) {
+	if r == nil {
+		return
+	}
+	r.messages = append(r.messages, SimulatedMessage{
+		ContractAddress: contractAddr.String(),
+		Msg:             mustMarshalJSON(msg),
+	})
+}
+
+// RecordSubMessage records a submessage that is dispatched by a contract
+func (r *DryRunRecorder) RecordSubMessage(contractAddr sdk.AccAddress, msg wasmvmtypes.SubMsg) {
+	if r == nil {
+		return
+	}
+	r.messages = append(r.messages, SimulatedMessage{
+		ContractAddress: contractAddr.String(),
+		Msg:             mustMarshalJSON(msg.Msg),
+		SubMessage:      true,
+		ID:              msg.ID,
+		ReplyOn:         msg.ReplyOn.String(),
+	})
+}
+
+// RecordReply records a reply that is sent to a contract
+func (r *DryRunRecorder) RecordReply(contractAddr sdk.AccAddress, reply wasmvmtypes.Reply) {
+	if r == nil {
+		return
+	}
+	rec := SimulatedReply{
+		ContractAddress: contractAddr.String(),
+		ID:              reply.ID,
+		Error:           reply.Result.Err,
+	}
+	if reply.Result.Ok != nil {
+		rec.Data = reply.Result.Ok.Data
+	}
+	r.replies = append(r.replies, rec)
+}
+
+// Snapshot returns the current position of the recorder
+func (r *DryRunRecorder) Snapshot() DryRunSnapshot {
+	if r == nil {
+		return DryRunSnapshot{}
+	}
+	return DryRunSnapshot{writes: len(r.writes), messages: len(r.messages), replies: len(r.replies)}
+}
+
+// Revert drops all records after the snapshot. It is used when the state changes of a call are discarded.
+func (r *DryRunRecorder) Revert(s DryRunSnapshot) {
+	if r == nil {
+		return
+	}
+	r.writes, r.messages, r.replies = r.writes[:s.writes], r.messages[:s.messages], r.replies[:s.replies]
+}
+
+// StateChanges returns the changed keys in the order of their first write. Multiple writes to a key are merged and
+// keys that have the old value again are dropped.
+func (r *DryRunRecorder) StateChanges() []SimulatedStateChange {
+	if r == nil {
+		return nil
+	}
+	type storeKey struct{ contract, key string }
+	pos := make(map[storeKey]int)
+	var result []SimulatedStateChange
+	for _, w := range r.writes {
+		k := storeKey{contract: w.ContractAddress, key: string(w.Key)}
+		i, exists := pos[k]
+		if !exists {
+			pos[k] = len(result)
+			result = append(result, w)
+			continue
+		}
+		result[i].NewValue, result[i].Deleted = w.NewValue, w.Deleted
+	}
+	changes := result[:0]
+	for _, c := range result {
+		if (!c.Deleted && c.OldValue != nil && bytes.Equal(c.OldValue, c.NewValue)) ||
+			(c.Deleted && c.OldValue == nil) {
+			continue
+		}
+		changes = append(changes, c)
+	}
+	return changes
+}
+
+// Messages returns the dispatched messages in order
+func (r *DryRunRecorder) Messages() []SimulatedMessage {
+	if r == nil {
+		return nil
+	}
+	return r.messages
+}
+
+// Replies returns the replies in order
+func (r *DryRunRecorder) Replies() []SimulatedReply {
+	if r == nil {
+		return nil
+	}
+	return r.replies
+}
+
+// BalanceChangesFromEvents returns the balance changes from the coin spent and received events of the bank module
+// ordered by address and denom
+func BalanceChangesFromEvents(events sdk.Events) ([]SimulatedBalanceChange, error) {
+	type balanceKey struct{ address, denom string }
+	changes := make(map[balanceKey]sdkmath.Int)
+	add := func(addr string, coins sdk.Coins, sign int64) {
+		for _, c := range coins {
+			k := balanceKey{address: addr, denom: c.Denom}
+			v, ok := changes[k]
+			if !ok {
+				v = sdkmath.ZeroInt()
+			}
+			changes[k] = v.Add(c.Amount.MulRaw(sign))
+		}
+	}
+	for _, e := range events {
+		var addrKey string
+		var sign int64
+		switch e.Type {
+		case banktypes.EventTypeCoinSpent:
+			addrKey, sign = banktypes.AttributeKeySpender, -1
+		case banktypes.EventTypeCoinReceived:
+			addrKey, sign = banktypes.AttributeKeyReceiver, 1
+		default:
+			continue
+		}
+		var addr string
+		var coins sdk.Coins
+		for _, a := range e.Attributes {
+			switch a.Key {
+			case addrKey:
+				addr = a.Value
+			case sdk.AttributeKeyAmount:
+				var err error
+				if coins, err = sdk.ParseCoinsNormalized(a.Value); err != nil {
+					return nil, err
+				}
+			}
+		}
+		add(addr, coins, sign)
+	}
+	result := make([]SimulatedBalanceChange, 0, len(changes))
+	for k, v := range changes {
+		if v.IsZero() {
+			continue
+		}
+		result = append(result, SimulatedBalanceChange{Address: k.address, Denom: k.denom, Amount: v})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Address != result[j].Address {
+			return result[i].Address < result[j].Address
+		}
+		return result[i].Denom < result[j].Denom
+	})
+	return result, nil
+}
+
+func mustMarshalJSON(v any) []byte {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		panic(err) // can not happen for wasmvm types
+	}
+	return bz
+}
@@ -0,0 +1,118 @@
+package types
+
+import (
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestDryRunRecorderStateChanges(t *testing.T) {
+	myContract := sdk.AccAddress("myContract")
+	specs := map[string]struct {
+		writes [][3][]byte // key, old, new
+		exp    []SimulatedStateChange
+	}{
+		"created": {
+			writes: [][3][]byte{{[]byte("a"), nil, []byte("1")}},
+			exp:    []SimulatedStateChange{{Key: []byte("a"), NewValue: []byte("1")}},
+		},
+		"updated twice": {
+			writes: [][3][]byte{{[]byte("a"), []byte("0"), []byte("1")}, {[]byte("a"), []byte("1"), []byte("2")}},
+			exp:    []SimulatedStateChange{{Key: []byte("a"), OldValue: []byte("0"), NewValue: []byte("2")}},
+		},
+		"deleted": {
+			writes: [][3][]byte{{[]byte("a"), []byte("0"), nil}},
+			exp:    []SimulatedStateChange{{Key: []byte("a"), OldValue: []byte("0"), Deleted: true}},
+		},
+		"created and deleted": {
+			writes: [][3][]byte{{[]byte("a"), nil, []byte("1")}, {[]byte("a"), []byte("1"), nil}},
+		},
+		"set to old value": {
+			writes: [][3][]byte{{[]byte("a"), []byte("0"), []byte("1")}, {[]byte("a"), []byte("1"), []byte("0")}},
+		},
+		"order of first write": {
+			writes: [][3][]byte{{[]byte("b"), nil, []byte("1")}, {[]byte("a"), nil, []byte("1")}, {[]byte("b"), []byte("1"), []byte("2")}},
+			exp: []SimulatedStateChange{
+				{Key: []byte("b"), NewValue: []byte("2")},
+				{Key: []byte("a"), NewValue: []byte("1")},
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			r := NewDryRunRecorder()
+			for _, w := range spec.writes {
+				r.RecordStateChange(myContract, w[0], w[1], w[2])
+			}
+			// when
+			got := r.StateChanges()
+			// then
+			for i := range spec.exp {
+				spec.exp[i].ContractAddress = myContract.String()
+			}
+			assert.Equal(t, len(spec.exp), len(got))
+			for i := range spec.exp {
+				assert.Equal(t, spec.exp[i], got[i])
+			}
+		})
+	}
+}
+
+func TestDryRunRecorderRevert(t *testing.T) {
+	myContract := sdk.AccAddress("myContract")
+	r := NewDryRunRecorder()
+	subMsg := wasmvmtypes.SubMsg{ID: 1, ReplyOn: wasmvmtypes.ReplyError, Msg: wasmvmtypes.CosmosMsg{Custom: []byte(`{}`)}}
+	r.RecordSubMessage(myContract, subMsg)
+	snapshot := r.Snapshot()
+	r.RecordStateChange(myContract, []byte("a"), nil, []byte("1"))
+	r.RecordMessage(myContract, wasmvmtypes.CosmosMsg{Custom: []byte(`{}`)})
+	r.RecordReply(myContract, wasmvmtypes.Reply{ID: 2, Result: wasmvmtypes.SubMsgResult{Err: "failed"}})
+
+	// when
+	r.Revert(snapshot)
+
+	// then
+	assert.Empty(t, r.StateChanges())
+	assert.Empty(t, r.Replies())
+	exp := []SimulatedMessage{{
+		ContractAddress: myContract.String(),
+		Msg:             []byte(`{"custom":{}}`),
+		SubMessage:      true,
+		ID:              1,
+		ReplyOn:         "error",
+	}}
+	assert.Equal(t, exp, r.Messages())
+}
+
+func TestBalanceChangesFromEvents(t *testing.T) {
+	alice, bob := sdk.AccAddress("alice"), sdk.AccAddress("bob")
+	coins := func(s string) sdk.Coins {
+		c, err := sdk.ParseCoinsNormalized(s)
+		require.NoError(t, err)
+		return c
+	}
+	events := sdk.Events{
+		banktypes.NewCoinSpentEvent(alice, coins("10denom,5other")),
+		banktypes.NewCoinReceivedEvent(bob, coins("10denom")),
+		banktypes.NewCoinReceivedEvent(alice, coins("5other")),
+		sdk.NewEvent("other", sdk.NewAttribute(sdk.AttributeKeyAmount, "1denom")),
+	}
+
+	// when
+	got, err := BalanceChangesFromEvents(events)
+
+	// then
+	require.NoError(t, err)
+	exp := []SimulatedBalanceChange{
+		{Address: alice.String(), Denom: "denom", Amount: sdkmath.NewInt(-10)},
+		{Address: bob.String(), Denom: "denom", Amount: sdkmath.NewInt(10)},
+	}
+	assert.Equal(t, exp, got)
+}
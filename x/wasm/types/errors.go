@@ -92,6 +92,18 @@ var (
 
 	// ErrExceedMaxCallDepth error if max message stack size is exceeded
 	ErrExceedMaxCallDepth = errorsmod.Register(DefaultCodespace, 30, "max call depth exceeded")
+
+	// ErrContractFrozen error if the contract is frozen and must not be executed
+	ErrContractFrozen = errorsmod.Register(DefaultCodespace, 31, "contract frozen")
+
+	// ErrCodeDeprecated error if the code is deprecated and must not be used for new instances or migrations
+	ErrCodeDeprecated = errorsmod.Register(DefaultCodespace, 32, "code deprecated")
+
+	// ErrChecksumBlocked error if the checksum of a new code is blocked by the params
+	ErrChecksumBlocked = errorsmod.Register(DefaultCodespace, 33, "checksum blocked")
+
+	// ErrStorageDeposit error if a contract can not pay the deposit for its storage
+	ErrStorageDeposit = errorsmod.Register(DefaultCodespace, 34, "insufficient storage deposit")
 )
 
 // WasmVMErrorable mapped error type in wasmvm and are not redacted
@@ -0,0 +1,1526 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmwasm/wasm/v1/event_index.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = proto.Marshal
+	_ = fmt.Errorf
+	_ = math.Inf
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryContractEventsRequest is the request type for the
+// EventIndex/ContractEvents RPC method. At least one of the filters should be
+// set for an efficient query.
+type QueryContractEventsRequest struct {
+	// ContractAddress is the address of the contract that emitted the events
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// EventType is the full type of the events, including the "wasm-" prefix
+	EventType string `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	// AttributeKey is the key of an attribute that the events must have
+	AttributeKey string `protobuf:"bytes,3,opt,name=attribute_key,json=attributeKey,proto3" json:"attribute_key,omitempty"`
+	// AttributeValue is the value of the attribute with AttributeKey. Requires
+	// the AttributeKey.
+	AttributeValue string `protobuf:"bytes,4,opt,name=attribute_value,json=attributeValue,proto3" json:"attribute_value,omitempty"`
+	// MinHeight is the lowest block height, inclusive
+	MinHeight int64 `protobuf:"varint,5,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	// MaxHeight is the highest block height, inclusive. Not limited when zero.
+	MaxHeight int64 `protobuf:"varint,6,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
+	// Pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,7,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContractEventsRequest) Reset()         { *m = QueryContractEventsRequest{} }
+func (m *QueryContractEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContractEventsRequest) ProtoMessage()    {}
+func (*QueryContractEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22331de8e5cf0e9, []int{0}
+}
+
+func (m *QueryContractEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryContractEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryContractEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractEventsRequest.Merge(m, src)
+}
+
+func (m *QueryContractEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryContractEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractEventsRequest proto.InternalMessageInfo
+
+// QueryContractEventsResponse is the response type for the
+// EventIndex/ContractEvents RPC method
+type QueryContractEventsResponse struct {
+	// Events ordered by block height and position in the block
+	Events []IndexedEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events"`
+	// Pagination defines the pagination in the response. The number of index
+	// entries scanned per page is limited, so that a page can have fewer events
+	// than requested, or none, and still a next key.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryContractEventsResponse) Reset()         { *m = QueryContractEventsResponse{} }
+func (m *QueryContractEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractEventsResponse) ProtoMessage()    {}
+func (*QueryContractEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22331de8e5cf0e9, []int{1}
+}
+
+func (m *QueryContractEventsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *QueryContractEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractEventsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *QueryContractEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractEventsResponse.Merge(m, src)
+}
+
+func (m *QueryContractEventsResponse) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *QueryContractEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractEventsResponse proto.InternalMessageInfo
+
+// IndexedEvent is a custom contract event with its position in the chain
+type IndexedEvent struct {
+	// Height is the block height
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// TxHash is the hex encoded hash of the transaction. Empty for events that
+	// were emitted outside of a transaction.
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// Sequence is the position of the event within the indexed events of the
+	// block
+	Sequence uint32 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// ContractAddress is the address of the contract that emitted the event
+	ContractAddress string `protobuf:"bytes,4,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// Type is the event type
+	Type string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	// Attributes are the event attributes
+	Attributes []IndexedEventAttribute `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes"`
+}
+
+func (m *IndexedEvent) Reset()         { *m = IndexedEvent{} }
+func (m *IndexedEvent) String() string { return proto.CompactTextString(m) }
+func (*IndexedEvent) ProtoMessage()    {}
+func (*IndexedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22331de8e5cf0e9, []int{2}
+}
+
+func (m *IndexedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *IndexedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *IndexedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedEvent.Merge(m, src)
+}
+
+func (m *IndexedEvent) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *IndexedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedEvent proto.InternalMessageInfo
+
+// IndexedEventAttribute is a key value pair of an indexed event
+type IndexedEventAttribute struct {
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *IndexedEventAttribute) Reset()         { *m = IndexedEventAttribute{} }
+func (m *IndexedEventAttribute) String() string { return proto.CompactTextString(m) }
+func (*IndexedEventAttribute) ProtoMessage()    {}
+func (*IndexedEventAttribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22331de8e5cf0e9, []int{3}
+}
+
+func (m *IndexedEventAttribute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *IndexedEventAttribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexedEventAttribute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *IndexedEventAttribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedEventAttribute.Merge(m, src)
+}
+
+func (m *IndexedEventAttribute) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *IndexedEventAttribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedEventAttribute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedEventAttribute proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*QueryContractEventsRequest)(nil), "cosmwasm.wasm.v1.QueryContractEventsRequest")
+	proto.RegisterType((*QueryContractEventsResponse)(nil), "cosmwasm.wasm.v1.QueryContractEventsResponse")
+	proto.RegisterType((*IndexedEvent)(nil), "cosmwasm.wasm.v1.IndexedEvent")
+	proto.RegisterType((*IndexedEventAttribute)(nil), "cosmwasm.wasm.v1.IndexedEventAttribute")
+}
+
+func init() {
+	proto.RegisterFile("cosmwasm/wasm/v1/event_index.proto", fileDescriptor_f22331de8e5cf0e9)
+}
+
+var fileDescriptor_f22331de8e5cf0e9 = []byte{
+	// 640 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x3d, 0x6f, 0x13, 0x31,
+	0x18, 0x8e, 0x93, 0x36, 0x25, 0x6f, 0x3f, 0xb1, 0x0a, 0x1c, 0x01, 0x8e, 0x28, 0x48, 0x6d, 0x54,
+	0xd1, 0xb3, 0x52, 0x7e, 0x00, 0x6a, 0x2b, 0xa0, 0x88, 0x05, 0x0e, 0x04, 0x12, 0x4b, 0xe4, 0x24,
+	0xd6, 0xe5, 0x44, 0xcf, 0x4e, 0x63, 0x27, 0x5c, 0x56, 0x26, 0x36, 0x90, 0xd8, 0x99, 0xcb, 0xc6,
+	0xc0, 0x8f, 0xe8, 0x58, 0x01, 0x03, 0x13, 0x82, 0x14, 0x89, 0xbf, 0x81, 0xfc, 0x91, 0x34, 0x85,
+	0x54, 0xc0, 0x62, 0xd9, 0xef, 0xf3, 0xbc, 0x1f, 0x7e, 0xfc, 0xdc, 0x41, 0xb9, 0x21, 0x64, 0xf2,
+	0x9c, 0xca, 0x84, 0x98, 0xa5, 0x57, 0x25, 0xac, 0xc7, 0xb8, 0xaa, 0xc5, 0xbc, 0xc9, 0xd2, 0xa0,
+	0xdd, 0x11, 0x4a, 0xe0, 0xa5, 0x21, 0x27, 0x30, 0x4b, 0xaf, 0x5a, 0x5c, 0x8e, 0x44, 0x24, 0x0c,
+	0x48, 0xf4, 0xce, 0xf2, 0x8a, 0x97, 0x23, 0x21, 0xa2, 0x5d, 0x46, 0x68, 0x3b, 0x26, 0x94, 0x73,
+	0xa1, 0xa8, 0x8a, 0x05, 0x97, 0x0e, 0x5d, 0xd3, 0x55, 0x84, 0x24, 0x75, 0x2a, 0x19, 0xd9, 0xeb,
+	0xb2, 0x4e, 0x9f, 0xf4, 0xaa, 0x75, 0xa6, 0x68, 0x95, 0xb4, 0x69, 0x14, 0x73, 0x43, 0x76, 0xdc,
+	0xb3, 0x34, 0x89, 0xb9, 0x20, 0x66, 0x75, 0xa1, 0x8b, 0x36, 0xbd, 0x66, 0xbb, 0xda, 0x83, 0x85,
+	0xca, 0x9f, 0xb3, 0x50, 0x7c, 0xa0, 0x0b, 0x6e, 0x0b, 0xae, 0x3a, 0xb4, 0xa1, 0x6e, 0xe9, 0x2b,
+	0xc8, 0x90, 0xed, 0x75, 0x99, 0x54, 0x78, 0x1b, 0x96, 0x1a, 0x0e, 0xa8, 0xd1, 0x66, 0xb3, 0xc3,
+	0xa4, 0xf4, 0x50, 0x09, 0x55, 0x0a, 0x5b, 0xde, 0xc7, 0x0f, 0xeb, 0xcb, 0xae, 0xd4, 0xa6, 0x45,
+	0x1e, 0xaa, 0x4e, 0xcc, 0xa3, 0x70, 0x71, 0x98, 0xe1, 0xc2, 0xf8, 0x0a, 0x80, 0x15, 0x46, 0xf5,
+	0xdb, 0xcc, 0xcb, 0xea, 0xf4, 0xb0, 0x60, 0x22, 0x8f, 0xfa, 0x6d, 0x86, 0xaf, 0xc1, 0x3c, 0x55,
+	0xaa, 0x13, 0xd7, 0xbb, 0x8a, 0xd5, 0x9e, 0xb1, 0xbe, 0x97, 0x33, 0x8c, 0xb9, 0x51, 0xf0, 0x1e,
+	0xeb, 0xe3, 0x55, 0x58, 0x3c, 0x26, 0xf5, 0xe8, 0x6e, 0x97, 0x79, 0x53, 0x86, 0xb6, 0x30, 0x0a,
+	0x3f, 0xd6, 0x51, 0xdd, 0x2c, 0x89, 0x79, 0xad, 0xc5, 0xe2, 0xa8, 0xa5, 0xbc, 0xe9, 0x12, 0xaa,
+	0xe4, 0xc2, 0x42, 0x12, 0xf3, 0x1d, 0x13, 0x30, 0x30, 0x4d, 0x87, 0x70, 0xde, 0xc1, 0x34, 0x75,
+	0xf0, 0x6d, 0x80, 0x63, 0x41, 0xbd, 0x99, 0x12, 0xaa, 0xcc, 0x6e, 0xac, 0x04, 0xee, 0x9a, 0x5a,
+	0xfd, 0xc0, 0xa8, 0x1f, 0x38, 0xf5, 0x83, 0xfb, 0x34, 0x62, 0x4e, 0xab, 0x70, 0x2c, 0xb3, 0xfc,
+	0x0e, 0xc1, 0xa5, 0x89, 0xb2, 0xca, 0xb6, 0xe0, 0x92, 0xe1, 0x4d, 0xc8, 0x1b, 0x01, 0xb4, 0x9a,
+	0xb9, 0xca, 0xec, 0x86, 0x1f, 0xfc, 0xee, 0x93, 0xe0, 0xae, 0x76, 0x11, 0x6b, 0x9a, 0xc4, 0xad,
+	0xc2, 0xc1, 0xd7, 0xab, 0x99, 0xfd, 0x9f, 0xef, 0xd7, 0x50, 0xe8, 0x12, 0xf1, 0x9d, 0x13, 0xa3,
+	0x66, 0xcd, 0xa8, 0xab, 0x7f, 0x1d, 0xd5, 0xf6, 0x3f, 0x31, 0xeb, 0xcb, 0x2c, 0xcc, 0x8d, 0x37,
+	0xc3, 0xe7, 0x21, 0xef, 0xf4, 0x41, 0x46, 0x1f, 0x77, 0xc2, 0x17, 0x60, 0x46, 0xa5, 0xb5, 0x16,
+	0x95, 0x2d, 0xf7, 0x88, 0x79, 0x95, 0xee, 0x50, 0xd9, 0xc2, 0x45, 0x38, 0x23, 0xb5, 0x08, 0xbc,
+	0xc1, 0xcc, 0xe3, 0xcd, 0x87, 0xa3, 0xf3, 0x44, 0x07, 0x4d, 0xfd, 0xaf, 0x83, 0x30, 0x4c, 0x19,
+	0xef, 0x4c, 0x9b, 0xb6, 0x66, 0x8f, 0x43, 0x80, 0xd1, 0xd3, 0x4b, 0x2f, 0x5f, 0xca, 0x8d, 0xee,
+	0x7f, 0xaa, 0x8c, 0x9b, 0x43, 0xfe, 0xb8, 0x9e, 0x63, 0x55, 0xca, 0x37, 0xe1, 0xdc, 0x44, 0x3e,
+	0x5e, 0x82, 0x9c, 0x76, 0xa6, 0xb1, 0x7e, 0xa8, 0xb7, 0x78, 0x19, 0xa6, 0xad, 0x0d, 0xad, 0x14,
+	0xf6, 0xb0, 0xf1, 0x16, 0x01, 0x98, 0x54, 0x53, 0x06, 0xbf, 0x42, 0xb0, 0x70, 0xd2, 0x01, 0xf8,
+	0xfa, 0x9f, 0x23, 0x9e, 0xfe, 0xfd, 0x15, 0xd7, 0xff, 0x91, 0x6d, 0x9f, 0xb5, 0x5c, 0x7a, 0xf1,
+	0xe9, 0xc7, 0x9b, 0x6c, 0x11, 0x7b, 0x64, 0xf2, 0xaf, 0x49, 0x6e, 0xed, 0x1c, 0x7c, 0xf7, 0x33,
+	0xfb, 0x03, 0x3f, 0x73, 0x30, 0xf0, 0xd1, 0xe1, 0xc0, 0x47, 0xdf, 0x06, 0x3e, 0x7a, 0x7d, 0xe4,
+	0x67, 0x0e, 0x8f, 0xfc, 0xcc, 0x97, 0x23, 0x3f, 0xf3, 0x74, 0x25, 0x8a, 0x55, 0xab, 0x5b, 0x0f,
+	0x1a, 0x22, 0x21, 0xdb, 0x42, 0x26, 0x4f, 0x86, 0x55, 0x9a, 0x24, 0xb5, 0xd5, 0xb4, 0xfc, 0xb2,
+	0x9e, 0x37, 0x3f, 0x90, 0x1b, 0xbf, 0x06, 0x00, 0xf5, 0x8b, 0xd8, 0xe8, 0x06, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ context.Context
+	_ grpc.ClientConn
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// EventIndexClient is the client API for EventIndex service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EventIndexClient interface {
+	// ContractEvents gets indexed contract events within a height range
+	ContractEvents(ctx context.Context, in *QueryContractEventsRequest, opts ...grpc.CallOption) (*QueryContractEventsResponse, error)
+}
+
+type eventIndexClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewEventIndexClient(cc grpc1.ClientConn) EventIndexClient {
+	return &eventIndexClient{cc}
+}
+
+func (c *eventIndexClient) ContractEvents(ctx context.Context, in *QueryContractEventsRequest, opts ...grpc.CallOption) (*QueryContractEventsResponse, error) {
+	out := new(QueryContractEventsResponse)
+	err := c.cc.Invoke(ctx, "/cosmwasm.wasm.v1.EventIndex/ContractEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventIndexServer is the server API for EventIndex service.
+type EventIndexServer interface {
+	// ContractEvents gets indexed contract events within a height range
+	ContractEvents(context.Context, *QueryContractEventsRequest) (*QueryContractEventsResponse, error)
+}
+
+// UnimplementedEventIndexServer can be embedded to have forward compatible implementations.
+type UnimplementedEventIndexServer struct{}
+
+func (*UnimplementedEventIndexServer) ContractEvents(ctx context.Context, req *QueryContractEventsRequest) (*QueryContractEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractEvents not implemented")
+}
+
+func RegisterEventIndexServer(s grpc1.Server, srv EventIndexServer) {
+	s.RegisterService(&_EventIndex_serviceDesc, srv)
+}
+
+func _EventIndex_ContractEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventIndexServer).ContractEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmwasm.wasm.v1.EventIndex/ContractEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventIndexServer).ContractEvents(ctx, req.(*QueryContractEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _EventIndex_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmwasm.wasm.v1.EventIndex",
+	HandlerType: (*EventIndexServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ContractEvents",
+			Handler:    _EventIndex_ContractEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmwasm/wasm/v1/event_index.proto",
+}
+
+func (m *QueryContractEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEventIndex(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MaxHeight != 0 {
+		i = encodeVarintEventIndex(dAtA, i, uint64(m.MaxHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MinHeight != 0 {
+		i = encodeVarintEventIndex(dAtA, i, uint64(m.MinHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AttributeValue) > 0 {
+		i -= len(m.AttributeValue)
+		copy(dAtA[i:], m.AttributeValue)
+		i = encodeVarintEventIndex(dAtA, i, uint64(len(m.AttributeValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AttributeKey) > 0 {
+		i -= len(m.AttributeKey)
+		copy(dAtA[i:], m.AttributeKey)
+		i = encodeVarintEventIndex(dAtA, i, uint64(len(m.AttributeKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EventType) > 0 {
+		i -= len(m.EventType)
+		copy(dAtA[i:], m.EventType)
+		i = encodeVarintEventIndex(dAtA, i, uint64(len(m.EventType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEventIndex(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEventIndex(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEventIndex(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEventIndex(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintEventIndex(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEventIndex(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintEventIndex(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintEventIndex(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintEventIndex(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IndexedEventAttribute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedEventAttribute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedEventAttribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintEventIndex(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintEventIndex(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEventIndex(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEventIndex(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *QueryContractEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEventIndex(uint64(l))
+	}
+	l = len(m.EventType)
+	if l > 0 {
+		n += 1 + l + sovEventIndex(uint64(l))
+	}
+	l = len(m.AttributeKey)
+	if l > 0 {
+		n += 1 + l + sovEventIndex(uint64(l))
+	}
+	l = len(m.AttributeValue)
+	if l > 0 {
+		n += 1 + l + sovEventIndex(uint64(l))
+	}
+	if m.MinHeight != 0 {
+		n += 1 + sovEventIndex(uint64(m.MinHeight))
+	}
+	if m.MaxHeight != 0 {
+		n += 1 + sovEventIndex(uint64(m.MaxHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovEventIndex(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovEventIndex(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovEventIndex(uint64(l))
+	}
+	return n
+}
+
+func (m *IndexedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEventIndex(uint64(m.Height))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovEventIndex(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEventIndex(uint64(m.Sequence))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEventIndex(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovEventIndex(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovEventIndex(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IndexedEventAttribute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovEventIndex(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovEventIndex(uint64(l))
+	}
+	return n
+}
+
+func sovEventIndex(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozEventIndex(x uint64) (n int) {
+	return sovEventIndex(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *QueryContractEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttributeKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttributeValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHeight", wireType)
+			}
+			m.MinHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeight", wireType)
+			}
+			m.MaxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *QueryContractEventsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractEventsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, IndexedEvent{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *IndexedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, IndexedEventAttribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *IndexedEventAttribute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedEventAttribute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedEventAttribute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEventIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func skipEventIndex(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEventIndex
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEventIndex
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEventIndex
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEventIndex
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEventIndex
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEventIndex
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEventIndex        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEventIndex          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEventIndex = fmt.Errorf("proto: unexpected end of group")
+)
@@ -26,6 +26,25 @@ const (
 	EventTypeUpdateContractAdmin    = "update_contract_admin"
 	EventTypeUpdateContractLabel    = "update_contract_label"
 	EventTypeUpdateCodeAccessConfig = "update_code_access_config"
+	EventTypeFreezeContract         = "freeze_contract"
+	EventTypeUnfreezeContract       = "unfreeze_contract"
+	EventTypeUpdateCodeStatus       = "update_code_status"
+	EventTypePurgeContract          = "purge_contract"
+	EventTypeAddCronSchedule        = "add_cron_schedule"
+	EventTypeRemoveCronSchedule     = "remove_cron_schedule"
+	EventTypeCronRun                = "cron_run"
+	EventTypeScheduleCallback       = "schedule_callback"
+	EventTypeCancelCallback         = "cancel_callback"
+	EventTypeCallback               = "scheduled_callback"
+	EventTypeSetFeeSponsorship      = "set_fee_sponsorship"
+	EventTypeFundFeeSponsorship     = "fund_fee_sponsorship"
+	EventTypeRemoveFeeSponsorship   = "remove_fee_sponsorship"
+	EventTypeSponsoredFee           = "sponsored_fee"
+	EventTypeUpdateExecuteConfig    = "update_contract_execute_config"
+	EventTypeUpdateAdminSet         = "update_contract_admin_set"
+	EventTypeApproveMigration       = "approve_migration"
+	EventTypeSetContractMetadata    = "set_contract_metadata"
+	EventTypeGasProfile             = "gas_profile"
 	EventTypePacketRecv             = "ibc_packet_received"
 	// add new types to IsAcceptedEventOnRecvPacketErrorAck
 )
@@ -64,7 +83,21 @@ const (
 	AttributeKeyNewAdmin            = "new_admin_address"
 	AttributeKeyNewLabel            = "new_label"
 	AttributeKeyCodePermission      = "code_permission"
+	AttributeKeyCodeStatus          = "code_status"
 	AttributeKeyAuthorizedAddresses = "authorized_addresses"
+	AttributeKeyBeneficiary         = "beneficiary"
+	AttributeKeyPurgeCompleted      = "completed"
+	AttributeKeyCronName            = "cron_name"
+	AttributeKeyCronSuccess         = "success"
+	AttributeKeyCronError           = "error"
+	AttributeKeyGasUsed             = "gas_used"
+	AttributeKeyCallbackID          = "callback_id"
+	AttributeKeyCallbackFee         = "fee"
+	AttributeKeyAdminSetMembers     = "admin_set_members"
+	AttributeKeyThreshold           = "threshold"
+	AttributeKeyApprover            = "approver"
+	AttributeKeyApprovals           = "approvals"
 	AttributeKeyAckSuccess          = "success"
 	AttributeKeyAckError            = "error"
+	AttributeKeyGasProfile          = "profile"
 )
@@ -20,13 +20,22 @@ type ViewKeeper interface {
 	GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *ContractInfo
 	IterateContractInfo(ctx context.Context, cb func(sdk.AccAddress, ContractInfo) bool)
 	IterateContractsByCreator(ctx context.Context, creator sdk.AccAddress, cb func(address sdk.AccAddress) bool)
+	IterateContractsByAdmin(ctx context.Context, admin sdk.AccAddress, cb func(address sdk.AccAddress) bool)
 	IterateContractsByCode(ctx context.Context, codeID uint64, cb func(address sdk.AccAddress) bool)
 	IterateContractState(ctx context.Context, contractAddress sdk.AccAddress, cb func(key, value []byte) bool)
+	IterateContractStateRange(ctx context.Context, contractAddress sdk.AccAddress, start, end []byte, reverse bool, cb func(key, value []byte) bool)
+	GetContractStorageStats(ctx context.Context, contractAddress sdk.AccAddress) ContractStorageStats
+	GetContractStorageDeposit(ctx context.Context, contractAddress sdk.AccAddress) sdk.Coins
+	GetCronSchedule(ctx context.Context, name string) *CronSchedule
+	GetFeeSponsorship(ctx context.Context, contractAddress sdk.AccAddress) *FeeSponsorship
+	GetMigrationApproval(ctx context.Context, contractAddress sdk.AccAddress, codeID uint64, msg RawContractMessage) *MigrationApproval
+	GetContractMetadata(ctx context.Context, contractAddress sdk.AccAddress) *ContractMetadata
 	GetCodeInfo(ctx context.Context, codeID uint64) *CodeInfo
 	IterateCodeInfos(ctx context.Context, cb func(uint64, CodeInfo) bool)
 	GetByteCode(ctx context.Context, codeID uint64) ([]byte, error)
 	IsPinnedCode(ctx context.Context, codeID uint64) bool
 	GetParams(ctx context.Context) Params
+	GetGasRegister() GasRegister
 }
 
 // ContractOpsKeeper contains mutable operations on a contract.
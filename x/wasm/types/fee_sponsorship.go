@@ -0,0 +1,69 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateBasic performs stateless validation of the fee sponsorship
+func (s FeeSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(s.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	for _, v := range []struct {
+		coins sdk.Coins
+		name  string
+	}{
+		{s.Balance, "balance"},
+		{s.MaxFeePerSender, "max fee per sender"},
+		{s.MaxFeePerBlock, "max fee per block"},
+		{s.BlockSpent, "block spent"},
+	} {
+		if !v.coins.IsValid() {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, v.name)
+		}
+	}
+	if s.BlockHeight < 0 {
+		return errorsmod.Wrap(ErrInvalid, "block height")
+	}
+	return nil
+}
+
+// SpentInBlock returns the total fee paid within the block at the given height
+func (s FeeSponsorship) SpentInBlock(height int64) sdk.Coins {
+	if s.BlockHeight != height {
+		return sdk.NewCoins()
+	}
+	return s.BlockSpent
+}
+
+// CanPay returns true when the fee is covered by the balance and does not exceed the per sender and per block limits.
+// The sender spent amount is the total fee that was paid for the sender before.
+func (s FeeSponsorship) CanPay(height int64, senderSpent, fee sdk.Coins) bool {
+	if !s.Balance.IsAllGTE(fee) {
+		return false
+	}
+	if !s.MaxFeePerSender.Empty() && !s.MaxFeePerSender.IsAllGTE(senderSpent.Add(fee...)) {
+		return false
+	}
+	if !s.MaxFeePerBlock.Empty() && !s.MaxFeePerBlock.IsAllGTE(s.SpentInBlock(height).Add(fee...)) {
+		return false
+	}
+	return true
+}
+
+// ValidateBasic performs stateless validation of the fee sponsorship usage
+func (u FeeSponsorshipUsage) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(u.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if _, err := sdk.AccAddressFromBech32(u.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if !u.Spent.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "spent")
+	}
+	return nil
+}
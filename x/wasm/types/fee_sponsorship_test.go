@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFeeSponsorshipCanPay(t *testing.T) {
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("denom", amount)) }
+	const height = 10
+	specs := map[string]struct {
+		src         FeeSponsorship
+		senderSpent sdk.Coins
+		fee         sdk.Coins
+		exp         bool
+	}{
+		"no limits": {
+			src: FeeSponsorship{Balance: coins(100)},
+			fee: coins(100),
+			exp: true,
+		},
+		"exceeds balance": {
+			src: FeeSponsorship{Balance: coins(100)},
+			fee: coins(101),
+		},
+		"other denom": {
+			src: FeeSponsorship{Balance: coins(100)},
+			fee: sdk.NewCoins(sdk.NewInt64Coin("other", 1)),
+		},
+		"within sender limit": {
+			src:         FeeSponsorship{Balance: coins(100), MaxFeePerSender: coins(30)},
+			senderSpent: coins(10),
+			fee:         coins(20),
+			exp:         true,
+		},
+		"exceeds sender limit": {
+			src:         FeeSponsorship{Balance: coins(100), MaxFeePerSender: coins(30)},
+			senderSpent: coins(10),
+			fee:         coins(21),
+		},
+		"within block limit": {
+			src: FeeSponsorship{Balance: coins(100), MaxFeePerBlock: coins(30), BlockHeight: height, BlockSpent: coins(10)},
+			fee: coins(20),
+			exp: true,
+		},
+		"exceeds block limit": {
+			src: FeeSponsorship{Balance: coins(100), MaxFeePerBlock: coins(30), BlockHeight: height, BlockSpent: coins(10)},
+			fee: coins(21),
+		},
+		"block spent of previous block": {
+			src: FeeSponsorship{Balance: coins(100), MaxFeePerBlock: coins(30), BlockHeight: height - 1, BlockSpent: coins(10)},
+			fee: coins(30),
+			exp: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, spec.exp, spec.src.CanPay(height, spec.senderSpent, spec.fee))
+		})
+	}
+}
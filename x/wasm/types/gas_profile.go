@@ -0,0 +1,134 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// steps of the gas profile
+const (
+	GasProfileStepInstantiate = "instantiate"
+	GasProfileStepExecute     = "execute"
+	GasProfileStepMigrate     = "migrate"
+	GasProfileStepSudo        = "sudo"
+	GasProfileStepReply       = "reply"
+	GasProfileStepQuery       = "query"
+	// GasProfileStepSetup is the setup cost of a contract call, see GasRegister.SetupContractCost and
+	// GasRegister.ReplyCosts
+	GasProfileStepSetup = "setup"
+	// GasProfileStepVM is the gas used by the wasm VM converted to SDK gas
+	GasProfileStepVM = "vm"
+	// GasProfileStepEvents is the cost of the events of a contract response
+	GasProfileStepEvents = "events"
+)
+
+// GasProfile is a node of the gas breakdown of contract calls. The gas of a node includes the gas of the children and
+// of all other operations of the step that are not recorded separately, like store access or bank transfers.
+type GasProfile struct {
+	Step     string        `json:"step"`
+	Contract string        `json:"contract,omitempty"`
+	Gas      uint64        `json:"gas"`
+	Children []*GasProfile `json:"children,omitempty"`
+}
+
+type gasProfileFrame struct {
+	node  *GasProfile
+	meter storetypes.GasMeter
+	start storetypes.Gas
+}
+
+// GasProfiler collects the gas breakdown of the contract calls of a tx as a tree. All methods can be called on a nil
+// profiler and do nothing then.
+type GasProfiler struct {
+	root  GasProfile
+	stack []gasProfileFrame
+}
+
+// NewGasProfiler constructor
+func NewGasProfiler() *GasProfiler {
+	return &GasProfiler{}
+}
+
+// Enter adds a node for the step to the current node and makes it the current node. The gas of the node is the gas
+// that the meter consumes until Exit is called.
+func (p *GasProfiler) Enter(step string, contractAddr sdk.AccAddress, meter storetypes.GasMeter) {
+	if p == nil {
+		return
+	}
+	node := &GasProfile{Step: step}
+	if contractAddr != nil {
+		node.Contract = contractAddr.String()
+	}
+	parent := p.current()
+	parent.Children = append(parent.Children, node)
+	p.stack = append(p.stack, gasProfileFrame{node: node, meter: meter, start: meter.GasConsumed()})
+}
+
+// Exit sets the gas of the current node and makes the parent node the current node
+func (p *GasProfiler) Exit() {
+	if p == nil || len(p.stack) == 0 {
+		return
+	}
+	frame := p.stack[len(p.stack)-1]
+	if consumed := frame.meter.GasConsumed(); consumed > frame.start {
+		frame.node.Gas = consumed - frame.start
+	}
+	p.stack = p.stack[:len(p.stack)-1]
+}
+
+// ExitWithError exits the current step like Exit. When the step is a top level call that failed or ran out of gas, the
+// gas profiles are added to the error or to the out of gas descriptor, as failed simulations do not return the events
+// of the post handler. It must be deferred directly so that it can recover the out of gas panic, which is re-thrown.
+func (p *GasProfiler) ExitWithError(err *error) {
+	if p == nil || len(p.stack) != 1 {
+		p.Exit()
+		return
+	}
+	r := recover()
+	p.Exit()
+	switch oog, ok := r.(storetypes.ErrorOutOfGas); {
+	case ok:
+		oog.Descriptor = fmt.Sprintf("%s; gas profile: %s", oog.Descriptor, mustMarshalJSON(p.Profiles()))
+		panic(oog)
+	case r != nil:
+		panic(r)
+	case *err != nil:
+		*err = errorsmod.Wrapf(*err, "gas profile: %s", mustMarshalJSON(p.Profiles()))
+	}
+}
+
+// SetContract sets the contract address of the current node
+func (p *GasProfiler) SetContract(contractAddr sdk.AccAddress) {
+	if p == nil || len(p.stack) == 0 {
+		return
+	}
+	p.current().Contract = contractAddr.String()
+}
+
+// Record adds a leaf with the gas of a step to the current node. Steps without gas are not recorded.
+func (p *GasProfiler) Record(step string, gas storetypes.Gas) {
+	if p == nil || gas == 0 {
+		return
+	}
+	parent := p.current()
+	parent.Children = append(parent.Children, &GasProfile{Step: step, Gas: gas})
+}
+
+// Profiles returns the gas profiles of the top level contract calls
+func (p *GasProfiler) Profiles() []*GasProfile {
+	if p == nil {
+		return nil
+	}
+	return p.root.Children
+}
+
+func (p *GasProfiler) current() *GasProfile {
+	if len(p.stack) == 0 {
+		return &p.root
+	}
+	return p.stack[len(p.stack)-1].node
+}
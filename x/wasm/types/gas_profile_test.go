@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGasProfiler(t *testing.T) {
+	myContract, otherContract := sdk.AccAddress("myContract"), sdk.AccAddress("otherContract")
+	meter := storetypes.NewInfiniteGasMeter()
+	p := NewGasProfiler()
+
+	// when
+	p.Enter(GasProfileStepInstantiate, nil, meter)
+	meter.ConsumeGas(10, "setup")
+	p.Record(GasProfileStepSetup, 10)
+	p.SetContract(myContract)
+	subMeter := storetypes.NewGasMeter(100)
+	p.Enter(GasProfileStepQuery, otherContract, subMeter)
+	subMeter.ConsumeGas(5, "vm")
+	p.Record(GasProfileStepVM, 5)
+	p.Record(GasProfileStepEvents, 0)
+	p.Exit()
+	meter.ConsumeGas(subMeter.GasConsumed()+1, "query")
+	p.Exit()
+	p.Exit()
+
+	// then
+	exp := []*GasProfile{{
+		Step:     GasProfileStepInstantiate,
+		Contract: myContract.String(),
+		Gas:      16,
+		Children: []*GasProfile{
+			{Step: GasProfileStepSetup, Gas: 10},
+			{
+				Step:     GasProfileStepQuery,
+				Contract: otherContract.String(),
+				Gas:      5,
+				Children: []*GasProfile{{Step: GasProfileStepVM, Gas: 5}},
+			},
+		},
+	}}
+	assert.Equal(t, exp, p.Profiles())
+}
+
+func TestNilGasProfiler(t *testing.T) {
+	var p *GasProfiler
+	assert.NotPanics(t, func() {
+		p.Enter(GasProfileStepExecute, nil, storetypes.NewInfiniteGasMeter())
+		p.Record(GasProfileStepSetup, 1)
+		p.SetContract(sdk.AccAddress("myContract"))
+		p.Exit()
+	})
+	assert.Nil(t, p.Profiles())
+}
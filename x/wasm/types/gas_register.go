@@ -144,6 +144,56 @@ func DefaultGasRegisterConfig() WasmGasRegisterConfig {
 	}
 }
 
+// NewGasCosts returns the gas costs of the gas register config
+func NewGasCosts(c WasmGasRegisterConfig) GasCosts {
+	return GasCosts{
+		InstanceCost:               c.InstanceCost,
+		InstanceCostDiscount:       c.InstanceCostDiscount,
+		CompileCost:                c.CompileCost,
+		UncompressCostNumerator:    c.UncompressCost.Numerator,
+		UncompressCostDenominator:  c.UncompressCost.Denominator,
+		GasMultiplier:              c.GasMultiplier,
+		EventPerAttributeCost:      c.EventPerAttributeCost,
+		EventAttributeDataCost:     c.EventAttributeDataCost,
+		EventAttributeDataFreeTier: c.EventAttributeDataFreeTier,
+		ContractMessageDataCost:    c.ContractMessageDataCost,
+		CustomEventCost:            c.CustomEventCost,
+	}
+}
+
+// GasRegisterConfig returns the gas register config with the gas costs
+func (c GasCosts) GasRegisterConfig() WasmGasRegisterConfig {
+	return WasmGasRegisterConfig{
+		InstanceCost:         c.InstanceCost,
+		InstanceCostDiscount: c.InstanceCostDiscount,
+		CompileCost:          c.CompileCost,
+		UncompressCost: wasmvmtypes.UFraction{
+			Numerator:   c.UncompressCostNumerator,
+			Denominator: c.UncompressCostDenominator,
+		},
+		GasMultiplier:              c.GasMultiplier,
+		EventPerAttributeCost:      c.EventPerAttributeCost,
+		EventAttributeDataCost:     c.EventAttributeDataCost,
+		EventAttributeDataFreeTier: c.EventAttributeDataFreeTier,
+		ContractMessageDataCost:    c.ContractMessageDataCost,
+		CustomEventCost:            c.CustomEventCost,
+	}
+}
+
+// ValidateBasic performs basic validation
+func (c GasCosts) ValidateBasic() error {
+	if c.GasMultiplier == 0 {
+		return errorsmod.Wrap(ErrEmpty, "gas multiplier")
+	}
+	if c.UncompressCostDenominator == 0 {
+		return errorsmod.Wrap(ErrEmpty, "uncompress cost denominator")
+	}
+	if c.InstanceCostDiscount > c.InstanceCost {
+		return errorsmod.Wrap(ErrInvalid, "instance cost discount must not exceed the instance cost")
+	}
+	return nil
+}
+
 // WasmGasRegister implements GasRegister interface
 type WasmGasRegister struct {
 	c WasmGasRegisterConfig
@@ -164,6 +214,11 @@ func NewWasmGasRegister(c WasmGasRegisterConfig) WasmGasRegister {
 	}
 }
 
+// Config returns the gas register config
+func (g WasmGasRegister) Config() WasmGasRegisterConfig {
+	return g.c
+}
+
 // UncompressCosts costs to unpack a new wasm contract
 func (g WasmGasRegister) UncompressCosts(byteLength int) storetypes.Gas {
 	if byteLength < 0 {
@@ -367,3 +367,41 @@ func TestUncompressCosts(t *testing.T) {
 		})
 	}
 }
+
+func TestGasCostsValidateBasic(t *testing.T) {
+	specs := map[string]struct {
+		src    GasCosts
+		expErr bool
+	}{
+		"defaults": {
+			src: NewGasCosts(DefaultGasRegisterConfig()),
+		},
+		"zero costs": {
+			src: GasCosts{GasMultiplier: 1, UncompressCostDenominator: 1},
+		},
+		"gas multiplier empty": {
+			src:    GasCosts{UncompressCostDenominator: 1},
+			expErr: true,
+		},
+		"uncompress cost denominator empty": {
+			src:    GasCosts{GasMultiplier: 1},
+			expErr: true,
+		},
+		"instance cost discount exceeds instance cost": {
+			src:    GasCosts{GasMultiplier: 1, UncompressCostDenominator: 1, InstanceCost: 1, InstanceCostDiscount: 2},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := spec.src.ValidateBasic()
+			if spec.expErr {
+				assert.Error(t, gotErr)
+				return
+			}
+			assert.NoError(t, gotErr)
+			// and the config round trips
+			assert.Equal(t, spec.src, NewGasCosts(spec.src.GasRegisterConfig()))
+		})
+	}
+}
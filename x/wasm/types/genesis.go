@@ -39,6 +39,68 @@ func (s GenesisState) ValidateBasic() error {
 			return errorsmod.Wrapf(err, "gen message: %d", i)
 		}
 	}
+	for i := range s.PurgedContracts {
+		if _, err := sdk.AccAddressFromBech32(s.PurgedContracts[i]); err != nil {
+			return errorsmod.Wrapf(err, "purged contract: %d", i)
+		}
+	}
+	cronNames := make(map[string]struct{}, len(s.CronSchedules))
+	for i := range s.CronSchedules {
+		if err := s.CronSchedules[i].ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "cron schedule: %d", i)
+		}
+		if _, exists := cronNames[s.CronSchedules[i].Name]; exists {
+			return errorsmod.Wrapf(ErrDuplicate, "cron schedule name: %s", s.CronSchedules[i].Name)
+		}
+		cronNames[s.CronSchedules[i].Name] = struct{}{}
+	}
+	callbackIDs := make(map[uint64]struct{}, len(s.Callbacks))
+	for i := range s.Callbacks {
+		if err := s.Callbacks[i].ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "callback: %d", i)
+		}
+		if _, exists := callbackIDs[s.Callbacks[i].ID]; exists {
+			return errorsmod.Wrapf(ErrDuplicate, "callback id: %d", s.Callbacks[i].ID)
+		}
+		callbackIDs[s.Callbacks[i].ID] = struct{}{}
+	}
+	sponsoredContracts := make(map[string]struct{}, len(s.FeeSponsorships))
+	for i := range s.FeeSponsorships {
+		if err := s.FeeSponsorships[i].ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "fee sponsorship: %d", i)
+		}
+		if _, exists := sponsoredContracts[s.FeeSponsorships[i].Contract]; exists {
+			return errorsmod.Wrapf(ErrDuplicate, "fee sponsorship contract: %s", s.FeeSponsorships[i].Contract)
+		}
+		sponsoredContracts[s.FeeSponsorships[i].Contract] = struct{}{}
+	}
+	for i := range s.FeeSponsorshipUsages {
+		if err := s.FeeSponsorshipUsages[i].ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "fee sponsorship usage: %d", i)
+		}
+	}
+	approvedProposals := make(map[string]struct{}, len(s.MigrationApprovals))
+	for i := range s.MigrationApprovals {
+		if err := s.MigrationApprovals[i].ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "migration approval: %d", i)
+		}
+		key := s.MigrationApprovals[i].Contract + string(s.MigrationApprovals[i].ProposalID())
+		if _, exists := approvedProposals[key]; exists {
+			return errorsmod.Wrapf(ErrDuplicate, "migration approval contract: %s", s.MigrationApprovals[i].Contract)
+		}
+		approvedProposals[key] = struct{}{}
+	}
+	dependencies := make(map[string]struct{}, len(s.ContractDependencies))
+	for i := range s.ContractDependencies {
+		if err := s.ContractDependencies[i].ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "contract dependency: %d", i)
+		}
+		key := s.ContractDependencies[i].Caller + "/" + s.ContractDependencies[i].Callee
+		if _, exists := dependencies[key]; exists {
+			return errorsmod.Wrapf(ErrDuplicate, "contract dependency: %s", key)
+		}
+		dependencies[key] = struct{}{}
+	}
 	return nil
 }
 
@@ -79,6 +141,23 @@ func (c Contract) ValidateBasic() error {
 			return errorsmod.Wrapf(err, "code history element %d", i)
 		}
 	}
+	if c.StorageStats != nil {
+		var stats ContractStorageStats
+		for _, m := range c.ContractState {
+			stats.Add(m.Key, m.Value)
+		}
+		if stats != *c.StorageStats {
+			return errorsmod.Wrapf(ErrInvalid, "storage stats do not match contract state: got %s, expected %s", c.StorageStats, &stats)
+		}
+	}
+	if c.Metadata != nil {
+		if err := c.Metadata.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "metadata")
+		}
+	}
+	if err := c.StorageDeposit.Validate(); err != nil {
+		return errorsmod.Wrap(err, "storage deposit")
+	}
 	return nil
 }
 
@@ -10,6 +10,8 @@ import (
 	math_bits "math/bits"
 
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -35,6 +37,22 @@ type GenesisState struct {
 	Contracts []Contract             `protobuf:"bytes,3,rep,name=contracts,proto3" json:"contracts,omitempty"`
 	Sequences []Sequence             `protobuf:"bytes,4,rep,name=sequences,proto3" json:"sequences,omitempty"`
 	GenMsgs   []GenesisState_GenMsgs `protobuf:"bytes,5,rep,name=gen_msgs,json=genMsgs,proto3" json:"gen_msgs,omitempty"`
+	// PurgedContracts are the addresses of removed contracts that must not be
+	// used again
+	PurgedContracts []string `protobuf:"bytes,6,rep,name=purged_contracts,json=purgedContracts,proto3" json:"purged_contracts,omitempty"`
+	// CronSchedules are the contract calls executed at the end of a block
+	CronSchedules []CronSchedule `protobuf:"bytes,7,rep,name=cron_schedules,json=cronSchedules,proto3" json:"cron_schedules,omitempty"`
+	// Callbacks are the pending calls that contracts scheduled to themselves
+	Callbacks []Callback `protobuf:"bytes,8,rep,name=callbacks,proto3" json:"callbacks,omitempty"`
+	// FeeSponsorships are the contracts that pay the tx fees for calls to them
+	FeeSponsorships []FeeSponsorship `protobuf:"bytes,9,rep,name=fee_sponsorships,json=feeSponsorships,proto3" json:"fee_sponsorships,omitempty"`
+	// FeeSponsorshipUsages are the total fees paid per contract and sender
+	FeeSponsorshipUsages []FeeSponsorshipUsage `protobuf:"bytes,10,rep,name=fee_sponsorship_usages,json=feeSponsorshipUsages,proto3" json:"fee_sponsorship_usages,omitempty"`
+	// MigrationApprovals are the pending migrations of contracts with an admin
+	// set
+	MigrationApprovals []MigrationApproval `protobuf:"bytes,11,rep,name=migration_approvals,json=migrationApprovals,proto3" json:"migration_approvals,omitempty"`
+	// ContractDependencies are the recorded calls between contracts
+	ContractDependencies []ContractDependency `protobuf:"bytes,12,rep,name=contract_dependencies,json=contractDependencies,proto3" json:"contract_dependencies,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -110,6 +128,55 @@ func (m *GenesisState) GetGenMsgs() []GenesisState_GenMsgs {
 	return nil
 }
 
+func (m *GenesisState) GetPurgedContracts() []string {
+	if m != nil {
+		return m.PurgedContracts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCronSchedules() []CronSchedule {
+	if m != nil {
+		return m.CronSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCallbacks() []Callback {
+	if m != nil {
+		return m.Callbacks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeSponsorships() []FeeSponsorship {
+	if m != nil {
+		return m.FeeSponsorships
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeSponsorshipUsages() []FeeSponsorshipUsage {
+	if m != nil {
+		return m.FeeSponsorshipUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMigrationApprovals() []MigrationApproval {
+	if m != nil {
+		return m.MigrationApprovals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContractDependencies() []ContractDependency {
+	if m != nil {
+		return m.ContractDependencies
+	}
+	return nil
+}
+
 // GenMsgs define the messages that can be executed during genesis phase in
 // order. The intention is to have more human readable data that is auditable.
 type GenesisState_GenMsgs struct {
@@ -298,6 +365,14 @@ type Contract struct {
 	ContractInfo        ContractInfo               `protobuf:"bytes,2,opt,name=contract_info,json=contractInfo,proto3" json:"contract_info"`
 	ContractState       []Model                    `protobuf:"bytes,3,rep,name=contract_state,json=contractState,proto3" json:"contract_state"`
 	ContractCodeHistory []ContractCodeHistoryEntry `protobuf:"bytes,4,rep,name=contract_code_history,json=contractCodeHistory,proto3" json:"contract_code_history"`
+	// StorageStats is optional and calculated from the contract state when not
+	// set
+	StorageStats *ContractStorageStats `protobuf:"bytes,5,opt,name=storage_stats,json=storageStats,proto3" json:"storage_stats,omitempty"`
+	// Metadata is the optional descriptive information of the contract
+	Metadata *ContractMetadata `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// StorageDeposit is the storage deposit that is held in escrow for the
+	// contract
+	StorageDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=storage_deposit,json=storageDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"storage_deposit"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -366,6 +441,27 @@ func (m *Contract) GetContractCodeHistory() []ContractCodeHistoryEntry {
 	return nil
 }
 
+func (m *Contract) GetStorageStats() *ContractStorageStats {
+	if m != nil {
+		return m.StorageStats
+	}
+	return nil
+}
+
+func (m *Contract) GetMetadata() *ContractMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Contract) GetStorageDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.StorageDeposit
+	}
+	return nil
+}
+
 // Sequence key and value of an id generation counter
 type Sequence struct {
 	IDKey []byte `protobuf:"bytes,1,opt,name=id_key,json=idKey,proto3" json:"id_key,omitempty"`
@@ -435,52 +531,77 @@ func init() {
 func init() { proto.RegisterFile("cosmwasm/wasm/v1/genesis.proto", fileDescriptor_2ab3f539b23472a6) }
 
 var fileDescriptor_2ab3f539b23472a6 = []byte{
-	// 718 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x4d, 0x4f, 0x13, 0x41,
-	0x18, 0xc7, 0xbb, 0x7d, 0xa3, 0x1d, 0xaa, 0xe0, 0x50, 0x71, 0x6d, 0x70, 0xdb, 0x54, 0x43, 0x1a,
-	0xa2, 0x6d, 0xc0, 0xa3, 0x07, 0x75, 0x81, 0x48, 0x25, 0x18, 0xdd, 0x1e, 0x4c, 0x48, 0x48, 0xb3,
-	0xec, 0x0e, 0xcb, 0x44, 0x76, 0xa7, 0x76, 0xa6, 0xc8, 0x9e, 0xfd, 0x02, 0x7e, 0x0a, 0xe3, 0xd1,
-	0x03, 0x1f, 0x82, 0x23, 0x31, 0x31, 0xf1, 0xd4, 0x98, 0x72, 0x30, 0xf1, 0x53, 0x98, 0x79, 0xd9,
-	0xed, 0xca, 0x96, 0xcb, 0xa6, 0x33, 0xff, 0xff, 0xf3, 0x9b, 0x79, 0x9e, 0x3e, 0xcf, 0x00, 0xc3,
-	0x21, 0xd4, 0xff, 0x64, 0x53, 0xbf, 0x23, 0x3e, 0xa7, 0xeb, 0x1d, 0x0f, 0x05, 0x88, 0x62, 0xda,
-	0x1e, 0x0c, 0x09, 0x23, 0x70, 0x31, 0xd2, 0xdb, 0xe2, 0x73, 0xba, 0x5e, 0xab, 0x7a, 0xc4, 0x23,
-	0x42, 0xec, 0xf0, 0x5f, 0xd2, 0x57, 0x5b, 0x49, 0x71, 0x58, 0x38, 0x40, 0x8a, 0x52, 0xbb, 0x9f,
-	0x56, 0xcf, 0x94, 0x74, 0xc7, 0xf6, 0x71, 0x40, 0x3a, 0xe2, 0x9b, 0x74, 0x13, 0xda, 0x97, 0x87,
-	0xc8, 0x85, 0x94, 0x9a, 0xe7, 0x05, 0x50, 0x79, 0x25, 0x2f, 0xd8, 0x63, 0x36, 0x43, 0xf0, 0x19,
-	0x28, 0x0e, 0xec, 0xa1, 0xed, 0x53, 0x5d, 0x6b, 0x68, 0xad, 0xf9, 0x0d, 0xbd, 0x7d, 0xfd, 0xc2,
-	0xed, 0xb7, 0x42, 0x37, 0xcb, 0x17, 0xe3, 0x7a, 0xe6, 0xdb, 0x9f, 0xef, 0x6b, 0x9a, 0xa5, 0x42,
-	0xe0, 0x6b, 0x50, 0x70, 0x88, 0x8b, 0xa8, 0x9e, 0x6d, 0xe4, 0x5a, 0xf3, 0x1b, 0xcb, 0xe9, 0xd8,
-	0x4d, 0xe2, 0x22, 0x73, 0x85, 0x47, 0xfe, 0x1d, 0xd7, 0x17, 0x84, 0xf9, 0x31, 0xf1, 0x31, 0x43,
-	0xfe, 0x80, 0x85, 0x12, 0x26, 0x11, 0x70, 0x1f, 0x94, 0x1d, 0x12, 0xb0, 0xa1, 0xed, 0x30, 0xaa,
-	0xe7, 0x04, 0xaf, 0x36, 0x8b, 0x27, 0x2d, 0x66, 0x43, 0x31, 0x97, 0xe2, 0xa0, 0xeb, 0xdc, 0x29,
-	0x8e, 0xb3, 0x29, 0xfa, 0x38, 0x42, 0x81, 0x83, 0xa8, 0x9e, 0xbf, 0x89, 0xdd, 0x53, 0x96, 0x29,
-	0x3b, 0x0e, 0x4a, 0xb1, 0x63, 0x05, 0x1e, 0x80, 0x92, 0x87, 0x82, 0xbe, 0x4f, 0x3d, 0xaa, 0x17,
-	0x04, 0x7a, 0x35, 0x8d, 0x4e, 0x96, 0x9c, 0x2f, 0xf6, 0xa8, 0x47, 0xcd, 0x9a, 0x3a, 0x06, 0x46,
-	0xf1, 0xd3, 0x53, 0xac, 0x39, 0x4f, 0x9a, 0x6a, 0x9f, 0xb3, 0x60, 0x4e, 0x05, 0xc0, 0xe7, 0x00,
-	0x50, 0x46, 0x86, 0xa8, 0xcf, 0x2b, 0xa6, 0xfe, 0x2f, 0x23, 0x7d, 0xd8, 0x1e, 0xf5, 0x7a, 0xdc,
-	0xc6, 0x6b, 0xbf, 0x93, 0xb1, 0xca, 0x34, 0x5a, 0xc0, 0x03, 0x50, 0xc5, 0x01, 0x65, 0x76, 0xc0,
-	0xb0, 0xcd, 0x38, 0x46, 0x16, 0x48, 0xcf, 0x0a, 0x54, 0x6b, 0x26, 0xaa, 0x3b, 0x0d, 0x88, 0x8a,
-	0xbf, 0x93, 0xb1, 0x96, 0x70, 0x7a, 0x1b, 0xbe, 0x03, 0x8b, 0xe8, 0x0c, 0x39, 0xa3, 0x24, 0x3a,
-	0x27, 0xd0, 0x8f, 0x66, 0xa2, 0xb7, 0xa5, 0x39, 0x81, 0x5d, 0x40, 0xff, 0x6f, 0x99, 0x05, 0x90,
-	0xa3, 0x23, 0xbf, 0xf9, 0x55, 0x03, 0x79, 0x91, 0xc1, 0x43, 0x30, 0xc7, 0x93, 0xef, 0x63, 0x57,
-	0xe4, 0x9f, 0x37, 0xc1, 0x64, 0x5c, 0x2f, 0x72, 0xa9, 0xbb, 0x65, 0x15, 0xb9, 0xd4, 0x75, 0xa1,
-	0xc9, 0x5b, 0x89, 0x9b, 0x82, 0x23, 0xa2, 0x72, 0xab, 0xcd, 0x6e, 0xcd, 0x6e, 0x70, 0x44, 0x92,
-	0x8d, 0x5d, 0x72, 0xd4, 0x26, 0x7c, 0x00, 0x80, 0x60, 0x1c, 0x86, 0x0c, 0x51, 0x91, 0x45, 0xc5,
-	0x12, 0x54, 0x93, 0x6f, 0xc0, 0x65, 0x50, 0x1c, 0xe0, 0x20, 0x40, 0xae, 0x9e, 0x6f, 0x68, 0xad,
-	0x92, 0xa5, 0x56, 0xcd, 0x9f, 0x59, 0x50, 0x8a, 0xeb, 0xb1, 0x09, 0x16, 0xa3, 0x3a, 0xf4, 0x6d,
-	0xd7, 0x1d, 0x22, 0x2a, 0xa7, 0xac, 0x6c, 0xea, 0x3f, 0xce, 0x9f, 0x54, 0xd5, 0x60, 0xbe, 0x94,
-	0x4a, 0x8f, 0x0d, 0x71, 0xe0, 0x59, 0x0b, 0x51, 0x84, 0xda, 0x86, 0x6f, 0xc0, 0xad, 0x18, 0x92,
-	0x48, 0xc8, 0xb8, 0x79, 0x36, 0xae, 0x27, 0x55, 0x71, 0x12, 0x02, 0xec, 0x82, 0xdb, 0x31, 0x8f,
-	0xf2, 0x7e, 0x54, 0xc3, 0x76, 0x6f, 0xc6, 0x5f, 0x44, 0x5c, 0x74, 0x92, 0x24, 0xc5, 0x37, 0x91,
-	0x6f, 0x07, 0x06, 0x77, 0x63, 0x94, 0x28, 0xd6, 0x31, 0xe6, 0xbd, 0x16, 0xaa, 0x11, 0x5b, 0xbb,
-	0xf9, 0x8a, 0xa2, 0x35, 0xa5, 0x79, 0x3b, 0x60, 0xc3, 0x30, 0x79, 0x48, 0x3c, 0xd1, 0x09, 0x53,
-	0xd3, 0x04, 0xa5, 0x68, 0x3c, 0x61, 0x03, 0x14, 0xb1, 0xdb, 0xff, 0x80, 0x42, 0x51, 0xcc, 0x8a,
-	0x59, 0x9e, 0x8c, 0xeb, 0x85, 0xee, 0xd6, 0x2e, 0x0a, 0xad, 0x02, 0x76, 0x77, 0x51, 0x08, 0xab,
-	0xa0, 0x70, 0x6a, 0x9f, 0x8c, 0x90, 0xa8, 0x55, 0xde, 0x92, 0x0b, 0xf3, 0xc5, 0xc5, 0xc4, 0xd0,
-	0x2e, 0x27, 0x86, 0xf6, 0x7b, 0x62, 0x68, 0x5f, 0xae, 0x8c, 0xcc, 0xe5, 0x95, 0x91, 0xf9, 0x75,
-	0x65, 0x64, 0xf6, 0x57, 0x3d, 0xcc, 0x8e, 0x47, 0x87, 0x6d, 0x87, 0xf8, 0x9d, 0x4d, 0x42, 0xfd,
-	0xf7, 0xd1, 0x4b, 0xeb, 0x76, 0xce, 0xe4, 0x8b, 0x2b, 0x1e, 0xe3, 0xc3, 0xa2, 0x78, 0x44, 0x9f,
-	0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x5a, 0xe0, 0xc5, 0x38, 0xf5, 0x05, 0x00, 0x00,
+	// 1115 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xbd, 0x8d, 0xed, 0xd8, 0x13, 0x37, 0x09, 0x93, 0x10, 0xb6, 0xa6, 0xb5, 0x2d, 0x97,
+	0x16, 0x2b, 0xa2, 0xb6, 0x52, 0x24, 0x2e, 0x48, 0x40, 0xd7, 0x69, 0xa9, 0x89, 0x82, 0x60, 0xad,
+	0x0a, 0xa9, 0x52, 0xb5, 0x1a, 0xef, 0x4e, 0x36, 0xa3, 0x78, 0x77, 0x96, 0x7d, 0xe3, 0x10, 0x8b,
+	0x1b, 0x88, 0x0b, 0x27, 0xce, 0xfc, 0x01, 0x08, 0x71, 0xea, 0x81, 0x3f, 0xa2, 0xc7, 0xaa, 0x27,
+	0x4e, 0x01, 0x25, 0x87, 0x4a, 0xbd, 0xf0, 0x2f, 0xa0, 0x9d, 0x9d, 0x5d, 0x6f, 0xec, 0xb5, 0xb8,
+	0xac, 0x3d, 0xfb, 0xbe, 0xef, 0xf3, 0x7e, 0xcc, 0xec, 0xcc, 0xa0, 0x86, 0xcd, 0xc1, 0xfb, 0x8e,
+	0x80, 0xd7, 0x93, 0x8f, 0xd3, 0xbd, 0x9e, 0x4b, 0x7d, 0x0a, 0x0c, 0xba, 0x41, 0xc8, 0x05, 0xc7,
+	0x9b, 0x89, 0xbd, 0x2b, 0x1f, 0xa7, 0x7b, 0xf5, 0x6d, 0x97, 0xbb, 0x5c, 0x1a, 0x7b, 0xd1, 0xbf,
+	0x58, 0x57, 0xbf, 0xb9, 0xc0, 0x11, 0xd3, 0x80, 0x2a, 0x4a, 0xfd, 0xc6, 0xa2, 0xf5, 0x4c, 0x99,
+	0xde, 0x22, 0x1e, 0xf3, 0x79, 0x4f, 0x3e, 0xb3, 0x6a, 0x0e, 0x56, 0x1c, 0x24, 0x1e, 0x28, 0x53,
+	0x23, 0x1e, 0xf5, 0x46, 0x04, 0x68, 0xef, 0x74, 0x6f, 0x44, 0x05, 0xd9, 0xeb, 0xd9, 0x9c, 0xf9,
+	0xb1, 0xbd, 0xfd, 0x6a, 0x0d, 0xd5, 0x3e, 0x8f, 0x0b, 0x18, 0x0a, 0x22, 0x28, 0xfe, 0x18, 0x95,
+	0x03, 0x12, 0x12, 0x0f, 0x74, 0xad, 0xa5, 0x75, 0xd6, 0xee, 0xeb, 0xdd, 0xf9, 0x82, 0xba, 0x5f,
+	0x49, 0xbb, 0x51, 0x7d, 0x71, 0xde, 0x2c, 0xfc, 0xfe, 0xfa, 0xf9, 0xae, 0x66, 0x2a, 0x17, 0xfc,
+	0x05, 0x2a, 0xd9, 0xdc, 0xa1, 0xa0, 0x5f, 0x6b, 0xad, 0x74, 0xd6, 0xee, 0xef, 0x2c, 0xfa, 0xf6,
+	0xb9, 0x43, 0x8d, 0x9b, 0x91, 0xe7, 0x9b, 0xf3, 0xe6, 0x86, 0x14, 0x7f, 0xc0, 0x3d, 0x26, 0xa8,
+	0x17, 0x88, 0x69, 0x0c, 0x8b, 0x11, 0xf8, 0x29, 0xaa, 0xda, 0xdc, 0x17, 0x21, 0xb1, 0x05, 0xe8,
+	0x2b, 0x92, 0x57, 0xcf, 0xe3, 0xc5, 0x12, 0xa3, 0xa5, 0x98, 0x5b, 0xa9, 0xd3, 0x3c, 0x77, 0x86,
+	0x8b, 0xd8, 0x40, 0xbf, 0x9d, 0x50, 0xdf, 0xa6, 0xa0, 0x17, 0x97, 0xb1, 0x87, 0x4a, 0x32, 0x63,
+	0xa7, 0x4e, 0x0b, 0xec, 0xd4, 0x82, 0x9f, 0xa1, 0x8a, 0x4b, 0x7d, 0xcb, 0x03, 0x17, 0xf4, 0x92,
+	0x44, 0xdf, 0x5d, 0x44, 0x67, 0x5b, 0x1e, 0x0d, 0x0e, 0xc1, 0x05, 0xa3, 0xae, 0xc2, 0xe0, 0xc4,
+	0x7f, 0x16, 0xc5, 0x5c, 0x75, 0x63, 0x11, 0x26, 0x68, 0x33, 0x98, 0x84, 0x2e, 0x75, 0xac, 0x59,
+	0x77, 0xca, 0xad, 0x95, 0x4e, 0xd5, 0xf8, 0xe8, 0xcd, 0x79, 0xb3, 0x3e, 0x6f, 0x9b, 0x21, 0x5e,
+	0xfd, 0x79, 0x6f, 0x5b, 0x2d, 0x8d, 0x07, 0x8e, 0x13, 0x52, 0x80, 0xa1, 0x08, 0x99, 0xef, 0x9a,
+	0x1b, 0xb1, 0x4f, 0x3f, 0xed, 0x8e, 0x8b, 0xd6, 0xed, 0x90, 0xfb, 0x16, 0xd8, 0xc7, 0xd4, 0x99,
+	0x8c, 0x29, 0xe8, 0xab, 0xb2, 0x8e, 0x46, 0x4e, 0xfb, 0x43, 0xee, 0x0f, 0x95, 0x2c, 0x6d, 0x93,
+	0x7e, 0xd5, 0x3b, 0x53, 0xc5, 0x75, 0x3b, 0xa3, 0x07, 0xfc, 0x04, 0x55, 0x6d, 0x32, 0x1e, 0x8f,
+	0x88, 0x7d, 0x02, 0x7a, 0x65, 0xe9, 0x14, 0x2b, 0x89, 0xf1, 0x6e, 0x3a, 0xc5, 0x89, 0x53, 0x06,
+	0x3d, 0x23, 0x61, 0x8e, 0x36, 0x8f, 0x28, 0xb5, 0x20, 0xe0, 0x3e, 0xf0, 0x10, 0x8e, 0x59, 0x00,
+	0x7a, 0x55, 0xd2, 0x5b, 0x8b, 0xf4, 0x47, 0x94, 0x0e, 0x67, 0x42, 0xa3, 0xad, 0x62, 0xd4, 0xe7,
+	0x09, 0x99, 0x50, 0x1b, 0x47, 0x57, 0x7c, 0x00, 0xff, 0xa4, 0xa1, 0x9d, 0x39, 0xbd, 0x35, 0x01,
+	0xe2, 0x52, 0xd0, 0x91, 0x8c, 0x7b, 0xe7, 0xff, 0xe2, 0x3e, 0x89, 0xd4, 0x46, 0x47, 0x05, 0x6f,
+	0xe5, 0xc3, 0x32, 0x29, 0x6c, 0x1f, 0x2d, 0xba, 0x03, 0xfe, 0x1e, 0x6d, 0x79, 0xcc, 0x0d, 0x89,
+	0x60, 0xdc, 0xb7, 0x48, 0x10, 0x84, 0xfc, 0x94, 0x8c, 0x41, 0x5f, 0x93, 0x39, 0xdc, 0x5e, 0xcc,
+	0xe1, 0x30, 0x11, 0x3f, 0x50, 0x5a, 0xe3, 0x8e, 0xca, 0xe0, 0x56, 0x0e, 0x27, 0x13, 0x1e, 0x7b,
+	0xf3, 0x9e, 0x80, 0x7f, 0xd0, 0xd0, 0xdb, 0xc9, 0xb2, 0xb3, 0x1c, 0x1a, 0x50, 0xdf, 0xa1, 0xbe,
+	0xcd, 0x28, 0xe8, 0x35, 0x19, 0xff, 0xbd, 0xe5, 0x1f, 0xef, 0x7e, 0xa2, 0x9e, 0x1a, 0xef, 0xab,
+	0x04, 0x9a, 0xb9, 0xa8, 0x6c, 0x07, 0xec, 0x79, 0x67, 0x46, 0xa1, 0xfe, 0xe3, 0x35, 0xb4, 0xaa,
+	0x3e, 0x27, 0xfc, 0x29, 0x42, 0x20, 0x78, 0x48, 0xad, 0x68, 0x3f, 0x51, 0xbb, 0x59, 0xce, 0x12,
+	0x3e, 0x04, 0x77, 0x18, 0xc9, 0xa2, 0x9d, 0xe9, 0x71, 0xc1, 0xac, 0x42, 0x32, 0xc0, 0xcf, 0xd0,
+	0x36, 0xf3, 0x41, 0x10, 0x5f, 0x30, 0x22, 0x68, 0xfa, 0x4d, 0xe9, 0xd7, 0x24, 0xaa, 0x93, 0x8b,
+	0x1a, 0xcc, 0x1c, 0x92, 0xea, 0x1e, 0x17, 0xcc, 0x2d, 0xb6, 0xf8, 0x1a, 0x7f, 0x8d, 0x36, 0xe9,
+	0x19, 0xb5, 0x27, 0x59, 0xf4, 0x4a, 0x4b, 0xcb, 0x6f, 0xd5, 0x21, 0xb8, 0x0f, 0x63, 0x71, 0x06,
+	0xbb, 0x41, 0xaf, 0xbe, 0x32, 0x4a, 0x68, 0x05, 0x26, 0x5e, 0xfb, 0x37, 0x0d, 0x15, 0x65, 0x05,
+	0xb7, 0xd1, 0x6a, 0x54, 0xbc, 0xc5, 0x1c, 0x59, 0x7f, 0xd1, 0x40, 0x17, 0xe7, 0xcd, 0x72, 0x64,
+	0x1a, 0xec, 0x9b, 0xe5, 0xc8, 0x34, 0x70, 0xb0, 0x81, 0xaa, 0xb1, 0xc8, 0x3f, 0xe2, 0xaa, 0xb6,
+	0x7a, 0xfe, 0xc6, 0x3d, 0xf0, 0x8f, 0x78, 0x76, 0xdb, 0xaf, 0xd8, 0xea, 0x25, 0xbe, 0x85, 0x90,
+	0x64, 0x8c, 0xa6, 0x82, 0x82, 0xac, 0xa2, 0x66, 0x4a, 0xaa, 0x11, 0xbd, 0xc0, 0x3b, 0xa8, 0x1c,
+	0x30, 0xdf, 0xa7, 0x8e, 0x5e, 0x6c, 0x69, 0x9d, 0x8a, 0xa9, 0x46, 0xed, 0x7f, 0x8b, 0xa8, 0x92,
+	0xf6, 0xa3, 0x8f, 0x36, 0xd3, 0x49, 0x27, 0xf1, 0x0e, 0x25, 0xb3, 0xae, 0x1a, 0xfa, 0xf2, 0xbd,
+	0x2b, 0xf1, 0x50, 0xaf, 0xf1, 0x97, 0xe8, 0x7a, 0x0a, 0xc9, 0x14, 0xd4, 0x58, 0xbe, 0xf8, 0xe6,
+	0x8b, 0xaa, 0xd9, 0x19, 0x03, 0x1e, 0xa0, 0xf5, 0x94, 0x07, 0x82, 0x08, 0xaa, 0x8e, 0xa2, 0x77,
+	0x72, 0xa6, 0x88, 0x3b, 0x74, 0x9c, 0x25, 0xa5, 0x99, 0xc4, 0x27, 0x2b, 0xcb, 0x7c, 0x1f, 0xb2,
+	0x59, 0xc7, 0x2c, 0x5a, 0x6b, 0x53, 0x75, 0x00, 0xed, 0x2e, 0x4f, 0x51, 0x2e, 0xcd, 0x58, 0xfc,
+	0xd0, 0x17, 0xe1, 0x34, 0x1b, 0x64, 0xcb, 0x5e, 0x14, 0xe1, 0x03, 0x74, 0x3d, 0xfa, 0x43, 0x5c,
+	0x2a, 0x93, 0x8e, 0x0e, 0x22, 0x2d, 0xff, 0x20, 0xea, 0xa7, 0x29, 0x4a, 0x79, 0x94, 0x29, 0x98,
+	0x35, 0xc8, 0x8c, 0xf0, 0x27, 0xa8, 0xe2, 0x51, 0x41, 0x1c, 0x22, 0x88, 0x5e, 0x96, 0x9c, 0xf6,
+	0x72, 0xce, 0xa1, 0x52, 0x9a, 0xa9, 0x0f, 0xfe, 0x59, 0x43, 0x1b, 0x49, 0x36, 0x0e, 0x0d, 0x38,
+	0x30, 0xa1, 0x0e, 0x94, 0x1b, 0x5d, 0x35, 0xa9, 0xd1, 0xed, 0xa4, 0xab, 0x6e, 0x27, 0xdd, 0x3e,
+	0x67, 0xbe, 0xf1, 0x28, 0xaa, 0xf0, 0x8f, 0xbf, 0x9b, 0x1d, 0x97, 0x89, 0xe3, 0xc9, 0xa8, 0x6b,
+	0x73, 0x4f, 0x5d, 0x6c, 0xd4, 0xcf, 0x3d, 0x70, 0x4e, 0xd4, 0x95, 0x29, 0x72, 0x80, 0x5f, 0x5f,
+	0x3f, 0xdf, 0xad, 0x8d, 0xa9, 0x4b, 0xec, 0xa9, 0x15, 0xdd, 0x6f, 0x20, 0x6e, 0xcf, 0xba, 0x8a,
+	0xbc, 0x1f, 0x07, 0x6e, 0x1b, 0xa8, 0x92, 0x1c, 0xeb, 0xb8, 0x85, 0xca, 0xcc, 0xb1, 0x4e, 0xe8,
+	0x54, 0x2e, 0xb3, 0x9a, 0x51, 0xbd, 0x38, 0x6f, 0x96, 0x06, 0xfb, 0x07, 0x74, 0x6a, 0x96, 0x98,
+	0x73, 0x40, 0xa7, 0x78, 0x1b, 0x95, 0x4e, 0xc9, 0x78, 0x42, 0xe5, 0x2a, 0x2a, 0x9a, 0xf1, 0xc0,
+	0xf8, 0xec, 0xc5, 0x45, 0x43, 0x7b, 0x79, 0xd1, 0xd0, 0xfe, 0xb9, 0x68, 0x68, 0xbf, 0x5c, 0x36,
+	0x0a, 0x2f, 0x2f, 0x1b, 0x85, 0xbf, 0x2e, 0x1b, 0x85, 0xa7, 0x77, 0x33, 0xd9, 0xf6, 0x39, 0x78,
+	0xdf, 0x24, 0x37, 0x38, 0xa7, 0x77, 0x26, 0x7f, 0xe3, 0x8c, 0x47, 0x65, 0x79, 0xf9, 0xfa, 0xf0,
+	0xbf, 0x01, 0x00, 0x83, 0x55, 0xd2, 0x1e, 0x4d, 0x0a, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -503,6 +624,99 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContractDependencies) > 0 {
+		for iNdEx := len(m.ContractDependencies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractDependencies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.MigrationApprovals) > 0 {
+		for iNdEx := len(m.MigrationApprovals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MigrationApprovals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.FeeSponsorshipUsages) > 0 {
+		for iNdEx := len(m.FeeSponsorshipUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSponsorshipUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.FeeSponsorships) > 0 {
+		for iNdEx := len(m.FeeSponsorships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSponsorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Callbacks) > 0 {
+		for iNdEx := len(m.Callbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Callbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CronSchedules) > 0 {
+		for iNdEx := len(m.CronSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CronSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PurgedContracts) > 0 {
+		for iNdEx := len(m.PurgedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PurgedContracts[iNdEx])
+			copy(dAtA[i:], m.PurgedContracts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PurgedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.GenMsgs) > 0 {
 		for iNdEx := len(m.GenMsgs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -745,6 +959,44 @@ func (m *Contract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StorageDeposit) > 0 {
+		for iNdEx := len(m.StorageDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.StorageStats != nil {
+		{
+			size, err := m.StorageStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.ContractCodeHistory) > 0 {
 		for iNdEx := len(m.ContractCodeHistory) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -872,6 +1124,48 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PurgedContracts) > 0 {
+		for _, s := range m.PurgedContracts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CronSchedules) > 0 {
+		for _, e := range m.CronSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Callbacks) > 0 {
+		for _, e := range m.Callbacks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeSponsorships) > 0 {
+		for _, e := range m.FeeSponsorships {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeSponsorshipUsages) > 0 {
+		for _, e := range m.FeeSponsorshipUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MigrationApprovals) > 0 {
+		for _, e := range m.MigrationApprovals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractDependencies) > 0 {
+		for _, e := range m.ContractDependencies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -971,6 +1265,20 @@ func (m *Contract) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.StorageStats != nil {
+		l = m.StorageStats.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.StorageDeposit) > 0 {
+		for _, e := range m.StorageDeposit {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1196,6 +1504,242 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurgedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PurgedContracts = append(m.PurgedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronSchedules = append(m.CronSchedules, CronSchedule{})
+			if err := m.CronSchedules[len(m.CronSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callbacks = append(m.Callbacks, Callback{})
+			if err := m.Callbacks[len(m.Callbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSponsorships", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSponsorships = append(m.FeeSponsorships, FeeSponsorship{})
+			if err := m.FeeSponsorships[len(m.FeeSponsorships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSponsorshipUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSponsorshipUsages = append(m.FeeSponsorshipUsages, FeeSponsorshipUsage{})
+			if err := m.FeeSponsorshipUsages[len(m.FeeSponsorshipUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationApprovals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MigrationApprovals = append(m.MigrationApprovals, MigrationApproval{})
+			if err := m.MigrationApprovals[len(m.MigrationApprovals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractDependencies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractDependencies = append(m.ContractDependencies, ContractDependency{})
+			if err := m.ContractDependencies[len(m.ContractDependencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1693,6 +2237,112 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StorageStats == nil {
+				m.StorageStats = &ContractStorageStats{}
+			}
+			if err := m.StorageStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &ContractMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageDeposit = append(m.StorageDeposit, types.Coin{})
+			if err := m.StorageDeposit[len(m.StorageDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -49,6 +49,125 @@ func TestValidateGenesisState(t *testing.T) {
 			},
 			expError: true,
 		},
+		"purged contract valid": {
+			srcMutator: func(s *GenesisState) {
+				s.PurgedContracts = []string{sdk.AccAddress(make([]byte, ContractAddrLen)).String()}
+			},
+		},
+		"cron schedules valid": {
+			srcMutator: func(s *GenesisState) {
+				s.CronSchedules = []CronSchedule{
+					{Name: "a", Contract: s.Contracts[0].ContractAddress, Msg: []byte(`{}`), Interval: 1, GasLimit: 1},
+					{Name: "b", Contract: s.Contracts[0].ContractAddress, Msg: []byte(`{}`), Interval: 1, GasLimit: 1},
+				}
+			},
+		},
+		"cron schedule invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.CronSchedules = []CronSchedule{{Name: "a", Contract: s.Contracts[0].ContractAddress, Msg: []byte(`{}`), Interval: 1}}
+			},
+			expError: true,
+		},
+		"cron schedule names not unique": {
+			srcMutator: func(s *GenesisState) {
+				s.CronSchedules = []CronSchedule{
+					{Name: "a", Contract: s.Contracts[0].ContractAddress, Msg: []byte(`{}`), Interval: 1, GasLimit: 1},
+					{Name: "a", Contract: s.Contracts[0].ContractAddress, Msg: []byte(`{}`), Interval: 1, GasLimit: 1},
+				}
+			},
+			expError: true,
+		},
+		"callbacks valid": {
+			srcMutator: func(s *GenesisState) {
+				s.Callbacks = []Callback{
+					{ID: 1, Contract: s.Contracts[0].ContractAddress, Height: 10},
+					{ID: 2, Contract: s.Contracts[0].ContractAddress, Time: 1},
+				}
+			},
+		},
+		"callback invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.Callbacks = []Callback{{ID: 1, Contract: s.Contracts[0].ContractAddress}}
+			},
+			expError: true,
+		},
+		"callback ids not unique": {
+			srcMutator: func(s *GenesisState) {
+				s.Callbacks = []Callback{
+					{ID: 1, Contract: s.Contracts[0].ContractAddress, Height: 10},
+					{ID: 1, Contract: s.Contracts[0].ContractAddress, Height: 11},
+				}
+			},
+			expError: true,
+		},
+		"fee sponsorships valid": {
+			srcMutator: func(s *GenesisState) {
+				s.FeeSponsorships = []FeeSponsorship{{Contract: s.Contracts[0].ContractAddress, Balance: sdk.NewCoins(sdk.NewInt64Coin("denom", 1))}}
+				s.FeeSponsorshipUsages = []FeeSponsorshipUsage{{Contract: s.Contracts[0].ContractAddress, Sender: s.Contracts[0].ContractAddress}}
+			},
+		},
+		"fee sponsorship contracts not unique": {
+			srcMutator: func(s *GenesisState) {
+				s.FeeSponsorships = []FeeSponsorship{{Contract: s.Contracts[0].ContractAddress}, {Contract: s.Contracts[0].ContractAddress}}
+			},
+			expError: true,
+		},
+		"fee sponsorship usage invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.FeeSponsorshipUsages = []FeeSponsorshipUsage{{Contract: s.Contracts[0].ContractAddress, Sender: invalidAddress}}
+			},
+			expError: true,
+		},
+		"migration approvals valid": {
+			srcMutator: func(s *GenesisState) {
+				s.MigrationApprovals = []MigrationApproval{{Contract: s.Contracts[0].ContractAddress, CodeID: 1, Msg: []byte(`{}`), Approvals: []string{s.Contracts[0].ContractAddress}}}
+			},
+		},
+		"migration approvals for different proposals of a contract": {
+			srcMutator: func(s *GenesisState) {
+				approval := MigrationApproval{Contract: s.Contracts[0].ContractAddress, CodeID: 1, Msg: []byte(`{}`), Approvals: []string{s.Contracts[0].ContractAddress}}
+				other := approval
+				other.CodeID = 2
+				s.MigrationApprovals = []MigrationApproval{approval, other}
+			},
+		},
+		"migration approval proposals not unique": {
+			srcMutator: func(s *GenesisState) {
+				approval := MigrationApproval{Contract: s.Contracts[0].ContractAddress, CodeID: 1, Msg: []byte(`{}`), Approvals: []string{s.Contracts[0].ContractAddress}}
+				s.MigrationApprovals = []MigrationApproval{approval, approval}
+			},
+			expError: true,
+		},
+		"migration approval invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.MigrationApprovals = []MigrationApproval{{Contract: s.Contracts[0].ContractAddress, CodeID: 1, Msg: []byte(`{}`)}}
+			},
+			expError: true,
+		},
+		"contract dependencies valid": {
+			srcMutator: func(s *GenesisState) {
+				s.ContractDependencies = []ContractDependency{{Caller: s.Contracts[0].ContractAddress, Callee: s.Contracts[1].ContractAddress, MessageCount: 1}}
+			},
+		},
+		"contract dependencies not unique": {
+			srcMutator: func(s *GenesisState) {
+				dependency := ContractDependency{Caller: s.Contracts[0].ContractAddress, Callee: s.Contracts[1].ContractAddress, MessageCount: 1}
+				s.ContractDependencies = []ContractDependency{dependency, dependency}
+			},
+			expError: true,
+		},
+		"contract dependency invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.ContractDependencies = []ContractDependency{{Caller: s.Contracts[0].ContractAddress, Callee: invalidAddress}}
+			},
+			expError: true,
+		},
+		"purged contract invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.PurgedContracts = []string{invalidAddress}
+			},
+			expError: true,
+		},
 		"genesis store code message invalid": {
 			srcMutator: func(s *GenesisState) {
 				s.GenMsgs[0].GetStoreCode().WASMByteCode = nil
@@ -173,6 +292,28 @@ func TestContractValidateBasic(t *testing.T) {
 			},
 			expError: true,
 		},
+		"storage stats matching state": {
+			srcMutator: func(c *Contract) {
+				c.StorageStats = &ContractStorageStats{KeyCount: 1, TotalBytes: uint64(len("anyKey") + len("anyValue"))}
+			},
+		},
+		"storage stats not matching state": {
+			srcMutator: func(c *Contract) {
+				c.StorageStats = &ContractStorageStats{KeyCount: 1, TotalBytes: 1}
+			},
+			expError: true,
+		},
+		"metadata set": {
+			srcMutator: func(c *Contract) {
+				c.Metadata = &ContractMetadata{Name: "foo", Homepage: "https://example.com"}
+			},
+		},
+		"metadata invalid": {
+			srcMutator: func(c *Contract) {
+				c.Metadata = &ContractMetadata{Homepage: "example.com"}
+			},
+			expError: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"encoding/binary"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -36,9 +37,28 @@ var (
 	ContractsByCreatorPrefix                       = []byte{0x09}
 	ParamsKey                                      = []byte{0x10}
 	AsyncAckKeyPrefix                              = []byte{0x11}
+	FrozenContractIndexPrefix                      = []byte{0x12}
+	ContractStorageStatsPrefix                     = []byte{0x13}
+	ContractTombstonePrefix                        = []byte{0x14}
+	PendingContractPurgePrefix                     = []byte{0x15}
+	CronSchedulePrefix                             = []byte{0x16}
+	CallbackPrefix                                 = []byte{0x17}
+	CallbackQueuePrefix                            = []byte{0x18}
+	FeeSponsorshipPrefix                           = []byte{0x19}
+	FeeSponsorshipUsagePrefix                      = []byte{0x1a}
+	MigrationApprovalPrefix                        = []byte{0x1b}
+	ContractsByAdminPrefix                         = []byte{0x1c}
+	ContractsByLabelPrefix                         = []byte{0x1d}
+	CodesByChecksumPrefix                          = []byte{0x1e}
+	CodesByCreatorPrefix                           = []byte{0x1f}
+	ContractDependencyPrefix                       = []byte{0x20}
+	ContractDependentsPrefix                       = []byte{0x21}
+	ContractMetadataPrefix                         = []byte{0x22}
+	ContractStorageDepositPrefix                   = []byte{0x23}
 
 	KeySequenceCodeID     = append(SequenceKeyPrefix, []byte("lastCodeId")...)
 	KeySequenceInstanceID = append(SequenceKeyPrefix, []byte("lastContractId")...)
+	KeySequenceCallbackID = append(SequenceKeyPrefix, []byte("lastCallbackId")...)
 )
 
 // GetCodeKey constructs the key for retreiving the ID for the WASM code
@@ -58,6 +78,12 @@ func GetContractsByCreatorPrefix(addr sdk.AccAddress) []byte {
 	return append(ContractsByCreatorPrefix, bz...)
 }
 
+// GetContractsByAdminPrefix returns the contracts by admin prefix for the WASM contract instance
+func GetContractsByAdminPrefix(addr sdk.AccAddress) []byte {
+	bz := address.MustLengthPrefix(addr)
+	return append(ContractsByAdminPrefix, bz...)
+}
+
 // GetContractStorePrefix returns the store prefix for the WASM contract instance
 func GetContractStorePrefix(addr sdk.AccAddress) []byte {
 	return append(ContractStorePrefix, addr...)
@@ -116,6 +142,86 @@ func GetContractByCreatorSecondaryIndexKey(bz, position []byte, contractAddr sdk
 	return r
 }
 
+// GetContractByAdminSecondaryIndexKey returns the key for the admin index: `<prefix><adminAddress length><adminAddress><contractAddr>`
+func GetContractByAdminSecondaryIndexKey(adminAddr, contractAddr sdk.AccAddress) []byte {
+	return append(GetContractsByAdminPrefix(adminAddr), contractAddr...)
+}
+
+// GetContractsByLabelPrefix returns the prefix of the label index for all contracts with the label: `<prefix><label><0x00>`.
+// Labels have printable characters only so that the zero byte terminates them.
+func GetContractsByLabelPrefix(label string) []byte {
+	r := append(bytes.Clone(ContractsByLabelPrefix), label...)
+	return append(r, 0)
+}
+
+// GetContractsByLabelAndCreatorPrefix returns the prefix of the label index for the contracts of a creator with the label
+func GetContractsByLabelAndCreatorPrefix(label string, creatorAddr sdk.AccAddress) []byte {
+	return append(GetContractsByLabelPrefix(label), address.MustLengthPrefix(creatorAddr)...)
+}
+
+// GetContractByLabelSecondaryIndexKey returns the key for the label index: `<prefix><label><0x00><creatorAddress length><creatorAddress><contractAddr>`
+func GetContractByLabelSecondaryIndexKey(label string, creatorAddr, contractAddr sdk.AccAddress) []byte {
+	return append(GetContractsByLabelAndCreatorPrefix(label, creatorAddr), contractAddr...)
+}
+
+// ParseContractByLabelSecondaryIndexKey returns the label and contract address of a label index key without the store prefix
+func ParseContractByLabelSecondaryIndexKey(key []byte) (string, sdk.AccAddress, error) {
+	pos := bytes.IndexByte(key, 0)
+	if pos < 0 || pos+1 >= len(key) {
+		return "", nil, ErrInvalid.Wrap("label index key")
+	}
+	creatorLen := int(key[pos+1])
+	if pos+2+creatorLen >= len(key) {
+		return "", nil, ErrInvalid.Wrap("label index key")
+	}
+	return string(key[:pos]), key[pos+2+creatorLen:], nil
+}
+
+// GetCodesByChecksumPrefix returns the prefix of the index for code ids by checksum: `<prefix><checksum>`
+func GetCodesByChecksumPrefix(checksum []byte) []byte {
+	return append(bytes.Clone(CodesByChecksumPrefix), checksum...)
+}
+
+// GetCodeByChecksumSecondaryIndexKey returns the key for the checksum index: `<prefix><checksum><codeID>`
+func GetCodeByChecksumSecondaryIndexKey(checksum []byte, codeID uint64) []byte {
+	return append(GetCodesByChecksumPrefix(checksum), sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetCodesByCreatorPrefix returns the prefix of the index for code ids by creator: `<prefix><creatorAddress length><creatorAddress>`
+func GetCodesByCreatorPrefix(creatorAddr sdk.AccAddress) []byte {
+	return append(bytes.Clone(CodesByCreatorPrefix), address.MustLengthPrefix(creatorAddr)...)
+}
+
+// GetCodeByCreatorSecondaryIndexKey returns the key for the creator index: `<prefix><creatorAddress length><creatorAddress><codeID>`
+func GetCodeByCreatorSecondaryIndexKey(creatorAddr sdk.AccAddress, codeID uint64) []byte {
+	return append(GetCodesByCreatorPrefix(creatorAddr), sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetContractDependenciesPrefix returns the prefix of the recorded calls of a caller contract: `<prefix><callerAddress length><callerAddress>`
+func GetContractDependenciesPrefix(callerAddr sdk.AccAddress) []byte {
+	return append(bytes.Clone(ContractDependencyPrefix), address.MustLengthPrefix(callerAddr)...)
+}
+
+// GetContractDependencyKey returns the key of the recorded calls from caller to callee: `<prefix><callerAddress length><callerAddress><calleeAddr>`
+func GetContractDependencyKey(callerAddr, calleeAddr sdk.AccAddress) []byte {
+	return append(GetContractDependenciesPrefix(callerAddr), calleeAddr...)
+}
+
+// GetContractDependentsPrefix returns the prefix of the reverse index of the callers of a contract: `<prefix><calleeAddress length><calleeAddress>`
+func GetContractDependentsPrefix(calleeAddr sdk.AccAddress) []byte {
+	return append(bytes.Clone(ContractDependentsPrefix), address.MustLengthPrefix(calleeAddr)...)
+}
+
+// GetContractDependentKey returns the key for the reverse index: `<prefix><calleeAddress length><calleeAddress><callerAddr>`
+func GetContractDependentKey(calleeAddr, callerAddr sdk.AccAddress) []byte {
+	return append(GetContractDependentsPrefix(calleeAddr), callerAddr...)
+}
+
+// GetContractMetadataKey returns the key of the metadata of a contract: `<prefix><contractAddr>`
+func GetContractMetadataKey(contractAddr sdk.AccAddress) []byte {
+	return append(bytes.Clone(ContractMetadataPrefix), contractAddr...)
+}
+
 // GetContractCodeHistoryElementKey returns the key a contract code history entry: `<prefix><contractAddr><position>`
 func GetContractCodeHistoryElementKey(contractAddr sdk.AccAddress, pos uint64) []byte {
 	prefix := GetContractCodeHistoryElementPrefix(contractAddr)
@@ -149,3 +255,97 @@ func GetPinnedCodeIndexPrefix(codeID uint64) []byte {
 func ParsePinnedCodeIndex(s []byte) uint64 {
 	return sdk.BigEndianToUint64(s)
 }
+
+// GetFrozenContractIndexKey returns the key for the index of frozen contracts: `<prefix><contractAddr>`
+func GetFrozenContractIndexKey(contractAddr sdk.AccAddress) []byte {
+	return append(FrozenContractIndexPrefix, contractAddr...)
+}
+
+// GetContractStorageStatsKey returns the key for the storage usage stats of a contract: `<prefix><contractAddr>`
+func GetContractStorageStatsKey(contractAddr sdk.AccAddress) []byte {
+	return append(ContractStorageStatsPrefix, contractAddr...)
+}
+
+// GetContractStorageDepositKey returns the key for the storage deposit of a contract: `<prefix><contractAddr>`
+func GetContractStorageDepositKey(contractAddr sdk.AccAddress) []byte {
+	return append(ContractStorageDepositPrefix, contractAddr...)
+}
+
+// GetContractTombstoneKey returns the key for the tombstone of a purged contract: `<prefix><contractAddr>`
+func GetContractTombstoneKey(contractAddr sdk.AccAddress) []byte {
+	return append(ContractTombstonePrefix, contractAddr...)
+}
+
+// GetPendingContractPurgeKey returns the key for a purged contract with state left to delete: `<prefix><contractAddr>`
+func GetPendingContractPurgeKey(contractAddr sdk.AccAddress) []byte {
+	return append(PendingContractPurgePrefix, contractAddr...)
+}
+
+// GetCronScheduleKey returns the key for a scheduled contract call: `<prefix><name>`
+func GetCronScheduleKey(name string) []byte {
+	return append(CronSchedulePrefix, name...)
+}
+
+// GetContractCallbacksPrefix returns the prefix for the callbacks of a contract: `<prefix><contractAddr length><contractAddr>`
+func GetContractCallbacksPrefix(contractAddr sdk.AccAddress) []byte {
+	return append(CallbackPrefix, address.MustLengthPrefix(contractAddr)...)
+}
+
+// GetCallbackKey returns the key for a callback: `<prefix><contractAddr length><contractAddr><id>`
+func GetCallbackKey(contractAddr sdk.AccAddress, id uint64) []byte {
+	return append(GetContractCallbacksPrefix(contractAddr), sdk.Uint64ToBigEndian(id)...)
+}
+
+const (
+	callbackQueueByHeight byte = iota
+	callbackQueueByTime
+)
+
+// GetCallbackHeightQueuePrefix returns the prefix for callbacks scheduled by block height
+func GetCallbackHeightQueuePrefix() []byte {
+	return append(CallbackQueuePrefix, callbackQueueByHeight)
+}
+
+// GetCallbackTimeQueuePrefix returns the prefix for callbacks scheduled by block time
+func GetCallbackTimeQueuePrefix() []byte {
+	return append(CallbackQueuePrefix, callbackQueueByTime)
+}
+
+// GetCallbackQueueKey returns the key for the execution queue of a callback:
+// `<prefix><queue type><height or time><id>`
+func GetCallbackQueueKey(c Callback) []byte {
+	prefix, pos := GetCallbackHeightQueuePrefix(), c.Height
+	if c.Height == 0 {
+		prefix, pos = GetCallbackTimeQueuePrefix(), c.Time
+	}
+	r := make([]byte, len(prefix)+16)
+	copy(r[0:], prefix)
+	copy(r[len(prefix):], sdk.Uint64ToBigEndian(pos))
+	copy(r[len(prefix)+8:], sdk.Uint64ToBigEndian(c.ID))
+	return r
+}
+
+// GetFeeSponsorshipKey returns the key for the fee sponsorship of a contract: `<prefix><contractAddr>`
+func GetFeeSponsorshipKey(contractAddr sdk.AccAddress) []byte {
+	return append(FeeSponsorshipPrefix, contractAddr...)
+}
+
+// GetFeeSponsorshipUsagesPrefix returns the prefix for the fee usages of a contract: `<prefix><contractAddr length><contractAddr>`
+func GetFeeSponsorshipUsagesPrefix(contractAddr sdk.AccAddress) []byte {
+	return append(FeeSponsorshipUsagePrefix, address.MustLengthPrefix(contractAddr)...)
+}
+
+// GetFeeSponsorshipUsageKey returns the key for the fee usage of a sender: `<prefix><contractAddr length><contractAddr><sender>`
+func GetFeeSponsorshipUsageKey(contractAddr, sender sdk.AccAddress) []byte {
+	return append(GetFeeSponsorshipUsagesPrefix(contractAddr), sender...)
+}
+
+// GetMigrationApprovalsPrefix returns the prefix for the pending migration approvals of a contract: `<prefix><contractAddr length><contractAddr>`
+func GetMigrationApprovalsPrefix(contractAddr sdk.AccAddress) []byte {
+	return append(MigrationApprovalPrefix, address.MustLengthPrefix(contractAddr)...)
+}
+
+// GetMigrationApprovalKey returns the key for the approval of a pending migration proposal: `<prefix><contractAddr length><contractAddr><proposalID>`
+func GetMigrationApprovalKey(contractAddr sdk.AccAddress, proposalID []byte) []byte {
+	return append(GetMigrationApprovalsPrefix(contractAddr), proposalID...)
+}
@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestGetContractByCodeIDSecondaryIndexPrefix(t *testing.T) {
@@ -86,6 +89,105 @@ func TestGetContractByCreatedSecondaryIndexKey(t *testing.T) {
 	assert.Equal(t, exp, got)
 }
 
+func TestGetContractByAdminSecondaryIndexKey(t *testing.T) {
+	adminAddr := bytes.Repeat([]byte{4}, 20)
+	contractAddr := bytes.Repeat([]byte{8}, 32)
+	got := GetContractByAdminSecondaryIndexKey(adminAddr, contractAddr)
+	exp := []byte{
+		0x1c,                         // prefix
+		20,                           // admin address length
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, // admin address
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8, // contract address 32 bytes
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
+		8, 8,
+	}
+	assert.Equal(t, exp, got)
+}
+
+func TestGetContractByLabelSecondaryIndexKey(t *testing.T) {
+	creatorAddr := bytes.Repeat([]byte{4}, 20)
+	contractAddr := bytes.Repeat([]byte{8}, 32)
+	got := GetContractByLabelSecondaryIndexKey("foo", creatorAddr, contractAddr)
+	exp := []byte{
+		0x1d,          // prefix
+		'f', 'o', 'o', // label
+		0,                            // label terminator
+		20,                           // creator address length
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, // creator address
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8, // contract address 32 bytes
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
+		8, 8,
+	}
+	assert.Equal(t, exp, got)
+
+	// and parsed without the store prefix
+	label, gotContractAddr, err := ParseContractByLabelSecondaryIndexKey(got[1:])
+	require.NoError(t, err)
+	assert.Equal(t, "foo", label)
+	assert.Equal(t, sdk.AccAddress(contractAddr), gotContractAddr)
+
+	// and invalid keys are rejected
+	_, _, err = ParseContractByLabelSecondaryIndexKey([]byte("foo"))
+	require.Error(t, err)
+	_, _, err = ParseContractByLabelSecondaryIndexKey(got[1 : 1+3+1+1+20])
+	require.Error(t, err)
+}
+
+func TestGetCodeSecondaryIndexKeys(t *testing.T) {
+	checksum := bytes.Repeat([]byte{4}, 32)
+	gotChecksumKey := GetCodeByChecksumSecondaryIndexKey(checksum, 1)
+	exp := []byte{
+		0x1e,                         // prefix
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, // checksum
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		4, 4,
+		0, 0, 0, 0, 0, 0, 0, 1, // code id
+	}
+	assert.Equal(t, exp, gotChecksumKey)
+
+	creatorAddr := bytes.Repeat([]byte{8}, 20)
+	gotCreatorKey := GetCodeByCreatorSecondaryIndexKey(creatorAddr, 2)
+	exp = []byte{
+		0x1f,                         // prefix
+		20,                           // creator address length
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8, // creator address
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
+		0, 0, 0, 0, 0, 0, 0, 2, // code id
+	}
+	assert.Equal(t, exp, gotCreatorKey)
+}
+
+func TestGetContractDependencyKeys(t *testing.T) {
+	callerAddr := bytes.Repeat([]byte{4}, 20)
+	calleeAddr := bytes.Repeat([]byte{8}, 20)
+	gotKey := GetContractDependencyKey(callerAddr, calleeAddr)
+	exp := []byte{
+		0x20,                         // prefix
+		20,                           // caller address length
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, // caller address
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8, // callee address
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
+	}
+	assert.Equal(t, exp, gotKey)
+
+	gotKey = GetContractDependentKey(calleeAddr, callerAddr)
+	exp = []byte{
+		0x21,                         // prefix
+		20,                           // callee address length
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8, // callee address
+		8, 8, 8, 8, 8, 8, 8, 8, 8, 8,
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4, // caller address
+		4, 4, 4, 4, 4, 4, 4, 4, 4, 4,
+	}
+	assert.Equal(t, exp, gotKey)
+}
+
 func TestGetContractByCreatorSecondaryIndexKey(t *testing.T) {
 	creatorAddr := bytes.Repeat([]byte{4}, 20)
 	e := ContractCodeHistoryEntry{
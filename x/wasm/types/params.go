@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"github.com/cosmos/gogoproto/jsonpb"
@@ -106,9 +107,33 @@ func (p Params) ValidateBasic() error {
 	if err := p.CodeUploadAccess.ValidateBasic(); err != nil {
 		return errors.Wrap(err, "upload access")
 	}
+	if err := validateChecksums(p.BlockedChecksums); err != nil {
+		return errors.Wrap(err, "blocked checksums")
+	}
+	if err := p.StorageDepositPerByte.Validate(); err != nil {
+		return errors.Wrap(err, "storage deposit per byte")
+	}
+	if err := p.CallbackDeposit.Validate(); err != nil {
+		return errors.Wrap(err, "callback deposit")
+	}
+	if p.GasCosts != nil {
+		if err := p.GasCosts.ValidateBasic(); err != nil {
+			return errors.Wrap(err, "gas costs")
+		}
+	}
 	return nil
 }
 
+// IsBlockedChecksum returns true when the checksum is in the list of blocked checksums
+func (p Params) IsBlockedChecksum(checksum []byte) bool {
+	for _, c := range p.BlockedChecksums {
+		if bytes.Equal(c, checksum) {
+			return true
+		}
+	}
+	return false
+}
+
 func validateAccessType(a AccessType) error {
 	if a == AccessTypeUnspecified {
 		return errorsmod.Wrap(ErrEmpty, "type")
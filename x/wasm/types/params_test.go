@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -105,6 +107,67 @@ func TestValidateParams(t *testing.T) {
 			},
 			expErr: true,
 		},
+		"all good with blocked checksums": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				BlockedChecksums:             [][]byte{bytes.Repeat([]byte{1}, 32), bytes.Repeat([]byte{2}, 32)},
+			},
+		},
+		"reject invalid blocked checksum": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				BlockedChecksums:             [][]byte{bytes.Repeat([]byte{1}, 31)},
+			},
+			expErr: true,
+		},
+		"reject duplicate blocked checksums": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				BlockedChecksums:             [][]byte{bytes.Repeat([]byte{1}, 32), bytes.Repeat([]byte{1}, 32)},
+			},
+			expErr: true,
+		},
+		"all good with storage deposit": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				StorageDepositPerByte:        sdk.NewCoins(sdk.NewInt64Coin("denom", 1)),
+			},
+		},
+		"reject invalid storage deposit": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				StorageDepositPerByte:        sdk.Coins{sdk.Coin{Denom: "denom", Amount: sdkmath.NewInt(-1)}},
+			},
+			expErr: true,
+		},
+		"all good with gas costs": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasCosts:                     &GasCosts{GasMultiplier: 1, UncompressCostDenominator: 1},
+			},
+		},
+		"reject invalid gas costs": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				GasCosts:                     &GasCosts{UncompressCostDenominator: 1},
+			},
+			expErr: true,
+		},
+		"reject invalid callback deposit": {
+			src: Params{
+				CodeUploadAccess:             AllowEverybody,
+				InstantiateDefaultPermission: AccessTypeEverybody,
+				CallbackDeposit:              sdk.Coins{sdk.Coin{Denom: "denom", Amount: sdkmath.NewInt(-1)}},
+			},
+			expErr: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -553,3 +553,287 @@ func (msg MsgUpdateContractLabel) ValidateBasic() error {
 	}
 	return nil
 }
+
+func (msg MsgFreezeContract) Route() string {
+	return RouterKey
+}
+
+func (msg MsgFreezeContract) Type() string {
+	return "freeze-contract"
+}
+
+func (msg MsgFreezeContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgUnfreezeContract) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUnfreezeContract) Type() string {
+	return "unfreeze-contract"
+}
+
+func (msg MsgUnfreezeContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgPurgeContract) Route() string {
+	return RouterKey
+}
+
+func (msg MsgPurgeContract) Type() string {
+	return "purge-contract"
+}
+
+func (msg MsgPurgeContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Beneficiary); err != nil {
+		return errorsmod.Wrap(err, "beneficiary")
+	}
+	return nil
+}
+
+func (msg MsgAddCronSchedule) Route() string {
+	return RouterKey
+}
+
+func (msg MsgAddCronSchedule) Type() string {
+	return "add-cron-schedule"
+}
+
+func (msg MsgAddCronSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority")
+	}
+	return msg.Schedule().ValidateBasic()
+}
+
+// Schedule returns the cron schedule defined by the message
+func (msg MsgAddCronSchedule) Schedule() CronSchedule {
+	return CronSchedule{
+		Name:     msg.Name,
+		Contract: msg.Contract,
+		Msg:      msg.Msg,
+		Interval: msg.Interval,
+		GasLimit: msg.GasLimit,
+	}
+}
+
+func (msg MsgRemoveCronSchedule) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRemoveCronSchedule) Type() string {
+	return "remove-cron-schedule"
+}
+
+func (msg MsgRemoveCronSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority")
+	}
+	if err := ValidateLabel(msg.Name); err != nil {
+		return errorsmod.Wrap(err, "name")
+	}
+	return nil
+}
+
+func (msg MsgSetFeeSponsorship) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSetFeeSponsorship) Type() string {
+	return "set-fee-sponsorship"
+}
+
+func (msg MsgSetFeeSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if !msg.MaxFeePerSender.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "max fee per sender")
+	}
+	if !msg.MaxFeePerBlock.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "max fee per block")
+	}
+	return nil
+}
+
+func (msg MsgFundFeeSponsorship) Route() string {
+	return RouterKey
+}
+
+func (msg MsgFundFeeSponsorship) Type() string {
+	return "fund-fee-sponsorship"
+}
+
+func (msg MsgFundFeeSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if msg.Amount.Empty() || !msg.Amount.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "amount")
+	}
+	return nil
+}
+
+func (msg MsgRemoveFeeSponsorship) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRemoveFeeSponsorship) Type() string {
+	return "remove-fee-sponsorship"
+}
+
+func (msg MsgRemoveFeeSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	return nil
+}
+
+func (msg MsgUpdateExecuteConfig) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUpdateExecuteConfig) Type() string {
+	return "update-execute-config"
+}
+
+func (msg MsgUpdateExecuteConfig) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if msg.NewExecutePermission != nil {
+		if err := msg.NewExecutePermission.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "execute permission")
+		}
+	}
+	return nil
+}
+
+func (msg MsgUpdateAdminSet) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUpdateAdminSet) Type() string {
+	return "update-admin-set"
+}
+
+func (msg MsgUpdateAdminSet) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if err := msg.AdminSet.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "admin set")
+	}
+	return nil
+}
+
+func (msg MsgApproveMigration) Route() string {
+	return RouterKey
+}
+
+func (msg MsgApproveMigration) Type() string {
+	return "approve-migration"
+}
+
+func (msg MsgApproveMigration) ValidateBasic() error {
+	if msg.CodeID == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "code id is required")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if err := msg.Msg.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "payload msg")
+	}
+	return nil
+}
+
+func (msg MsgSetContractMetadata) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSetContractMetadata) Type() string {
+	return "set-contract-metadata"
+}
+
+func (msg MsgSetContractMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if err := msg.Metadata.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "metadata")
+	}
+	return nil
+}
+
+func (msg MsgSetCodeStatus) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSetCodeStatus) Type() string {
+	return "set-code-status"
+}
+
+func (msg MsgSetCodeStatus) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority")
+	}
+	if len(msg.CodeIDs) == 0 && len(msg.Checksum) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "code ids or checksum required")
+	}
+	if len(msg.CodeIDs) != 0 {
+		if err := validateCodeIDs(msg.CodeIDs); err != nil {
+			return err
+		}
+	}
+	if len(msg.Checksum) != 0 {
+		if err := validateChecksum(msg.Checksum); err != nil {
+			return errorsmod.Wrap(err, "checksum")
+		}
+	}
+	if _, ok := CodeStatus_name[int32(msg.Status)]; !ok {
+		return errorsmod.Wrapf(ErrInvalid, "unknown status: %d", msg.Status)
+	}
+	return nil
+}
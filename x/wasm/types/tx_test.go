@@ -1484,3 +1484,573 @@ func TestMsgUpdateContractLabel(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgFreezeContract(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	otherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String()
+
+	specs := map[string]struct {
+		sender, contract string
+		expErr           bool
+	}{
+		"all good": {
+			sender:   goodAddress,
+			contract: otherGoodAddress,
+		},
+		"bad sender": {
+			sender:   badAddress,
+			contract: otherGoodAddress,
+			expErr:   true,
+		},
+		"bad contract addr": {
+			sender:   goodAddress,
+			contract: badAddress,
+			expErr:   true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			freezeErr := MsgFreezeContract{Sender: spec.sender, Contract: spec.contract}.ValidateBasic()
+			unfreezeErr := MsgUnfreezeContract{Sender: spec.sender, Contract: spec.contract}.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, freezeErr)
+				require.Error(t, unfreezeErr)
+				return
+			}
+			require.NoError(t, freezeErr)
+			require.NoError(t, unfreezeErr)
+		})
+	}
+}
+
+func TestMsgUpdateExecuteConfigValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	otherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgUpdateExecuteConfig
+		expErr bool
+	}{
+		"all good": {
+			src: MsgUpdateExecuteConfig{
+				Sender:               goodAddress,
+				Contract:             otherGoodAddress,
+				NewExecutePermission: &AllowNobody,
+			},
+		},
+		"nil permission": {
+			src: MsgUpdateExecuteConfig{
+				Sender:   goodAddress,
+				Contract: otherGoodAddress,
+			},
+		},
+		"bad sender": {
+			src: MsgUpdateExecuteConfig{
+				Sender:               badAddress,
+				Contract:             otherGoodAddress,
+				NewExecutePermission: &AllowNobody,
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgUpdateExecuteConfig{
+				Sender:               goodAddress,
+				Contract:             badAddress,
+				NewExecutePermission: &AllowNobody,
+			},
+			expErr: true,
+		},
+		"invalid permission": {
+			src: MsgUpdateExecuteConfig{
+				Sender:               goodAddress,
+				Contract:             otherGoodAddress,
+				NewExecutePermission: &AccessConfig{},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgApproveMigrationValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	otherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgApproveMigration
+		expErr bool
+	}{
+		"all good": {
+			src: MsgApproveMigration{
+				Sender:   goodAddress,
+				Contract: otherGoodAddress,
+				CodeID:   1,
+				Msg:      []byte(`{}`),
+			},
+		},
+		"bad sender": {
+			src: MsgApproveMigration{
+				Sender:   badAddress,
+				Contract: otherGoodAddress,
+				CodeID:   1,
+				Msg:      []byte(`{}`),
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgApproveMigration{
+				Sender:   goodAddress,
+				Contract: badAddress,
+				CodeID:   1,
+				Msg:      []byte(`{}`),
+			},
+			expErr: true,
+		},
+		"empty code id": {
+			src: MsgApproveMigration{
+				Sender:   goodAddress,
+				Contract: otherGoodAddress,
+				Msg:      []byte(`{}`),
+			},
+			expErr: true,
+		},
+		"non json msg": {
+			src: MsgApproveMigration{
+				Sender:   goodAddress,
+				Contract: otherGoodAddress,
+				CodeID:   1,
+				Msg:      []byte("invalid json"),
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateAdminSetValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	otherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgUpdateAdminSet
+		expErr bool
+	}{
+		"all good": {
+			src: MsgUpdateAdminSet{
+				Sender:   goodAddress,
+				Contract: otherGoodAddress,
+				AdminSet: AdminSet{Members: []string{goodAddress}, Threshold: 1},
+			},
+		},
+		"bad sender": {
+			src: MsgUpdateAdminSet{
+				Sender:   badAddress,
+				Contract: otherGoodAddress,
+				AdminSet: AdminSet{Members: []string{goodAddress}, Threshold: 1},
+			},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src: MsgUpdateAdminSet{
+				Sender:   goodAddress,
+				Contract: badAddress,
+				AdminSet: AdminSet{Members: []string{goodAddress}, Threshold: 1},
+			},
+			expErr: true,
+		},
+		"invalid admin set": {
+			src: MsgUpdateAdminSet{
+				Sender:   goodAddress,
+				Contract: otherGoodAddress,
+				AdminSet: AdminSet{Members: []string{goodAddress}},
+			},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetCodeStatusValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	checksum := bytes.Repeat([]byte{1}, 32)
+
+	specs := map[string]struct {
+		src    MsgSetCodeStatus
+		expErr bool
+	}{
+		"all good with code ids": {
+			src: MsgSetCodeStatus{Authority: goodAddress, CodeIDs: []uint64{1, 2}, Status: CodeStatusDeprecated},
+		},
+		"all good with checksum": {
+			src: MsgSetCodeStatus{Authority: goodAddress, Checksum: checksum, Status: CodeStatusDeprecated},
+		},
+		"all good with code ids and checksum": {
+			src: MsgSetCodeStatus{Authority: goodAddress, CodeIDs: []uint64{1}, Checksum: checksum, Status: CodeStatusActive},
+		},
+		"bad authority": {
+			src:    MsgSetCodeStatus{Authority: badAddress, CodeIDs: []uint64{1}, Status: CodeStatusDeprecated},
+			expErr: true,
+		},
+		"neither code ids nor checksum": {
+			src:    MsgSetCodeStatus{Authority: goodAddress, Status: CodeStatusDeprecated},
+			expErr: true,
+		},
+		"duplicate code ids": {
+			src:    MsgSetCodeStatus{Authority: goodAddress, CodeIDs: []uint64{1, 1}, Status: CodeStatusDeprecated},
+			expErr: true,
+		},
+		"invalid checksum": {
+			src:    MsgSetCodeStatus{Authority: goodAddress, Checksum: checksum[1:], Status: CodeStatusDeprecated},
+			expErr: true,
+		},
+		"unknown status": {
+			src:    MsgSetCodeStatus{Authority: goodAddress, CodeIDs: []uint64{1}, Status: 99},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgPurgeContractValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	otherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgPurgeContract
+		expErr bool
+	}{
+		"all good": {
+			src: MsgPurgeContract{Sender: goodAddress, Contract: otherGoodAddress, Beneficiary: goodAddress},
+		},
+		"skip hook": {
+			src: MsgPurgeContract{Sender: goodAddress, Contract: otherGoodAddress, Beneficiary: goodAddress, SkipPurgeHook: true},
+		},
+		"bad sender": {
+			src:    MsgPurgeContract{Sender: badAddress, Contract: otherGoodAddress, Beneficiary: goodAddress},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src:    MsgPurgeContract{Sender: goodAddress, Contract: badAddress, Beneficiary: goodAddress},
+			expErr: true,
+		},
+		"bad beneficiary": {
+			src:    MsgPurgeContract{Sender: goodAddress, Contract: otherGoodAddress, Beneficiary: badAddress},
+			expErr: true,
+		},
+		"empty beneficiary": {
+			src:    MsgPurgeContract{Sender: goodAddress, Contract: otherGoodAddress},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgAddCronScheduleValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	otherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String()
+
+	validMsg := func(mutators ...func(*MsgAddCronSchedule)) MsgAddCronSchedule {
+		msg := MsgAddCronSchedule{
+			Authority: goodAddress,
+			Name:      "my-schedule",
+			Contract:  otherGoodAddress,
+			Msg:       []byte(`{"tick":{}}`),
+			Interval:  1,
+			GasLimit:  100_000,
+		}
+		for _, m := range mutators {
+			m(&msg)
+		}
+		return msg
+	}
+	specs := map[string]struct {
+		src    MsgAddCronSchedule
+		expErr bool
+	}{
+		"all good": {
+			src: validMsg(),
+		},
+		"bad authority": {
+			src:    validMsg(func(m *MsgAddCronSchedule) { m.Authority = badAddress }),
+			expErr: true,
+		},
+		"empty name": {
+			src:    validMsg(func(m *MsgAddCronSchedule) { m.Name = "" }),
+			expErr: true,
+		},
+		"bad contract addr": {
+			src:    validMsg(func(m *MsgAddCronSchedule) { m.Contract = badAddress }),
+			expErr: true,
+		},
+		"non json msg": {
+			src:    validMsg(func(m *MsgAddCronSchedule) { m.Msg = []byte("invalid") }),
+			expErr: true,
+		},
+		"zero interval": {
+			src:    validMsg(func(m *MsgAddCronSchedule) { m.Interval = 0 }),
+			expErr: true,
+		},
+		"zero gas limit": {
+			src:    validMsg(func(m *MsgAddCronSchedule) { m.GasLimit = 0 }),
+			expErr: true,
+		},
+		"max gas limit": {
+			src: validMsg(func(m *MsgAddCronSchedule) { m.GasLimit = MaxCronScheduleGasLimit }),
+		},
+		"gas limit exceeds max": {
+			src:    validMsg(func(m *MsgAddCronSchedule) { m.GasLimit = MaxCronScheduleGasLimit + 1 }),
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRemoveCronScheduleValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgRemoveCronSchedule
+		expErr bool
+	}{
+		"all good": {
+			src: MsgRemoveCronSchedule{Authority: goodAddress, Name: "my-schedule"},
+		},
+		"bad authority": {
+			src:    MsgRemoveCronSchedule{Authority: badAddress, Name: "my-schedule"},
+			expErr: true,
+		},
+		"empty name": {
+			src:    MsgRemoveCronSchedule{Authority: goodAddress},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetFeeSponsorshipValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	otherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgSetFeeSponsorship
+		expErr bool
+	}{
+		"all good": {
+			src: MsgSetFeeSponsorship{Sender: goodAddress, Contract: otherGoodAddress, MaxFeePerSender: sdk.NewCoins(sdk.NewInt64Coin("denom", 1))},
+		},
+		"no limits": {
+			src: MsgSetFeeSponsorship{Sender: goodAddress, Contract: otherGoodAddress},
+		},
+		"bad sender": {
+			src:    MsgSetFeeSponsorship{Sender: badAddress, Contract: otherGoodAddress},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src:    MsgSetFeeSponsorship{Sender: goodAddress, Contract: badAddress},
+			expErr: true,
+		},
+		"invalid max fee per sender": {
+			src:    MsgSetFeeSponsorship{Sender: goodAddress, Contract: otherGoodAddress, MaxFeePerSender: sdk.Coins{sdk.Coin{Denom: "denom", Amount: sdkmath.NewInt(-1)}}},
+			expErr: true,
+		},
+		"invalid max fee per block": {
+			src:    MsgSetFeeSponsorship{Sender: goodAddress, Contract: otherGoodAddress, MaxFeePerBlock: sdk.Coins{sdk.Coin{Denom: "denom", Amount: sdkmath.NewInt(-1)}}},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgFundFeeSponsorshipValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	otherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgFundFeeSponsorship
+		expErr bool
+	}{
+		"all good": {
+			src: MsgFundFeeSponsorship{Sender: goodAddress, Contract: otherGoodAddress, Amount: sdk.NewCoins(sdk.NewInt64Coin("denom", 1))},
+		},
+		"bad sender": {
+			src:    MsgFundFeeSponsorship{Sender: badAddress, Contract: otherGoodAddress, Amount: sdk.NewCoins(sdk.NewInt64Coin("denom", 1))},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src:    MsgFundFeeSponsorship{Sender: goodAddress, Contract: badAddress, Amount: sdk.NewCoins(sdk.NewInt64Coin("denom", 1))},
+			expErr: true,
+		},
+		"empty amount": {
+			src:    MsgFundFeeSponsorship{Sender: goodAddress, Contract: otherGoodAddress},
+			expErr: true,
+		},
+		"invalid amount": {
+			src:    MsgFundFeeSponsorship{Sender: goodAddress, Contract: otherGoodAddress, Amount: sdk.Coins{sdk.Coin{Denom: "denom", Amount: sdkmath.NewInt(-1)}}},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgRemoveFeeSponsorshipValidation(t *testing.T) {
+	// proper address size
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	otherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgRemoveFeeSponsorship
+		expErr bool
+	}{
+		"all good": {
+			src: MsgRemoveFeeSponsorship{Sender: goodAddress, Contract: otherGoodAddress},
+		},
+		"bad sender": {
+			src:    MsgRemoveFeeSponsorship{Sender: badAddress, Contract: otherGoodAddress},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src:    MsgRemoveFeeSponsorship{Sender: goodAddress, Contract: badAddress},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetContractMetadataValidation(t *testing.T) {
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+	otherGoodAddress := sdk.AccAddress(bytes.Repeat([]byte{0x1}, 20)).String()
+
+	specs := map[string]struct {
+		src    MsgSetContractMetadata
+		expErr bool
+	}{
+		"all good": {
+			src: MsgSetContractMetadata{Sender: goodAddress, Contract: otherGoodAddress, Metadata: ContractMetadata{Name: "foo", Homepage: "https://example.com"}},
+		},
+		"empty metadata": {
+			src: MsgSetContractMetadata{Sender: goodAddress, Contract: otherGoodAddress},
+		},
+		"bad sender": {
+			src:    MsgSetContractMetadata{Sender: badAddress, Contract: otherGoodAddress},
+			expErr: true,
+		},
+		"bad contract addr": {
+			src:    MsgSetContractMetadata{Sender: goodAddress, Contract: badAddress},
+			expErr: true,
+		},
+		"invalid metadata": {
+			src:    MsgSetContractMetadata{Sender: goodAddress, Contract: otherGoodAddress, Metadata: ContractMetadata{Homepage: "example.com"}},
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.src.ValidateBasic()
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -19,6 +19,11 @@ const (
 	defaultSmartQueryGasLimit uint64 = 3_000_000
 	defaultContractDebugMode         = false
 
+	// sinks of the contract debug output
+	ContractDebugSinkStdout = "stdout"
+	ContractDebugSinkFile   = "file"
+	ContractDebugSinkMemory = "memory"
+
 	// ContractAddrLen defines a valid address length for contracts
 	ContractAddrLen = 32
 	// SDKAddrLen defines a valid address length that was used in sdk address generation
@@ -94,6 +99,22 @@ func (c *ContractInfo) ValidateBasic() error {
 	if err := ValidateLabel(c.Label); err != nil {
 		return errorsmod.Wrap(err, "label")
 	}
+	if c.ExecutePermission != nil {
+		if err := c.ExecutePermission.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "execute permission")
+		}
+	}
+	if c.FrozenByGov && !c.Frozen {
+		return errorsmod.Wrap(ErrInvalid, "frozen by gov but not frozen")
+	}
+	if c.AdminSet != nil {
+		if len(c.Admin) != 0 {
+			return errorsmod.Wrap(ErrInvalid, "admin and admin set")
+		}
+		if err := c.AdminSet.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "admin set")
+		}
+	}
 	if c.Extension == nil {
 		return nil
 	}
@@ -186,6 +207,18 @@ func (c *ContractInfo) AdminAddr() sdk.AccAddress {
 	return admin
 }
 
+// Admins returns the admin set of the contract. A single admin is returned as a set with a threshold of 1
+// and a contract without admin as an empty set.
+func (c *ContractInfo) Admins() AdminSet {
+	if c.AdminSet != nil {
+		return *c.AdminSet
+	}
+	if c.Admin == "" {
+		return AdminSet{}
+	}
+	return AdminSet{Members: []string{c.Admin}, Threshold: 1}
+}
+
 // ContractInfoExtension defines the extension point for custom data to be stored with a contract info
 type ContractInfoExtension interface {
 	proto.Message
@@ -267,6 +300,25 @@ func (c ContractCodeHistoryEntry) ValidateBasic() error {
 	return errorsmod.Wrap(c.Msg.ValidateBasic(), "msg")
 }
 
+// Add updates the stats for a new key-value pair in the contract store
+func (s *ContractStorageStats) Add(key, value []byte) {
+	s.KeyCount++
+	s.TotalBytes += uint64(len(key) + len(value))
+}
+
+// Remove updates the stats for a key-value pair that is removed from the contract store.
+// The counters do not go below zero.
+func (s *ContractStorageStats) Remove(key, value []byte) {
+	if s.KeyCount > 0 {
+		s.KeyCount--
+	}
+	size := uint64(len(key) + len(value))
+	if s.TotalBytes < size {
+		size = s.TotalBytes
+	}
+	s.TotalBytes -= size
+}
+
 // NewEnv initializes the environment for a contract instance
 func NewEnv(ctx sdk.Context, contractAddr sdk.AccAddress) wasmvmtypes.Env {
 	// safety checks before casting below
@@ -323,8 +375,18 @@ type WasmConfig struct {
 	SmartQueryGasLimit uint64 `mapstructure:"query_gas_limit"`
 	// MemoryCacheSize in MiB not bytes
 	MemoryCacheSize uint32 `mapstructure:"memory_cache_size"`
-	// ContractDebugMode log what contract print
+	// ContractDebugMode log what contract print and export the call tree of each contract call to the debug sink
 	ContractDebugMode bool
+	// ContractDebugSink is the sink of the contract debug output: "stdout" (default), "file" or "memory".
+	// The messages that contracts print with `deps.api.debug` are not routed to the sink. wasmvm writes them to stdout
+	// and has no hook to capture them.
+	ContractDebugSink string `mapstructure:"contract_debug_sink"`
+	// ContractDebugGRPCAddress is the loopback address of the gRPC endpoint to tail the output of the "memory"
+	// contract debug sink. The endpoint is not served when empty.
+	ContractDebugGRPCAddress string `mapstructure:"contract_debug_grpc_address"`
+	// EventIndex enables the index of custom contract events in a database local to the node.
+	// The events can be queried with the EventIndex gRPC service.
+	EventIndex bool `mapstructure:"event_index"`
 }
 
 // DefaultWasmConfig returns the default settings for WasmConfig
@@ -360,7 +422,22 @@ memory_cache_size = %d
 # Simulation gas limit is the max gas to be used in a tx simulation call.
 # When not set the consensus max block gas is used instead
 %s
-`, c.SmartQueryGasLimit, c.MemoryCacheSize, simGasLimit)
+
+# Event index stores the custom contract events in a database local to the node
+# so that they can be queried by contract, type and attribute without tx indexing.
+event_index = %t
+
+# Contract debug sink receives the call tree of each contract call in contract debug mode
+# (--trace): "stdout" (default), "file" (rotated in the data directory) or "memory".
+# Records are tagged with the exec mode, as calls are traced in CheckTx and simulations too.
+# Messages that contracts print with deps.api.debug are not routed to the sink, wasmvm
+# writes them to stdout.
+contract_debug_sink = "%s"
+
+# Contract debug gRPC address is the loopback address to tail the output of the "memory"
+# contract debug sink, for example "127.0.0.1:9095". Not served when empty.
+contract_debug_grpc_address = "%s"
+`, c.SmartQueryGasLimit, c.MemoryCacheSize, simGasLimit, c.EventIndex, c.ContractDebugSink, c.ContractDebugGRPCAddress)
 }
 
 // VerifyAddressLen ensures that the address matches the expected length
@@ -429,3 +506,34 @@ func (a AccessConfig) AllAuthorizedAddresses() []string {
 	}
 	return []string{}
 }
+
+// MaxCronScheduleGasLimit is the highest gas limit of a single run of a cron schedule
+const MaxCronScheduleGasLimit = 10_000_000 // extension point for chains to customize via compile flag.
+
+// ValidateBasic performs stateless validation of the schedule
+func (s CronSchedule) ValidateBasic() error {
+	if err := ValidateLabel(s.Name); err != nil {
+		return errorsmod.Wrap(err, "name")
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if err := s.Msg.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "payload msg")
+	}
+	if s.Interval == 0 {
+		return errorsmod.Wrap(ErrEmpty, "interval")
+	}
+	if s.GasLimit == 0 {
+		return errorsmod.Wrap(ErrEmpty, "gas limit")
+	}
+	if s.GasLimit > MaxCronScheduleGasLimit {
+		return errorsmod.Wrapf(ErrLimit, "gas limit must not exceed %d", MaxCronScheduleGasLimit)
+	}
+	return nil
+}
+
+// IsDue returns true when the schedule is executed at the given block height
+func (s CronSchedule) IsDue(height int64) bool {
+	return height > 0 && uint64(height)%s.Interval == 0
+}
@@ -12,7 +12,9 @@ import (
 
 	github_com_cometbft_cometbft_libs_bytes "github.com/cometbft/cometbft/libs/bytes"
 	_ "github.com/cosmos/cosmos-proto"
-	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -63,6 +65,35 @@ func (AccessType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_e6155d98fa173e02, []int{0}
 }
 
+// CodeStatus lifecycle status of a stored code
+type CodeStatus int32
+
+const (
+	// CodeStatusActive default status without restrictions
+	CodeStatusActive CodeStatus = 0
+	// CodeStatusDeprecated no new instantiations or migrations to this code.
+	// Existing contracts keep running
+	CodeStatusDeprecated CodeStatus = 1
+)
+
+var CodeStatus_name = map[int32]string{
+	0: "CODE_STATUS_ACTIVE",
+	1: "CODE_STATUS_DEPRECATED",
+}
+
+var CodeStatus_value = map[string]int32{
+	"CODE_STATUS_ACTIVE":     0,
+	"CODE_STATUS_DEPRECATED": 1,
+}
+
+func (x CodeStatus) String() string {
+	return proto.EnumName(CodeStatus_name, int32(x))
+}
+
+func (CodeStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{1}
+}
+
 // ContractCodeHistoryOperationType actions that caused a code change
 type ContractCodeHistoryOperationType int32
 
@@ -96,7 +127,7 @@ func (x ContractCodeHistoryOperationType) String() string {
 }
 
 func (ContractCodeHistoryOperationType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{1}
+	return fileDescriptor_e6155d98fa173e02, []int{2}
 }
 
 // AccessTypeParam
@@ -190,6 +221,24 @@ var xxx_messageInfo_AccessConfig proto.InternalMessageInfo
 type Params struct {
 	CodeUploadAccess             AccessConfig `protobuf:"bytes,1,opt,name=code_upload_access,json=codeUploadAccess,proto3" json:"code_upload_access" yaml:"code_upload_access"`
 	InstantiateDefaultPermission AccessType   `protobuf:"varint,2,opt,name=instantiate_default_permission,json=instantiateDefaultPermission,proto3,enum=cosmwasm.wasm.v1.AccessType" json:"instantiate_default_permission,omitempty" yaml:"instantiate_default_permission"`
+	// BlockedChecksums are code checksums that can not be stored anymore
+	BlockedChecksums [][]byte `protobuf:"bytes,3,rep,name=blocked_checksums,json=blockedChecksums,proto3" json:"blocked_checksums,omitempty" yaml:"blocked_checksums"`
+	// StorageDepositPerByte is the deposit a contract has to lock for each byte
+	// stored. Only used when the params based storage deposit policy is enabled
+	StorageDepositPerByte github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=storage_deposit_per_byte,json=storageDepositPerByte,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"storage_deposit_per_byte" yaml:"storage_deposit_per_byte"`
+	// CallbackDeposit is the deposit a contract has to lock for each scheduled
+	// callback. Contracts can not schedule callbacks while it is empty, which is
+	// the default so that chains opt in with a deposit in their own denom. When
+	// the callback is executed, the share of the deposit for the gas used of the
+	// callback gas limit is paid as fee and the remainder is returned. A
+	// cancelled callback returns the full deposit.
+	CallbackDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=callback_deposit,json=callbackDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"callback_deposit" yaml:"callback_deposit"`
+	// UniqueContractLabels requires the labels of the contracts of a creator to
+	// be unique
+	UniqueContractLabels bool `protobuf:"varint,6,opt,name=unique_contract_labels,json=uniqueContractLabels,proto3" json:"unique_contract_labels,omitempty" yaml:"unique_contract_labels"`
+	// GasCosts replace the gas costs of the gas register that the node was
+	// configured with. Optional
+	GasCosts *GasCosts `protobuf:"bytes,7,opt,name=gas_costs,json=gasCosts,proto3" json:"gas_costs,omitempty" yaml:"gas_costs"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -229,6 +278,73 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// GasCosts are the costs in SDK gas that are charged for wasm operations
+type GasCosts struct {
+	// InstanceCost is charged when a contract is loaded for execution
+	InstanceCost uint64 `protobuf:"varint,1,opt,name=instance_cost,json=instanceCost,proto3" json:"instance_cost,omitempty" yaml:"instance_cost"`
+	// InstanceCostDiscount is charged instead of the InstanceCost when the
+	// contract is assumed to be in an in-memory cache
+	InstanceCostDiscount uint64 `protobuf:"varint,2,opt,name=instance_cost_discount,json=instanceCostDiscount,proto3" json:"instance_cost_discount,omitempty" yaml:"instance_cost_discount"`
+	// CompileCost is charged per byte to persist and compile new wasm code
+	CompileCost uint64 `protobuf:"varint,3,opt,name=compile_cost,json=compileCost,proto3" json:"compile_cost,omitempty" yaml:"compile_cost"`
+	// UncompressCostNumerator and UncompressCostDenominator are the fraction
+	// charged per byte to unpack new wasm code
+	UncompressCostNumerator   uint64 `protobuf:"varint,4,opt,name=uncompress_cost_numerator,json=uncompressCostNumerator,proto3" json:"uncompress_cost_numerator,omitempty" yaml:"uncompress_cost_numerator"`
+	UncompressCostDenominator uint64 `protobuf:"varint,5,opt,name=uncompress_cost_denominator,json=uncompressCostDenominator,proto3" json:"uncompress_cost_denominator,omitempty" yaml:"uncompress_cost_denominator"`
+	// GasMultiplier is how many CosmWasm gas points are one SDK gas point
+	GasMultiplier uint64 `protobuf:"varint,6,opt,name=gas_multiplier,json=gasMultiplier,proto3" json:"gas_multiplier,omitempty" yaml:"gas_multiplier"`
+	// EventPerAttributeCost is charged per event attribute
+	EventPerAttributeCost uint64 `protobuf:"varint,7,opt,name=event_per_attribute_cost,json=eventPerAttributeCost,proto3" json:"event_per_attribute_cost,omitempty" yaml:"event_per_attribute_cost"`
+	// EventAttributeDataCost is charged per byte of event attribute data
+	EventAttributeDataCost uint64 `protobuf:"varint,8,opt,name=event_attribute_data_cost,json=eventAttributeDataCost,proto3" json:"event_attribute_data_cost,omitempty" yaml:"event_attribute_data_cost"`
+	// EventAttributeDataFreeTier is the number of bytes of event attribute data
+	// that are free of charge
+	EventAttributeDataFreeTier uint64 `protobuf:"varint,9,opt,name=event_attribute_data_free_tier,json=eventAttributeDataFreeTier,proto3" json:"event_attribute_data_free_tier,omitempty" yaml:"event_attribute_data_free_tier"`
+	// ContractMessageDataCost is charged per byte of the message that goes to
+	// the contract
+	ContractMessageDataCost uint64 `protobuf:"varint,10,opt,name=contract_message_data_cost,json=contractMessageDataCost,proto3" json:"contract_message_data_cost,omitempty" yaml:"contract_message_data_cost"`
+	// CustomEventCost is charged per custom event
+	CustomEventCost uint64 `protobuf:"varint,11,opt,name=custom_event_cost,json=customEventCost,proto3" json:"custom_event_cost,omitempty" yaml:"custom_event_cost"`
+}
+
+func (m *GasCosts) Reset()         { *m = GasCosts{} }
+func (m *GasCosts) String() string { return proto.CompactTextString(m) }
+func (*GasCosts) ProtoMessage()    {}
+func (*GasCosts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{3}
+}
+
+func (m *GasCosts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *GasCosts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasCosts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *GasCosts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasCosts.Merge(m, src)
+}
+
+func (m *GasCosts) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *GasCosts) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasCosts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasCosts proto.InternalMessageInfo
+
 // CodeInfo is data for the uploaded contract WASM code
 type CodeInfo struct {
 	// CodeHash is the unique identifier created by wasmvm
@@ -237,13 +353,16 @@ type CodeInfo struct {
 	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
 	// InstantiateConfig access control to apply on contract creation, optional
 	InstantiateConfig AccessConfig `protobuf:"bytes,5,opt,name=instantiate_config,json=instantiateConfig,proto3" json:"instantiate_config"`
+	// Status of the code. Deprecated codes can not be used for new contract
+	// instances or migrations
+	Status CodeStatus `protobuf:"varint,6,opt,name=status,proto3,enum=cosmwasm.wasm.v1.CodeStatus" json:"status,omitempty"`
 }
 
 func (m *CodeInfo) Reset()         { *m = CodeInfo{} }
 func (m *CodeInfo) String() string { return proto.CompactTextString(m) }
 func (*CodeInfo) ProtoMessage()    {}
 func (*CodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{3}
+	return fileDescriptor_e6155d98fa173e02, []int{4}
 }
 
 func (m *CodeInfo) XXX_Unmarshal(b []byte) error {
@@ -292,14 +411,25 @@ type ContractInfo struct {
 	IBCPortID string              `protobuf:"bytes,6,opt,name=ibc_port_id,json=ibcPortId,proto3" json:"ibc_port_id,omitempty"`
 	// Extension is an extension point to store custom metadata within the
 	// persistence model.
-	Extension *types.Any `protobuf:"bytes,7,opt,name=extension,proto3" json:"extension,omitempty"`
+	Extension *types1.Any `protobuf:"bytes,7,opt,name=extension,proto3" json:"extension,omitempty"`
+	// Frozen contracts can not be executed but still be queried
+	Frozen bool `protobuf:"varint,8,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	// ExecutePermission restricts who can execute the contract. Everybody can
+	// execute the contract when not set.
+	ExecutePermission *AccessConfig `protobuf:"bytes,9,opt,name=execute_permission,json=executePermission,proto3" json:"execute_permission,omitempty"`
+	// AdminSet is an optional group of admins that replaces the single admin.
+	// Migrations require the approval of a threshold of members.
+	AdminSet *AdminSet `protobuf:"bytes,10,opt,name=admin_set,json=adminSet,proto3" json:"admin_set,omitempty"`
+	// FrozenByGov is set when the contract was frozen by governance. Such a
+	// freeze can only be lifted by governance.
+	FrozenByGov bool `protobuf:"varint,11,opt,name=frozen_by_gov,json=frozenByGov,proto3" json:"frozen_by_gov,omitempty"`
 }
 
 func (m *ContractInfo) Reset()         { *m = ContractInfo{} }
 func (m *ContractInfo) String() string { return proto.CompactTextString(m) }
 func (*ContractInfo) ProtoMessage()    {}
 func (*ContractInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{4}
+	return fileDescriptor_e6155d98fa173e02, []int{5}
 }
 
 func (m *ContractInfo) XXX_Unmarshal(b []byte) error {
@@ -333,6 +463,109 @@ func (m *ContractInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ContractInfo proto.InternalMessageInfo
 
+// AdminSet is a group of contract admins with an approval threshold
+type AdminSet struct {
+	// Members are the admin addresses
+	Members []string `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	// Threshold is the number of member approvals required for a migration.
+	// Members can act alone on other admin operations only with a threshold of 1.
+	// With a higher threshold, operations like updating the admin set, label,
+	// metadata or freezing the contract require governance.
+	Threshold uint32 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (m *AdminSet) Reset()         { *m = AdminSet{} }
+func (m *AdminSet) String() string { return proto.CompactTextString(m) }
+func (*AdminSet) ProtoMessage()    {}
+func (*AdminSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{6}
+}
+
+func (m *AdminSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *AdminSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AdminSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *AdminSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminSet.Merge(m, src)
+}
+
+func (m *AdminSet) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *AdminSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminSet proto.InternalMessageInfo
+
+// MigrationApproval collects the admin set approvals for a pending migration.
+// Each combination of code id and msg is a separate proposal.
+type MigrationApproval struct {
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// CodeID references the new WASM code
+	CodeID uint64 `protobuf:"varint,2,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// Msg json encoded message to be passed to the contract on migration
+	Msg RawContractMessage `protobuf:"bytes,3,opt,name=msg,proto3,casttype=RawContractMessage" json:"msg,omitempty"`
+	// Approvals are the addresses of the members that approved the migration
+	Approvals []string `protobuf:"bytes,4,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// ExpiresAt is the block time in unix nanoseconds after which the approvals
+	// are void
+	ExpiresAt uint64 `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (m *MigrationApproval) Reset()         { *m = MigrationApproval{} }
+func (m *MigrationApproval) String() string { return proto.CompactTextString(m) }
+func (*MigrationApproval) ProtoMessage()    {}
+func (*MigrationApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{7}
+}
+
+func (m *MigrationApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *MigrationApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrationApproval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+
+func (m *MigrationApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrationApproval.Merge(m, src)
+}
+
+func (m *MigrationApproval) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *MigrationApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrationApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrationApproval proto.InternalMessageInfo
+
 // ContractCodeHistoryEntry metadata to a contract.
 type ContractCodeHistoryEntry struct {
 	Operation ContractCodeHistoryOperationType `protobuf:"varint,1,opt,name=operation,proto3,enum=cosmwasm.wasm.v1.ContractCodeHistoryOperationType" json:"operation,omitempty"`
@@ -347,7 +580,7 @@ func (m *ContractCodeHistoryEntry) Reset()         { *m = ContractCodeHistoryEnt
 func (m *ContractCodeHistoryEntry) String() string { return proto.CompactTextString(m) }
 func (*ContractCodeHistoryEntry) ProtoMessage()    {}
 func (*ContractCodeHistoryEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{5}
+	return fileDescriptor_e6155d98fa173e02, []int{8}
 }
 
 func (m *ContractCodeHistoryEntry) XXX_Unmarshal(b []byte) error {
@@ -395,7 +628,7 @@ func (m *AbsoluteTxPosition) Reset()         { *m = AbsoluteTxPosition{} }
 func (m *AbsoluteTxPosition) String() string { return proto.CompactTextString(m) }
 func (*AbsoluteTxPosition) ProtoMessage()    {}
 func (*AbsoluteTxPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{6}
+	return fileDescriptor_e6155d98fa173e02, []int{9}
 }
 
 func (m *AbsoluteTxPosition) XXX_Unmarshal(b []byte) error {
@@ -441,7 +674,7 @@ func (m *Model) Reset()         { *m = Model{} }
 func (m *Model) String() string { return proto.CompactTextString(m) }
 func (*Model) ProtoMessage()    {}
 func (*Model) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6155d98fa173e02, []int{7}
+	return fileDescriptor_e6155d98fa173e02, []int{10}
 }
 
 func (m *Model) XXX_Unmarshal(b []byte) error {
@@ -475,268 +708,674 @@ func (m *Model) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Model proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("cosmwasm.wasm.v1.AccessType", AccessType_name, AccessType_value)
-	proto.RegisterEnum("cosmwasm.wasm.v1.ContractCodeHistoryOperationType", ContractCodeHistoryOperationType_name, ContractCodeHistoryOperationType_value)
-	proto.RegisterType((*AccessTypeParam)(nil), "cosmwasm.wasm.v1.AccessTypeParam")
-	proto.RegisterType((*AccessConfig)(nil), "cosmwasm.wasm.v1.AccessConfig")
-	proto.RegisterType((*Params)(nil), "cosmwasm.wasm.v1.Params")
-	proto.RegisterType((*CodeInfo)(nil), "cosmwasm.wasm.v1.CodeInfo")
-	proto.RegisterType((*ContractInfo)(nil), "cosmwasm.wasm.v1.ContractInfo")
-	proto.RegisterType((*ContractCodeHistoryEntry)(nil), "cosmwasm.wasm.v1.ContractCodeHistoryEntry")
-	proto.RegisterType((*AbsoluteTxPosition)(nil), "cosmwasm.wasm.v1.AbsoluteTxPosition")
-	proto.RegisterType((*Model)(nil), "cosmwasm.wasm.v1.Model")
+// ContractStorageStats is the storage usage of a contract
+type ContractStorageStats struct {
+	// KeyCount is the number of keys in the contract store
+	KeyCount uint64 `protobuf:"varint,1,opt,name=key_count,json=keyCount,proto3" json:"key_count,omitempty"`
+	// TotalBytes is the sum of all key and value sizes in the contract store
+	TotalBytes uint64 `protobuf:"varint,2,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
 }
 
-func init() { proto.RegisterFile("cosmwasm/wasm/v1/types.proto", fileDescriptor_e6155d98fa173e02) }
-
-var fileDescriptor_e6155d98fa173e02 = []byte{
-	// 1198 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0xda, 0x4e, 0x62, 0x4f, 0xf2, 0xfd, 0xb2, 0x1d, 0x52, 0xd5, 0x31, 0x91, 0x6d, 0x96,
-	0x12, 0xda, 0xb4, 0xb5, 0xdb, 0x80, 0x2a, 0xd4, 0x43, 0x25, 0xff, 0xd8, 0x36, 0x5b, 0x29, 0xb6,
-	0x35, 0x76, 0x29, 0x41, 0x2a, 0xab, 0xf5, 0xee, 0xd8, 0x19, 0x6a, 0xef, 0x58, 0x3b, 0xe3, 0xd4,
-	0xbe, 0x72, 0x42, 0x46, 0x48, 0x1c, 0x38, 0x20, 0x24, 0x4b, 0x48, 0x20, 0xe8, 0xb1, 0x87, 0xfe,
-	0x03, 0xdc, 0x2a, 0x4e, 0x15, 0x27, 0x4e, 0x16, 0xa4, 0x87, 0x72, 0xce, 0x81, 0x43, 0x4f, 0x68,
-	0x67, 0x62, 0x76, 0x45, 0x9b, 0xc6, 0x70, 0x59, 0xcd, 0xbc, 0xf7, 0x3e, 0x9f, 0xf7, 0xde, 0x67,
-	0x66, 0xdf, 0x2e, 0x58, 0xb7, 0x29, 0xeb, 0xdd, 0xb7, 0x58, 0xaf, 0x20, 0x1e, 0xfb, 0x57, 0x0a,
-	0x7c, 0xd4, 0xc7, 0x2c, 0xdf, 0xf7, 0x28, 0xa7, 0x50, 0x9d, 0x79, 0xf3, 0xe2, 0xb1, 0x7f, 0x25,
-	0xbd, 0xe6, 0x5b, 0x28, 0x33, 0x85, 0xbf, 0x20, 0x37, 0x32, 0x38, 0xbd, 0xda, 0xa1, 0x1d, 0x2a,
-	0xed, 0xfe, 0xea, 0xc8, 0xba, 0xd6, 0xa1, 0xb4, 0xd3, 0xc5, 0x05, 0xb1, 0x6b, 0x0d, 0xda, 0x05,
-	0xcb, 0x1d, 0x1d, 0xb9, 0x4e, 0x59, 0x3d, 0xe2, 0xd2, 0x82, 0x78, 0x4a, 0x93, 0x76, 0x17, 0xbc,
-	0x56, 0xb4, 0x6d, 0xcc, 0x58, 0x73, 0xd4, 0xc7, 0x75, 0xcb, 0xb3, 0x7a, 0xb0, 0x02, 0x16, 0xf6,
-	0xad, 0xee, 0x00, 0xa7, 0x94, 0x9c, 0x72, 0xee, 0xff, 0x5b, 0xeb, 0xf9, 0x7f, 0xd6, 0x94, 0x0f,
-	0x10, 0x25, 0xf5, 0x70, 0x9a, 0x5d, 0x19, 0x59, 0xbd, 0xee, 0x35, 0x4d, 0x80, 0x34, 0x24, 0xc1,
-	0xd7, 0xe2, 0x5f, 0x7f, 0x9b, 0x55, 0xb4, 0x1f, 0x15, 0xb0, 0x22, 0xa3, 0xcb, 0xd4, 0x6d, 0x93,
-	0x0e, 0x6c, 0x00, 0xd0, 0xc7, 0x5e, 0x8f, 0x30, 0x46, 0xa8, 0x3b, 0x57, 0x86, 0xd3, 0x87, 0xd3,
-	0xec, 0x29, 0x99, 0x21, 0x40, 0x6a, 0x28, 0x44, 0x03, 0xaf, 0x82, 0xa4, 0xe5, 0x38, 0x1e, 0x66,
-	0x0c, 0xb3, 0x54, 0x2c, 0x17, 0x3b, 0x97, 0x2c, 0xa5, 0x7e, 0x79, 0x74, 0x69, 0xf5, 0x48, 0xad,
-	0xa2, 0xf4, 0x35, 0xb8, 0x47, 0xdc, 0x0e, 0x0a, 0x42, 0x65, 0x8d, 0xb7, 0xe2, 0x89, 0xa8, 0x1a,
-	0xd3, 0xbe, 0x8a, 0x82, 0x45, 0xd1, 0x3f, 0x83, 0x1c, 0x40, 0x9b, 0x3a, 0xd8, 0x1c, 0xf4, 0xbb,
-	0xd4, 0x72, 0x4c, 0x4b, 0xd4, 0x22, 0x6a, 0x5d, 0xde, 0xca, 0x1c, 0x57, 0xab, 0xec, 0xaf, 0xb4,
-	0xf1, 0x78, 0x9a, 0x8d, 0x1c, 0x4e, 0xb3, 0x6b, 0xb2, 0xe2, 0x17, 0x79, 0xb4, 0x07, 0xcf, 0x1e,
-	0x6e, 0x2a, 0x48, 0xf5, 0x3d, 0xb7, 0x85, 0x43, 0xe2, 0xe1, 0x17, 0x0a, 0xc8, 0x10, 0x97, 0x71,
-	0xcb, 0xe5, 0xc4, 0xe2, 0xd8, 0x74, 0x70, 0xdb, 0x1a, 0x74, 0xb9, 0x19, 0x92, 0x2b, 0x3a, 0x87,
-	0x5c, 0xe7, 0x0f, 0xa7, 0xd9, 0xb7, 0x65, 0xf2, 0x57, 0xb3, 0x69, 0x68, 0x3d, 0x14, 0x50, 0x91,
-	0xfe, 0xfa, 0xdf, 0x6e, 0x21, 0x4e, 0x44, 0xfb, 0x49, 0x01, 0x89, 0x32, 0x75, 0xb0, 0xe1, 0xb6,
-	0x29, 0x7c, 0x03, 0x24, 0x45, 0x43, 0x7b, 0x16, 0xdb, 0x13, 0x7a, 0xac, 0xa0, 0x84, 0x6f, 0xd8,
-	0xb6, 0xd8, 0x1e, 0xdc, 0x02, 0x4b, 0xb6, 0x87, 0x2d, 0x4e, 0x3d, 0x51, 0xe7, 0xab, 0x8e, 0x60,
-	0x16, 0x08, 0x3f, 0x04, 0x30, 0x5c, 0xa4, 0x2d, 0x34, 0x4c, 0x2d, 0xcc, 0xa5, 0x74, 0xd2, 0x57,
-	0x5a, 0x8a, 0x79, 0x2a, 0x44, 0x22, 0xbd, 0xb7, 0xe2, 0x89, 0x98, 0x1a, 0xbf, 0x15, 0x4f, 0xc4,
-	0xd5, 0x05, 0xed, 0xd3, 0x18, 0x58, 0x29, 0x53, 0x97, 0x7b, 0x96, 0xcd, 0x45, 0x1f, 0x6f, 0x81,
-	0x25, 0xd1, 0x07, 0x71, 0x44, 0x17, 0xf1, 0x12, 0x38, 0x98, 0x66, 0x17, 0x45, 0x9b, 0x15, 0xb4,
-	0xe8, 0xbb, 0x0c, 0xe7, 0x3f, 0xf5, 0x93, 0x07, 0x0b, 0x96, 0xd3, 0x23, 0x6e, 0x2a, 0x76, 0x02,
-	0x42, 0x86, 0xc1, 0x55, 0xb0, 0xd0, 0xb5, 0x5a, 0xb8, 0x9b, 0x8a, 0xfb, 0xf1, 0x48, 0x6e, 0xe0,
-	0xf5, 0xa3, 0xcc, 0xd8, 0x39, 0x92, 0xe2, 0xec, 0x4b, 0xa4, 0x68, 0x31, 0xda, 0x1d, 0x70, 0xdc,
-	0x1c, 0xd6, 0x29, 0x23, 0x9c, 0x50, 0x17, 0xcd, 0x40, 0xf0, 0x12, 0x58, 0x26, 0x2d, 0xdb, 0xec,
-	0x53, 0x8f, 0xfb, 0x2d, 0x2e, 0x8a, 0x5a, 0xfe, 0x77, 0x30, 0xcd, 0x26, 0x8d, 0x52, 0xb9, 0x4e,
-	0x3d, 0x6e, 0x54, 0x50, 0x92, 0xb4, 0x6c, 0xb1, 0x74, 0xe0, 0xc7, 0x20, 0x89, 0x87, 0x1c, 0xbb,
-	0xe2, 0x8a, 0x2d, 0x89, 0x84, 0xab, 0x79, 0x39, 0x44, 0xf2, 0xb3, 0x21, 0x92, 0x2f, 0xba, 0xa3,
-	0xd2, 0xe6, 0xcf, 0x8f, 0x2e, 0x6d, 0xbc, 0x50, 0x49, 0x58, 0x59, 0x7d, 0xc6, 0x83, 0x02, 0xca,
-	0x6b, 0xf1, 0x3f, 0xfc, 0x49, 0xf0, 0x79, 0x14, 0xa4, 0x66, 0xa1, 0xbe, 0xd2, 0xdb, 0x84, 0x71,
-	0xea, 0x8d, 0x74, 0x97, 0x7b, 0x23, 0x58, 0x07, 0x49, 0xda, 0xc7, 0x9e, 0xc5, 0x83, 0xa1, 0xb0,
-	0x95, 0x3f, 0x36, 0x53, 0x08, 0x5e, 0x9b, 0xa1, 0xfc, 0xbb, 0x8f, 0x02, 0x92, 0xf0, 0x11, 0x47,
-	0x8f, 0x3d, 0xe2, 0xeb, 0x60, 0x69, 0xd0, 0x77, 0x84, 0xd0, 0xb1, 0x7f, 0x23, 0xf4, 0x11, 0x08,
-	0xbe, 0x0f, 0x62, 0x3d, 0xd6, 0x11, 0x87, 0xb7, 0x52, 0xda, 0x78, 0x3e, 0xcd, 0x42, 0x64, 0xdd,
-	0x9f, 0x55, 0xb9, 0x83, 0x19, 0xb3, 0x3a, 0xf8, 0x9b, 0x67, 0x0f, 0x37, 0x97, 0x89, 0xdb, 0x25,
-	0x2e, 0x36, 0x3f, 0x61, 0xd4, 0x45, 0x3e, 0x44, 0x43, 0x00, 0xbe, 0x48, 0x0c, 0xdf, 0x04, 0x2b,
-	0xad, 0x2e, 0xb5, 0xef, 0x99, 0x7b, 0x98, 0x74, 0xf6, 0xb8, 0xbc, 0x9c, 0x68, 0x59, 0xd8, 0xb6,
-	0x85, 0x09, 0xae, 0x81, 0x04, 0x1f, 0x9a, 0xc4, 0x75, 0xf0, 0x50, 0x36, 0x86, 0x96, 0xf8, 0xd0,
-	0xf0, 0xb7, 0x1a, 0x06, 0x0b, 0x3b, 0xd4, 0xc1, 0x5d, 0x78, 0x03, 0xc4, 0xee, 0xe1, 0x91, 0x7c,
-	0x41, 0x4b, 0xef, 0x3d, 0x9f, 0x66, 0x2f, 0x77, 0x08, 0xdf, 0x1b, 0xb4, 0xf2, 0x36, 0xed, 0x15,
-	0x6c, 0xda, 0xc3, 0xbc, 0xd5, 0xe6, 0xc1, 0xa2, 0x4b, 0x5a, 0xac, 0xd0, 0x1a, 0x71, 0xcc, 0xf2,
-	0xdb, 0x78, 0x58, 0xf2, 0x17, 0xc8, 0x27, 0xf0, 0x6f, 0xa7, 0xfc, 0x10, 0x44, 0xc5, 0xab, 0x2e,
-	0x37, 0x9b, 0x7f, 0x2a, 0x00, 0x04, 0xf3, 0x06, 0x5e, 0x05, 0x67, 0x8a, 0xe5, 0xb2, 0xde, 0x68,
-	0x98, 0xcd, 0xdd, 0xba, 0x6e, 0xde, 0xae, 0x36, 0xea, 0x7a, 0xd9, 0xb8, 0x61, 0xe8, 0x15, 0x35,
-	0x92, 0x5e, 0x1b, 0x4f, 0x72, 0xa7, 0x83, 0xe0, 0xdb, 0x2e, 0xeb, 0x63, 0x9b, 0xb4, 0x09, 0x76,
-	0xe0, 0x45, 0x00, 0xc3, 0xb8, 0x6a, 0xad, 0x54, 0xab, 0xec, 0xaa, 0x4a, 0x7a, 0x75, 0x3c, 0xc9,
-	0xa9, 0x01, 0xa4, 0x4a, 0x5b, 0xd4, 0x19, 0xc1, 0x2d, 0x70, 0x3a, 0x1c, 0xad, 0x7f, 0xa0, 0xa3,
-	0x5d, 0x01, 0x88, 0xa5, 0xcf, 0x8c, 0x27, 0xb9, 0xd7, 0x03, 0x80, 0xbe, 0x8f, 0xbd, 0x91, 0xc0,
-	0x5c, 0x07, 0xeb, 0x61, 0x4c, 0xb1, 0xba, 0x6b, 0xd6, 0x6e, 0x98, 0xc5, 0x4a, 0x05, 0xe9, 0x8d,
-	0x86, 0xde, 0x50, 0xe3, 0xe9, 0xf5, 0xf1, 0x24, 0x97, 0x0a, 0xa0, 0x45, 0x77, 0x54, 0x6b, 0x17,
-	0x67, 0x5f, 0x87, 0x74, 0xe2, 0xb3, 0xef, 0x32, 0x91, 0x07, 0xdf, 0x67, 0x22, 0x9a, 0xff, 0x85,
-	0x88, 0x6e, 0xfe, 0x10, 0x03, 0xb9, 0x93, 0xae, 0x20, 0xc4, 0xe0, 0x72, 0xb9, 0x56, 0x6d, 0xa2,
-	0x62, 0xb9, 0x69, 0x96, 0x6b, 0x15, 0xdd, 0xdc, 0x36, 0x1a, 0xcd, 0x1a, 0xda, 0x35, 0x6b, 0x75,
-	0x1d, 0x15, 0x9b, 0x46, 0xad, 0xfa, 0x32, 0x9d, 0x0a, 0xe3, 0x49, 0xee, 0xc2, 0x49, 0xdc, 0x61,
-	0xf5, 0xee, 0x80, 0xf3, 0x73, 0xa5, 0x31, 0xaa, 0x46, 0x53, 0x55, 0xd2, 0xe7, 0xc6, 0x93, 0xdc,
-	0xd9, 0x93, 0xf8, 0x0d, 0x97, 0x70, 0x78, 0x17, 0x5c, 0x9c, 0x8b, 0x78, 0xc7, 0xb8, 0x89, 0x8a,
-	0x4d, 0x5d, 0x8d, 0xa6, 0x2f, 0x8c, 0x27, 0xb9, 0x77, 0x4e, 0xe2, 0xde, 0x21, 0x1d, 0xcf, 0xe2,
-	0x78, 0x6e, 0xfa, 0x9b, 0x7a, 0x55, 0x6f, 0x18, 0x0d, 0x35, 0x36, 0x1f, 0xfd, 0x4d, 0xec, 0x62,
-	0x46, 0x58, 0x3a, 0xee, 0x1f, 0x59, 0x69, 0xfb, 0xf1, 0xef, 0x99, 0xc8, 0x83, 0x83, 0x8c, 0xf2,
-	0xf8, 0x20, 0xa3, 0x3c, 0x39, 0xc8, 0x28, 0xbf, 0x1d, 0x64, 0x94, 0x2f, 0x9f, 0x66, 0x22, 0x4f,
-	0x9e, 0x66, 0x22, 0xbf, 0x3e, 0xcd, 0x44, 0x3e, 0xda, 0x08, 0xbd, 0x10, 0x65, 0xca, 0x7a, 0x77,
-	0x66, 0xff, 0x63, 0x4e, 0x61, 0x28, 0xff, 0xcb, 0xc4, 0x4f, 0x59, 0x6b, 0x51, 0xcc, 0xbf, 0x77,
-	0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xba, 0x63, 0x50, 0xbd, 0xb5, 0x09, 0x00, 0x00,
+func (m *ContractStorageStats) Reset()         { *m = ContractStorageStats{} }
+func (m *ContractStorageStats) String() string { return proto.CompactTextString(m) }
+func (*ContractStorageStats) ProtoMessage()    {}
+func (*ContractStorageStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{11}
 }
 
-func (this *AccessTypeParam) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ContractStorageStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
 
-	that1, ok := that.(*AccessTypeParam)
-	if !ok {
-		that2, ok := that.(AccessTypeParam)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *ContractStorageStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractStorageStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Value != that1.Value {
-		return false
-	}
-	return true
 }
 
-func (this *AccessConfig) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ContractStorageStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractStorageStats.Merge(m, src)
+}
 
-	that1, ok := that.(*AccessConfig)
-	if !ok {
-		that2, ok := that.(AccessConfig)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Permission != that1.Permission {
-		return false
-	}
-	if len(this.Addresses) != len(that1.Addresses) {
-		return false
-	}
-	for i := range this.Addresses {
-		if this.Addresses[i] != that1.Addresses[i] {
-			return false
-		}
-	}
-	return true
+func (m *ContractStorageStats) XXX_Size() int {
+	return m.Size()
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ContractStorageStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractStorageStats.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.CodeUploadAccess.Equal(&that1.CodeUploadAccess) {
-		return false
-	}
-	if this.InstantiateDefaultPermission != that1.InstantiateDefaultPermission {
-		return false
-	}
-	return true
+var xxx_messageInfo_ContractStorageStats proto.InternalMessageInfo
+
+// ContractStorageDeposit is the storage deposit that is held in escrow for a
+// contract
+type ContractStorageDeposit struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (this *CodeInfo) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ContractStorageDeposit) Reset()         { *m = ContractStorageDeposit{} }
+func (m *ContractStorageDeposit) String() string { return proto.CompactTextString(m) }
+func (*ContractStorageDeposit) ProtoMessage()    {}
+func (*ContractStorageDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{12}
+}
 
-	that1, ok := that.(*CodeInfo)
-	if !ok {
-		that2, ok := that.(CodeInfo)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *ContractStorageDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ContractStorageDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractStorageDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.CodeHash, that1.CodeHash) {
-		return false
-	}
-	if this.Creator != that1.Creator {
-		return false
-	}
-	if !this.InstantiateConfig.Equal(&that1.InstantiateConfig) {
-		return false
-	}
-	return true
 }
 
-func (this *ContractInfo) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ContractStorageDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractStorageDeposit.Merge(m, src)
+}
 
-	that1, ok := that.(*ContractInfo)
-	if !ok {
-		that2, ok := that.(ContractInfo)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.CodeID != that1.CodeID {
-		return false
+func (m *ContractStorageDeposit) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ContractStorageDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractStorageDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractStorageDeposit proto.InternalMessageInfo
+
+// CronSchedule is a contract call that is executed with a sudo message at the
+// end of every interval blocks
+type CronSchedule struct {
+	// Name is the unique identifier of the schedule
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Msg json encoded message to be passed to the contract as sudo
+	Msg RawContractMessage `protobuf:"bytes,3,opt,name=msg,proto3,casttype=RawContractMessage" json:"msg,omitempty"`
+	// Interval is the number of blocks between two runs
+	Interval uint64 `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	// GasLimit is the max gas that can be spent on a single run
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// LastRun is the result of the last execution, not set before the first run
+	LastRun *CronRun `protobuf:"bytes,6,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
+}
+
+func (m *CronSchedule) Reset()         { *m = CronSchedule{} }
+func (m *CronSchedule) String() string { return proto.CompactTextString(m) }
+func (*CronSchedule) ProtoMessage()    {}
+func (*CronSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{13}
+}
+
+func (m *CronSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *CronSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CronSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.Creator != that1.Creator {
-		return false
+}
+
+func (m *CronSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronSchedule.Merge(m, src)
+}
+
+func (m *CronSchedule) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *CronSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronSchedule proto.InternalMessageInfo
+
+// CronRun is the result of a scheduled contract execution
+type CronRun struct {
+	// Height is the block height of the execution
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Success is true when the contract was executed without error
+	Success bool `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// Error is the error message of a failed execution
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// GasUsed is the gas consumed by the execution
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *CronRun) Reset()         { *m = CronRun{} }
+func (m *CronRun) String() string { return proto.CompactTextString(m) }
+func (*CronRun) ProtoMessage()    {}
+func (*CronRun) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{14}
+}
+
+func (m *CronRun) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *CronRun) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CronRun.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.Admin != that1.Admin {
-		return false
+}
+
+func (m *CronRun) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronRun.Merge(m, src)
+}
+
+func (m *CronRun) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *CronRun) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronRun.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronRun proto.InternalMessageInfo
+
+// Callback is a call that a contract scheduled to itself. The contract is
+// called with a sudo message at the end of the target block.
+type Callback struct {
+	// ID is the unique identifier of the callback
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Contract is the address of the smart contract that is called
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Height is the block height of the execution, zero when scheduled by time
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Time is the earliest block time of the execution in unix nanoseconds, zero
+	// when scheduled by height
+	Time uint64 `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+	// Payload is passed to the contract with the sudo message
+	Payload []byte `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Deposit is returned to the contract after execution or cancellation
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+}
+
+func (m *Callback) Reset()         { *m = Callback{} }
+func (m *Callback) String() string { return proto.CompactTextString(m) }
+func (*Callback) ProtoMessage()    {}
+func (*Callback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{15}
+}
+
+func (m *Callback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *Callback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Callback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.Label != that1.Label {
-		return false
+}
+
+func (m *Callback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Callback.Merge(m, src)
+}
+
+func (m *Callback) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *Callback) XXX_DiscardUnknown() {
+	xxx_messageInfo_Callback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Callback proto.InternalMessageInfo
+
+// FeeSponsorship is the configuration of a contract to pay the tx fees for
+// calls to it
+type FeeSponsorship struct {
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Balance is the remaining deposit to pay fees from
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+	// MaxFeePerSender is the max total fee paid for a single sender. Not
+	// limited when empty
+	MaxFeePerSender github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=max_fee_per_sender,json=maxFeePerSender,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_fee_per_sender"`
+	// MaxFeePerBlock is the max total fee paid within a single block. Not
+	// limited when empty
+	MaxFeePerBlock github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=max_fee_per_block,json=maxFeePerBlock,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_fee_per_block"`
+	// BlockHeight is the height of the last block with a sponsored fee
+	BlockHeight int64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// BlockSpent is the total fee paid within the last block with a
+	// sponsored fee
+	BlockSpent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=block_spent,json=blockSpent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"block_spent"`
+}
+
+func (m *FeeSponsorship) Reset()         { *m = FeeSponsorship{} }
+func (m *FeeSponsorship) String() string { return proto.CompactTextString(m) }
+func (*FeeSponsorship) ProtoMessage()    {}
+func (*FeeSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{16}
+}
+
+func (m *FeeSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *FeeSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.Created.Equal(that1.Created) {
-		return false
+}
+
+func (m *FeeSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSponsorship.Merge(m, src)
+}
+
+func (m *FeeSponsorship) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *FeeSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSponsorship proto.InternalMessageInfo
+
+// FeeSponsorshipUsage is the total fee that a contract paid for a sender
+type FeeSponsorshipUsage struct {
+	// Contract is the address of the smart contract
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Sender is the address of the fee payer
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Spent is the total fee paid for the sender
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+}
+
+func (m *FeeSponsorshipUsage) Reset()         { *m = FeeSponsorshipUsage{} }
+func (m *FeeSponsorshipUsage) String() string { return proto.CompactTextString(m) }
+func (*FeeSponsorshipUsage) ProtoMessage()    {}
+func (*FeeSponsorshipUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{17}
+}
+
+func (m *FeeSponsorshipUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *FeeSponsorshipUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSponsorshipUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.IBCPortID != that1.IBCPortID {
-		return false
+}
+
+func (m *FeeSponsorshipUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSponsorshipUsage.Merge(m, src)
+}
+
+func (m *FeeSponsorshipUsage) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *FeeSponsorshipUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSponsorshipUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSponsorshipUsage proto.InternalMessageInfo
+
+// ContractDependency is a recorded call from one contract to another
+type ContractDependency struct {
+	// Caller is the address of the calling contract
+	Caller string `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	// Callee is the address of the called contract
+	Callee string `protobuf:"bytes,2,opt,name=callee,proto3" json:"callee,omitempty"`
+	// MessageCount is the number of dispatched execute and migrate messages
+	MessageCount uint64 `protobuf:"varint,3,opt,name=message_count,json=messageCount,proto3" json:"message_count,omitempty"`
+	// LastHeight is the block height of the last recorded call
+	LastHeight int64 `protobuf:"varint,5,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+}
+
+func (m *ContractDependency) Reset()         { *m = ContractDependency{} }
+func (m *ContractDependency) String() string { return proto.CompactTextString(m) }
+func (*ContractDependency) ProtoMessage()    {}
+func (*ContractDependency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{18}
+}
+
+func (m *ContractDependency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ContractDependency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractDependency.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if !this.Extension.Equal(that1.Extension) {
-		return false
+}
+
+func (m *ContractDependency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractDependency.Merge(m, src)
+}
+
+func (m *ContractDependency) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ContractDependency) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractDependency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractDependency proto.InternalMessageInfo
+
+// ContractMetadata is the descriptive information of a contract that is set
+// by the contract admin or creator
+type ContractMetadata struct {
+	// Name is the display name of the contract
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Description is a short description of the contract
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Homepage is the http(s) URL of the project website
+	Homepage string `protobuf:"bytes,3,opt,name=homepage,proto3" json:"homepage,omitempty"`
+	// AuditLinks are http(s) URLs of audit reports
+	AuditLinks []string `protobuf:"bytes,4,rep,name=audit_links,json=auditLinks,proto3" json:"audit_links,omitempty"`
+	// SchemaURI is the location of the JSON schema of the contract messages
+	SchemaURI string `protobuf:"bytes,5,opt,name=schema_uri,json=schemaUri,proto3" json:"schema_uri,omitempty"`
+	// SchemaHash is the hex encoded sha256 hash of the JSON schema
+	SchemaHash string `protobuf:"bytes,6,opt,name=schema_hash,json=schemaHash,proto3" json:"schema_hash,omitempty"`
+}
+
+func (m *ContractMetadata) Reset()         { *m = ContractMetadata{} }
+func (m *ContractMetadata) String() string { return proto.CompactTextString(m) }
+func (*ContractMetadata) ProtoMessage()    {}
+func (*ContractMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6155d98fa173e02, []int{19}
+}
+
+func (m *ContractMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+
+func (m *ContractMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return true
 }
 
-func (this *ContractCodeHistoryEntry) Equal(that interface{}) bool {
+func (m *ContractMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractMetadata.Merge(m, src)
+}
+
+func (m *ContractMetadata) XXX_Size() int {
+	return m.Size()
+}
+
+func (m *ContractMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractMetadata proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("cosmwasm.wasm.v1.AccessType", AccessType_name, AccessType_value)
+	proto.RegisterEnum("cosmwasm.wasm.v1.CodeStatus", CodeStatus_name, CodeStatus_value)
+	proto.RegisterEnum("cosmwasm.wasm.v1.ContractCodeHistoryOperationType", ContractCodeHistoryOperationType_name, ContractCodeHistoryOperationType_value)
+	proto.RegisterType((*AccessTypeParam)(nil), "cosmwasm.wasm.v1.AccessTypeParam")
+	proto.RegisterType((*AccessConfig)(nil), "cosmwasm.wasm.v1.AccessConfig")
+	proto.RegisterType((*Params)(nil), "cosmwasm.wasm.v1.Params")
+	proto.RegisterType((*GasCosts)(nil), "cosmwasm.wasm.v1.GasCosts")
+	proto.RegisterType((*CodeInfo)(nil), "cosmwasm.wasm.v1.CodeInfo")
+	proto.RegisterType((*ContractInfo)(nil), "cosmwasm.wasm.v1.ContractInfo")
+	proto.RegisterType((*AdminSet)(nil), "cosmwasm.wasm.v1.AdminSet")
+	proto.RegisterType((*MigrationApproval)(nil), "cosmwasm.wasm.v1.MigrationApproval")
+	proto.RegisterType((*ContractCodeHistoryEntry)(nil), "cosmwasm.wasm.v1.ContractCodeHistoryEntry")
+	proto.RegisterType((*AbsoluteTxPosition)(nil), "cosmwasm.wasm.v1.AbsoluteTxPosition")
+	proto.RegisterType((*Model)(nil), "cosmwasm.wasm.v1.Model")
+	proto.RegisterType((*ContractStorageStats)(nil), "cosmwasm.wasm.v1.ContractStorageStats")
+	proto.RegisterType((*ContractStorageDeposit)(nil), "cosmwasm.wasm.v1.ContractStorageDeposit")
+	proto.RegisterType((*CronSchedule)(nil), "cosmwasm.wasm.v1.CronSchedule")
+	proto.RegisterType((*CronRun)(nil), "cosmwasm.wasm.v1.CronRun")
+	proto.RegisterType((*Callback)(nil), "cosmwasm.wasm.v1.Callback")
+	proto.RegisterType((*FeeSponsorship)(nil), "cosmwasm.wasm.v1.FeeSponsorship")
+	proto.RegisterType((*FeeSponsorshipUsage)(nil), "cosmwasm.wasm.v1.FeeSponsorshipUsage")
+	proto.RegisterType((*ContractDependency)(nil), "cosmwasm.wasm.v1.ContractDependency")
+	proto.RegisterType((*ContractMetadata)(nil), "cosmwasm.wasm.v1.ContractMetadata")
+}
+
+func init() { proto.RegisterFile("cosmwasm/wasm/v1/types.proto", fileDescriptor_e6155d98fa173e02) }
+
+var fileDescriptor_e6155d98fa173e02 = []byte{
+	// 2650 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x39, 0x4d, 0x6c, 0x23, 0x57,
+	0xfd, 0x19, 0xdb, 0x49, 0xec, 0x97, 0xec, 0xae, 0xf3, 0x9a, 0xcd, 0x3a, 0x6e, 0x6a, 0xbb, 0xd3,
+	0x76, 0xff, 0xe9, 0xb6, 0xeb, 0x74, 0xf7, 0x5f, 0x95, 0x6a, 0x25, 0x2a, 0xfc, 0x95, 0x8d, 0xab,
+	0xe6, 0x43, 0xcf, 0x4e, 0xcb, 0xa2, 0x96, 0xe1, 0x79, 0xe6, 0xc5, 0x19, 0x32, 0x1f, 0xee, 0xbc,
+	0x37, 0x69, 0x4c, 0x4f, 0x70, 0x42, 0x29, 0x20, 0x24, 0x2e, 0x08, 0x14, 0x09, 0x09, 0x44, 0x2b,
+	0x4e, 0x3d, 0xf4, 0xca, 0xb5, 0xaa, 0x38, 0x15, 0xc4, 0x81, 0x93, 0x81, 0xf4, 0x50, 0x6e, 0x88,
+	0x1c, 0x8a, 0xd4, 0x13, 0x7a, 0x1f, 0xe3, 0x71, 0x36, 0xc9, 0x26, 0x5d, 0x55, 0x7b, 0x71, 0xe6,
+	0xfd, 0xbe, 0x7f, 0xbf, 0xf9, 0x7d, 0xbd, 0x09, 0x58, 0x30, 0x7d, 0xea, 0xbe, 0x83, 0xa9, 0xbb,
+	0x24, 0x7e, 0x76, 0x6f, 0x2d, 0xb1, 0x7e, 0x8f, 0xd0, 0x72, 0x2f, 0xf0, 0x99, 0x0f, 0xb3, 0x11,
+	0xb6, 0x2c, 0x7e, 0x76, 0x6f, 0xe5, 0xe7, 0x39, 0xc4, 0xa7, 0x86, 0xc0, 0x2f, 0xc9, 0x83, 0x24,
+	0xce, 0xcf, 0x76, 0xfd, 0xae, 0x2f, 0xe1, 0xfc, 0x49, 0x41, 0xe7, 0xbb, 0xbe, 0xdf, 0x75, 0xc8,
+	0x92, 0x38, 0x75, 0xc2, 0xad, 0x25, 0xec, 0xf5, 0x15, 0x6a, 0x06, 0xbb, 0xb6, 0xe7, 0x2f, 0x89,
+	0x5f, 0x05, 0x2a, 0x48, 0x89, 0x4b, 0x1d, 0x4c, 0xc9, 0xd2, 0xee, 0xad, 0x0e, 0x61, 0xf8, 0xd6,
+	0x92, 0xe9, 0xdb, 0x9e, 0xc4, 0xeb, 0x6f, 0x81, 0x2b, 0x15, 0xd3, 0x24, 0x94, 0xb6, 0xfb, 0x3d,
+	0xb2, 0x81, 0x03, 0xec, 0xc2, 0x3a, 0x18, 0xdf, 0xc5, 0x4e, 0x48, 0x72, 0x5a, 0x49, 0x5b, 0xbc,
+	0x7c, 0x7b, 0xa1, 0x7c, 0xbf, 0xcd, 0xe5, 0x98, 0xa3, 0x9a, 0x3d, 0x1a, 0x14, 0xa7, 0xfb, 0xd8,
+	0x75, 0xee, 0xe8, 0x82, 0x49, 0x47, 0x92, 0xf9, 0x4e, 0xea, 0x97, 0xbf, 0x29, 0x6a, 0xfa, 0xfb,
+	0x1a, 0x98, 0x96, 0xd4, 0x35, 0xdf, 0xdb, 0xb2, 0xbb, 0xb0, 0x05, 0x40, 0x8f, 0x04, 0xae, 0x4d,
+	0xa9, 0xed, 0x7b, 0x17, 0xd2, 0x70, 0xf5, 0x68, 0x50, 0x9c, 0x91, 0x1a, 0x62, 0x4e, 0x1d, 0x8d,
+	0x88, 0x81, 0x2f, 0x81, 0x0c, 0xb6, 0xac, 0x80, 0x50, 0x4a, 0x68, 0x2e, 0x59, 0x4a, 0x2e, 0x66,
+	0xaa, 0xb9, 0xbf, 0x7c, 0x74, 0x73, 0x56, 0x45, 0xb3, 0x22, 0x71, 0x2d, 0x16, 0xd8, 0x5e, 0x17,
+	0xc5, 0xa4, 0xd2, 0xc6, 0x57, 0x53, 0xe9, 0x44, 0x36, 0xa9, 0xff, 0x67, 0x02, 0x4c, 0x08, 0xff,
+	0x29, 0x64, 0x00, 0x9a, 0xbe, 0x45, 0x8c, 0xb0, 0xe7, 0xf8, 0xd8, 0x32, 0xb0, 0xb0, 0x45, 0xd8,
+	0x3a, 0x75, 0xbb, 0x70, 0x96, 0xad, 0xd2, 0xbf, 0xea, 0xf5, 0x4f, 0x06, 0xc5, 0xb1, 0xa3, 0x41,
+	0x71, 0x5e, 0x5a, 0x7c, 0x52, 0x8e, 0xfe, 0xc1, 0xe7, 0x1f, 0xde, 0xd0, 0x50, 0x96, 0x63, 0x36,
+	0x05, 0x42, 0xf2, 0xc3, 0x9f, 0x6a, 0xa0, 0x60, 0x7b, 0x94, 0x61, 0x8f, 0xd9, 0x98, 0x11, 0xc3,
+	0x22, 0x5b, 0x38, 0x74, 0x98, 0x31, 0x12, 0xae, 0xc4, 0x05, 0xc2, 0xf5, 0xec, 0xd1, 0xa0, 0xf8,
+	0x8c, 0x54, 0xfe, 0x60, 0x69, 0x3a, 0x5a, 0x18, 0x21, 0xa8, 0x4b, 0xfc, 0x46, 0x1c, 0xd4, 0x26,
+	0x98, 0xe9, 0x38, 0xbe, 0xb9, 0x43, 0x2c, 0xc3, 0xdc, 0x26, 0xe6, 0x0e, 0x0d, 0x5d, 0x19, 0xdc,
+	0xe9, 0xea, 0xc2, 0xd1, 0xa0, 0x98, 0x93, 0x3a, 0x4e, 0x90, 0xe8, 0x28, 0xab, 0x60, 0xb5, 0x08,
+	0x04, 0xff, 0xa8, 0x81, 0x1c, 0x65, 0x7e, 0x80, 0xbb, 0xdc, 0x90, 0x9e, 0x4f, 0x6d, 0x61, 0x88,
+	0xd1, 0xe9, 0x33, 0x92, 0x4b, 0x95, 0x92, 0x8b, 0x53, 0xb7, 0xe7, 0xcb, 0xea, 0x65, 0xf1, 0x44,
+	0x2d, 0xab, 0x44, 0x2d, 0xd7, 0x7c, 0xdb, 0xab, 0xda, 0x2a, 0xa4, 0x45, 0xa9, 0xf1, 0x2c, 0x41,
+	0xfa, 0x1f, 0xfe, 0x5e, 0x5c, 0xec, 0xda, 0x6c, 0x3b, 0xec, 0x94, 0x4d, 0xdf, 0x55, 0xa5, 0xa4,
+	0xfe, 0xdc, 0xa4, 0xd6, 0x8e, 0x2a, 0x44, 0x2e, 0x93, 0xfe, 0xea, 0xf3, 0x0f, 0x6f, 0x4c, 0x3b,
+	0xa4, 0x8b, 0xcd, 0xbe, 0xc1, 0xab, 0x81, 0xca, 0xb7, 0x72, 0x55, 0x09, 0xaf, 0x4b, 0xd9, 0x1b,
+	0x24, 0xa8, 0xf6, 0x19, 0x81, 0xef, 0x6b, 0x20, 0x6b, 0x62, 0xc7, 0xe9, 0x60, 0x73, 0x27, 0xd2,
+	0x9b, 0x1b, 0x3f, 0xcf, 0x6e, 0xac, 0xec, 0xbe, 0xa6, 0x52, 0xe1, 0x3e, 0x01, 0x5f, 0x87, 0xbd,
+	0x57, 0x22, 0xa1, 0xca, 0x60, 0xf8, 0x06, 0x98, 0x0b, 0x3d, 0xfb, 0xed, 0x90, 0x18, 0xa6, 0xef,
+	0xb1, 0x00, 0x9b, 0xcc, 0x70, 0x70, 0x87, 0x38, 0x34, 0x37, 0x51, 0xd2, 0x16, 0xd3, 0xd5, 0x27,
+	0x8f, 0x06, 0xc5, 0x27, 0xa4, 0x3d, 0xa7, 0xd3, 0xe9, 0x68, 0x56, 0x22, 0x6a, 0x0a, 0xfe, 0x9a,
+	0x00, 0xc3, 0x55, 0x90, 0xe9, 0x62, 0x6a, 0x98, 0x3e, 0x65, 0x34, 0x37, 0x29, 0x4a, 0x21, 0x7f,
+	0x32, 0x0f, 0xef, 0x62, 0x5a, 0xe3, 0x14, 0xd5, 0xd9, 0xa3, 0x41, 0x31, 0x2b, 0xf5, 0x0c, 0xd9,
+	0x74, 0x94, 0xee, 0x2a, 0xbc, 0xa8, 0xbc, 0x31, 0xfd, 0xe3, 0x49, 0x90, 0x8e, 0x58, 0xe0, 0x37,
+	0xc1, 0x25, 0x99, 0x8f, 0x26, 0x11, 0xf4, 0xa2, 0xe0, 0x52, 0xd5, 0xdc, 0xd1, 0xa0, 0x38, 0x3b,
+	0x9a, 0xcf, 0x0a, 0xad, 0xa3, 0xe9, 0xe8, 0xcc, 0xf9, 0xb9, 0xe7, 0xc7, 0xf0, 0x86, 0x65, 0x53,
+	0xd3, 0x0f, 0x3d, 0x26, 0xaa, 0x26, 0x35, 0xea, 0xf9, 0xe9, 0x74, 0x3a, 0x9a, 0x1d, 0x15, 0x58,
+	0x57, 0x60, 0x78, 0x07, 0x4c, 0x9b, 0xbe, 0xdb, 0xb3, 0x1d, 0x65, 0x56, 0x52, 0x88, 0xbb, 0x76,
+	0x34, 0x28, 0x3e, 0x16, 0xd5, 0x78, 0x8c, 0xd5, 0xd1, 0x94, 0x3a, 0x0a, 0xa3, 0xbe, 0x07, 0xe6,
+	0x43, 0x8f, 0x03, 0x78, 0xc3, 0x91, 0xea, 0xbc, 0xd0, 0x25, 0x01, 0x66, 0x7e, 0x90, 0x4b, 0x09,
+	0x41, 0x4f, 0x1f, 0x0d, 0x8a, 0xa5, 0xe8, 0x8d, 0x9c, 0x41, 0xaa, 0xa3, 0x6b, 0x31, 0x8e, 0x0b,
+	0x5e, 0x8b, 0x30, 0x70, 0x0b, 0x3c, 0x7e, 0x3f, 0x9b, 0x45, 0x3c, 0xdf, 0xb5, 0x3d, 0xa1, 0x63,
+	0x5c, 0xe8, 0xb8, 0x7e, 0x34, 0x28, 0xea, 0xa7, 0xeb, 0x18, 0x21, 0xd6, 0xd1, 0xfc, 0x71, 0x2d,
+	0xf5, 0x18, 0x07, 0xbf, 0x05, 0x2e, 0xf3, 0x17, 0xe9, 0x86, 0x0e, 0xb3, 0x7b, 0x8e, 0x4d, 0x02,
+	0x91, 0x50, 0xa9, 0xea, 0xfc, 0xd1, 0xa0, 0x78, 0x35, 0x7e, 0xd1, 0x31, 0x5e, 0x47, 0x97, 0xba,
+	0x98, 0xae, 0x0e, 0xcf, 0xf0, 0x4d, 0x90, 0x23, 0xbb, 0xc4, 0x93, 0x05, 0x8b, 0x19, 0x0b, 0xec,
+	0x4e, 0xc8, 0x54, 0x4c, 0x27, 0x85, 0xac, 0xa7, 0xe2, 0x22, 0x3f, 0x8b, 0x52, 0x47, 0x57, 0x05,
+	0x6a, 0x83, 0x04, 0x95, 0x08, 0x21, 0x22, 0x6d, 0x80, 0x79, 0xc9, 0x13, 0xd3, 0x5b, 0x98, 0x61,
+	0x29, 0x3e, 0x7d, 0x7f, 0xa4, 0xcf, 0x24, 0xd5, 0xd1, 0x9c, 0xc0, 0x0d, 0x85, 0xd7, 0x31, 0xc3,
+	0x42, 0x81, 0x0b, 0x0a, 0xa7, 0x72, 0x6d, 0x05, 0x84, 0x18, 0x8c, 0x07, 0x24, 0x23, 0xb4, 0x8c,
+	0xf4, 0xdf, 0x07, 0xd3, 0xeb, 0x28, 0x7f, 0x52, 0xd5, 0x72, 0x40, 0x48, 0x9b, 0x47, 0xab, 0x03,
+	0xf2, 0xc3, 0xca, 0x74, 0x09, 0xa5, 0xb8, 0xab, 0xf8, 0x85, 0x43, 0x40, 0xa8, 0x7a, 0xe6, 0x68,
+	0x50, 0x7c, 0x32, 0xca, 0xc1, 0xb3, 0x68, 0x75, 0x74, 0x2d, 0x42, 0xae, 0x4a, 0xdc, 0xd0, 0xa5,
+	0x15, 0x30, 0x63, 0x86, 0x94, 0xf9, 0xae, 0x21, 0x2d, 0x15, 0xa2, 0xa7, 0x84, 0xe8, 0x91, 0x0e,
+	0x7f, 0x82, 0x44, 0x47, 0x57, 0x24, 0xac, 0xc1, 0x41, 0x5c, 0x92, 0xfe, 0x5f, 0x0d, 0xa4, 0x6b,
+	0xbe, 0x45, 0x9a, 0xde, 0x96, 0x0f, 0x1f, 0x07, 0x19, 0x31, 0xf6, 0xb6, 0x31, 0xdd, 0x16, 0x45,
+	0x3c, 0x8d, 0xd2, 0x1c, 0xb0, 0x82, 0xe9, 0x36, 0xbc, 0x0d, 0x26, 0xcd, 0x80, 0x88, 0xdc, 0xe4,
+	0x75, 0xf9, 0xa0, 0x41, 0x1d, 0x11, 0xc2, 0x6f, 0x03, 0x38, 0x3a, 0xca, 0x4c, 0x31, 0x69, 0x73,
+	0xe3, 0x17, 0x9a, 0xc7, 0x19, 0xde, 0x84, 0x65, 0xb3, 0x9c, 0x19, 0x11, 0x22, 0xb1, 0xf0, 0x45,
+	0x30, 0x41, 0x19, 0x66, 0xa1, 0x6c, 0x8f, 0xa7, 0x8e, 0x56, 0xee, 0x56, 0x4b, 0xd0, 0x20, 0x45,
+	0xfb, 0x6a, 0x2a, 0x9d, 0xcc, 0xa6, 0x5e, 0x4d, 0xa5, 0x53, 0xd9, 0x71, 0xfd, 0xe3, 0x14, 0x98,
+	0x8e, 0x5a, 0xa5, 0xf0, 0xfe, 0x29, 0x30, 0x29, 0xbc, 0xb7, 0x2d, 0xd5, 0xc0, 0xc0, 0xe1, 0xa0,
+	0x38, 0x21, 0x82, 0x53, 0x47, 0x13, 0x1c, 0xd5, 0xb4, 0x1e, 0x2a, 0x0a, 0x65, 0x30, 0x8e, 0x2d,
+	0xd7, 0xf6, 0x72, 0xc9, 0x73, 0x38, 0x24, 0x19, 0x9c, 0x05, 0xe3, 0xa2, 0xa5, 0x8b, 0x3e, 0x93,
+	0x41, 0xf2, 0x00, 0x5f, 0x51, 0x9a, 0x89, 0xa5, 0x02, 0xf8, 0xf4, 0x29, 0x01, 0xec, 0x50, 0xdf,
+	0x09, 0x19, 0x69, 0xef, 0x6d, 0xf0, 0xa1, 0x62, 0xfb, 0x1e, 0x8a, 0x98, 0xe0, 0x4d, 0x30, 0x65,
+	0x77, 0x4c, 0xa3, 0xe7, 0x07, 0x8c, 0xbb, 0x38, 0x21, 0x6c, 0xb9, 0x74, 0x38, 0x28, 0x66, 0x9a,
+	0xd5, 0xda, 0x86, 0x1f, 0xb0, 0x66, 0x1d, 0x65, 0xec, 0x8e, 0x29, 0x1e, 0x2d, 0xf8, 0x5d, 0x90,
+	0x21, 0x7b, 0x8c, 0x78, 0x62, 0x7d, 0x91, 0x63, 0x63, 0xb6, 0x2c, 0x17, 0xd8, 0x72, 0xb4, 0xc0,
+	0x96, 0x2b, 0x5e, 0xbf, 0x7a, 0xe3, 0x4f, 0x1f, 0xdd, 0xbc, 0x7e, 0x4a, 0xf0, 0xe3, 0xc8, 0x36,
+	0x22, 0x39, 0x28, 0x16, 0x09, 0xe7, 0xc0, 0xc4, 0x56, 0xe0, 0xff, 0x80, 0x78, 0xa2, 0xc6, 0xd3,
+	0x48, 0x9d, 0xe0, 0x2a, 0x80, 0x64, 0x8f, 0x98, 0xbc, 0xea, 0x46, 0xf6, 0xa7, 0xcc, 0x45, 0x52,
+	0x06, 0xcd, 0x28, 0xce, 0x91, 0x5d, 0xe8, 0x1b, 0x7c, 0xc1, 0x74, 0x6d, 0xcf, 0xa0, 0x44, 0x16,
+	0xdf, 0xa9, 0xd3, 0xaf, 0xc2, 0x49, 0x5a, 0x84, 0xa1, 0x34, 0x56, 0x4f, 0x50, 0x07, 0x97, 0xa4,
+	0x45, 0x46, 0xa7, 0x6f, 0x74, 0xfd, 0x5d, 0x51, 0x5e, 0x69, 0x34, 0x25, 0x81, 0xd5, 0xfe, 0x5d,
+	0x7f, 0xf7, 0x4e, 0xea, 0x5f, 0x7c, 0x53, 0x7e, 0x13, 0xa4, 0x23, 0x7e, 0x9e, 0x1e, 0x2e, 0x71,
+	0x3b, 0x24, 0xe0, 0x5b, 0xe7, 0x83, 0xb7, 0xd9, 0x88, 0x10, 0x2e, 0x80, 0x0c, 0xdb, 0x0e, 0x08,
+	0xdd, 0xf6, 0x1d, 0x4b, 0x24, 0xd5, 0x25, 0x14, 0x03, 0xf4, 0x7f, 0x6b, 0x60, 0x66, 0xd5, 0xee,
+	0x06, 0x98, 0xbf, 0xcd, 0x4a, 0xaf, 0x17, 0xf8, 0xbb, 0xd8, 0x81, 0x2f, 0x82, 0x74, 0xd4, 0x1b,
+	0x44, 0xb2, 0x3e, 0x48, 0xd1, 0x90, 0x72, 0x34, 0xc3, 0x13, 0x67, 0x66, 0xf8, 0x22, 0x48, 0xba,
+	0xb4, 0x2b, 0x72, 0x75, 0xba, 0x3a, 0xf7, 0xe5, 0xa0, 0x08, 0x11, 0x7e, 0xa7, 0x76, 0xbc, 0x11,
+	0x21, 0x4e, 0x22, 0x96, 0x77, 0x65, 0x10, 0x15, 0xcb, 0xe0, 0x83, 0x97, 0xf7, 0x88, 0x14, 0x3e,
+	0x01, 0x00, 0xd9, 0xeb, 0xd9, 0x01, 0xa1, 0x06, 0x66, 0x72, 0xd0, 0xa1, 0x8c, 0x82, 0x54, 0x98,
+	0xfe, 0x5e, 0x02, 0xe4, 0x22, 0x7d, 0xdc, 0xb6, 0x15, 0x9b, 0x32, 0x3f, 0xe8, 0x37, 0x3c, 0x16,
+	0xf4, 0xe1, 0x06, 0xc8, 0xf8, 0x3d, 0x22, 0xa3, 0xa1, 0x2e, 0x21, 0xb7, 0xcb, 0x67, 0x66, 0xdf,
+	0x08, 0xfb, 0x7a, 0xc4, 0xc5, 0x77, 0x6d, 0x14, 0x0b, 0xb9, 0x58, 0x50, 0x5e, 0x01, 0x93, 0x61,
+	0xcf, 0x12, 0xc5, 0x97, 0xfc, 0x2a, 0xc5, 0xa7, 0x98, 0xe0, 0xcb, 0x32, 0xa8, 0x29, 0x11, 0xd4,
+	0xeb, 0xa7, 0x07, 0x95, 0x6f, 0x8a, 0x53, 0xb6, 0xe7, 0xd8, 0x1e, 0x31, 0xbe, 0x4f, 0x7d, 0x4f,
+	0x04, 0x59, 0x47, 0x00, 0x9e, 0x14, 0x0c, 0x9f, 0x04, 0xd3, 0x62, 0x57, 0x37, 0xb6, 0x89, 0xdd,
+	0xdd, 0x56, 0x1b, 0x17, 0x9a, 0x12, 0xb0, 0x15, 0x01, 0x82, 0xf3, 0x20, 0xcd, 0xf6, 0x0c, 0xdb,
+	0xb3, 0xc8, 0x9e, 0x74, 0x0c, 0x4d, 0xb2, 0xbd, 0x26, 0x3f, 0xea, 0x04, 0x8c, 0xaf, 0xfa, 0x16,
+	0x71, 0xe0, 0x32, 0x48, 0xee, 0x90, 0xbe, 0x6c, 0xf5, 0xd5, 0x17, 0xbf, 0x1c, 0x14, 0x5f, 0x38,
+	0xb6, 0xd6, 0xba, 0x84, 0x75, 0xb6, 0x58, 0xfc, 0xe0, 0xd8, 0x1d, 0xba, 0xc4, 0x37, 0x76, 0x5a,
+	0x5e, 0x21, 0x7b, 0x7c, 0xc1, 0xa6, 0x88, 0x0b, 0xe0, 0x1d, 0x4b, 0x5e, 0x3c, 0x13, 0x62, 0x68,
+	0xc8, 0x83, 0xde, 0x06, 0xb3, 0x91, 0x8b, 0x2d, 0xb9, 0x9d, 0xf3, 0x76, 0x4c, 0xf9, 0x98, 0xd9,
+	0x21, 0x7d, 0x43, 0xee, 0x78, 0xd2, 0xf2, 0xf4, 0x0e, 0xe9, 0xd7, 0xf8, 0x19, 0x16, 0xc1, 0x14,
+	0xf3, 0x19, 0x76, 0xc4, 0xcd, 0x80, 0x2a, 0xcb, 0x81, 0x00, 0x09, 0x85, 0xfa, 0x2f, 0x34, 0x30,
+	0x77, 0x9f, 0xd8, 0x68, 0x87, 0xee, 0x83, 0x09, 0xec, 0x2a, 0xa9, 0xe7, 0xac, 0xf8, 0xcb, 0x7c,
+	0xba, 0x7c, 0x0d, 0x7b, 0xbc, 0x52, 0xa8, 0xff, 0x30, 0x01, 0xa6, 0x6b, 0x81, 0xef, 0xb5, 0xcc,
+	0x6d, 0x62, 0x85, 0x0e, 0x81, 0x10, 0xa4, 0x3c, 0xec, 0xca, 0xab, 0x78, 0x06, 0x89, 0xe7, 0x63,
+	0x55, 0x9b, 0xb8, 0x70, 0xd5, 0xbe, 0x3c, 0x5a, 0x90, 0x5f, 0x25, 0x77, 0x60, 0x1e, 0xa4, 0x6d,
+	0x8f, 0x91, 0x60, 0x17, 0xcb, 0x59, 0x92, 0x42, 0xc3, 0x33, 0x7f, 0x09, 0x7c, 0xed, 0x73, 0x6c,
+	0xd7, 0x8e, 0x6a, 0x90, 0x2f, 0xf9, 0xaf, 0xf1, 0x33, 0x37, 0xd4, 0xc1, 0x94, 0x19, 0x41, 0xe8,
+	0x89, 0x41, 0x11, 0x85, 0xf2, 0x78, 0x91, 0x05, 0xbe, 0x87, 0x42, 0x0f, 0x4d, 0x72, 0x52, 0x14,
+	0x7a, 0xba, 0x03, 0x26, 0x15, 0x8c, 0x77, 0xf7, 0x91, 0xcc, 0x4c, 0x22, 0x75, 0x82, 0x39, 0x30,
+	0x49, 0x43, 0x79, 0x2b, 0x4f, 0x88, 0x7e, 0x1a, 0x1d, 0x79, 0x0a, 0x91, 0x20, 0xf0, 0x03, 0x39,
+	0x24, 0x91, 0x3c, 0xf0, 0x24, 0xe6, 0x56, 0x86, 0x94, 0x58, 0xca, 0x83, 0xc9, 0x2e, 0xa6, 0x9b,
+	0x94, 0x58, 0xfa, 0xaf, 0x13, 0x20, 0x5d, 0x53, 0x97, 0x28, 0x38, 0x07, 0x12, 0xc3, 0xb1, 0x3d,
+	0x71, 0x38, 0x28, 0x26, 0x9a, 0x75, 0x94, 0xb0, 0xad, 0x87, 0x8c, 0x78, 0x6c, 0xbd, 0xb8, 0x32,
+	0x0c, 0xad, 0x87, 0x20, 0xc5, 0x6c, 0x97, 0x28, 0x4b, 0xc4, 0x33, 0xf7, 0xa8, 0x87, 0xfb, 0xfc,
+	0x6b, 0x80, 0x88, 0xe2, 0x34, 0x8a, 0x8e, 0xf0, 0x5d, 0x30, 0x19, 0xdd, 0x38, 0x27, 0x1e, 0x55,
+	0x3a, 0x46, 0x1a, 0xf5, 0xf7, 0xc6, 0xc1, 0xe5, 0x65, 0x42, 0x5a, 0x3d, 0xdf, 0xa3, 0x7e, 0x40,
+	0xb7, 0xed, 0xde, 0x43, 0xce, 0x8c, 0x77, 0xc1, 0x64, 0x07, 0x3b, 0xfc, 0x6e, 0x95, 0x4b, 0x3c,
+	0x32, 0x2f, 0x94, 0x46, 0xf8, 0x33, 0x0d, 0x40, 0x17, 0xef, 0x19, 0x5b, 0x44, 0x6c, 0x03, 0x06,
+	0x25, 0x9e, 0x45, 0x82, 0x5c, 0xf2, 0x51, 0x19, 0x72, 0xc5, 0xc5, 0x7b, 0xcb, 0x84, 0xef, 0x13,
+	0x2d, 0xa1, 0x19, 0xfe, 0x44, 0x03, 0x33, 0xa3, 0x06, 0x89, 0x86, 0x9b, 0x4b, 0x3d, 0x2a, 0x7b,
+	0x2e, 0x0f, 0xed, 0xa9, 0x72, 0xc5, 0x27, 0xc6, 0xc0, 0xb8, 0x28, 0xb6, 0x63, 0x63, 0xe0, 0x47,
+	0x1a, 0x90, 0x67, 0x83, 0xf6, 0x88, 0xf7, 0x08, 0x53, 0x11, 0x08, 0xad, 0x2d, 0xae, 0x54, 0xff,
+	0x42, 0x03, 0x8f, 0x1d, 0xcf, 0xc6, 0x4d, 0xde, 0xb5, 0x1e, 0x32, 0x25, 0x5f, 0x00, 0x13, 0x2a,
+	0x11, 0xce, 0x2b, 0x69, 0x45, 0x07, 0xdf, 0x01, 0xe3, 0xd2, 0xfb, 0x47, 0x96, 0x39, 0x52, 0x9f,
+	0xfe, 0x67, 0x0d, 0xc0, 0xa8, 0x55, 0xd7, 0x49, 0x8f, 0x5b, 0xe3, 0x99, 0x7d, 0xee, 0x01, 0xff,
+	0xfe, 0x43, 0x82, 0x73, 0xbd, 0x56, 0x74, 0x43, 0x0e, 0x72, 0xbe, 0xcf, 0x92, 0x0e, 0x3e, 0x05,
+	0x2e, 0x45, 0x57, 0x4a, 0x39, 0x69, 0x65, 0x2f, 0x9b, 0x56, 0xc0, 0xe1, 0xb4, 0x15, 0x8d, 0xfe,
+	0x58, 0xfe, 0x00, 0x0e, 0x92, 0xe9, 0x23, 0xef, 0x4a, 0x68, 0xea, 0xed, 0x90, 0x04, 0x6a, 0x62,
+	0xeb, 0x7f, 0xd5, 0x40, 0x36, 0x1e, 0x3f, 0x0c, 0xf3, 0x0b, 0xeb, 0xa9, 0xe3, 0xae, 0x04, 0xa6,
+	0x2c, 0x42, 0xcd, 0xc0, 0xee, 0xb1, 0xe8, 0x1b, 0x68, 0x06, 0x8d, 0x82, 0xf8, 0x80, 0xda, 0xf6,
+	0x5d, 0xd2, 0xc3, 0x5d, 0xa2, 0xfa, 0xfe, 0xf0, 0xcc, 0x4d, 0xc3, 0xa1, 0x65, 0x33, 0xc3, 0xb1,
+	0xbd, 0x1d, 0xb5, 0x5f, 0x22, 0x20, 0x40, 0xaf, 0x71, 0x08, 0x7c, 0x1e, 0x00, 0x6a, 0x6e, 0x13,
+	0x17, 0x1b, 0x61, 0x60, 0xe7, 0xc6, 0xe3, 0xfb, 0x4c, 0x4b, 0x40, 0x37, 0x51, 0x13, 0x65, 0x24,
+	0xc1, 0x66, 0x60, 0x73, 0x71, 0x8a, 0x5a, 0xdc, 0x6e, 0xc5, 0xf5, 0x07, 0x29, 0x01, 0xfc, 0x7e,
+	0x7b, 0xe3, 0x0b, 0x0d, 0x80, 0xf8, 0x6b, 0x2c, 0x7c, 0x09, 0x5c, 0xab, 0xd4, 0x6a, 0x8d, 0x56,
+	0xcb, 0x68, 0xdf, 0xdb, 0x68, 0x18, 0x9b, 0x6b, 0xad, 0x8d, 0x46, 0xad, 0xb9, 0xdc, 0x6c, 0xd4,
+	0xb3, 0x63, 0xf9, 0xf9, 0xfd, 0x83, 0xd2, 0xd5, 0x98, 0x78, 0xd3, 0xa3, 0x3d, 0x62, 0xda, 0x5b,
+	0x36, 0xb1, 0xe0, 0xf3, 0x00, 0x8e, 0xf2, 0xad, 0xad, 0x57, 0xd7, 0xeb, 0xf7, 0xb2, 0x5a, 0x7e,
+	0x76, 0xff, 0xa0, 0x94, 0x8d, 0x59, 0xd6, 0xfc, 0x8e, 0x6f, 0xf5, 0xe1, 0x6d, 0x70, 0x75, 0x94,
+	0xba, 0xf1, 0x7a, 0x03, 0xdd, 0x13, 0x0c, 0xc9, 0xfc, 0xb5, 0xfd, 0x83, 0xd2, 0x63, 0x31, 0x43,
+	0x63, 0x97, 0x04, 0x7d, 0xc1, 0xf3, 0x0a, 0x58, 0x18, 0xe5, 0xa9, 0xac, 0xdd, 0x33, 0xd6, 0x97,
+	0x8d, 0x4a, 0xbd, 0x8e, 0x1a, 0xad, 0x56, 0xa3, 0x95, 0x4d, 0xe5, 0x17, 0xf6, 0x0f, 0x4a, 0xb9,
+	0x98, 0xb5, 0xe2, 0xf5, 0xd7, 0xb7, 0x2a, 0xd1, 0xb7, 0xf3, 0x7c, 0xfa, 0xc7, 0xbf, 0x2d, 0x8c,
+	0x7d, 0xf0, 0xbb, 0xc2, 0x98, 0xce, 0xbf, 0x9f, 0x27, 0x6e, 0xec, 0x02, 0x10, 0x5f, 0x95, 0xb9,
+	0xfd, 0xb5, 0xf5, 0x7a, 0xc3, 0x68, 0xb5, 0x2b, 0xed, 0xcd, 0x96, 0x51, 0xa9, 0xb5, 0x9b, 0xaf,
+	0x37, 0xb2, 0x63, 0xd2, 0xfe, 0x98, 0xae, 0x62, 0x32, 0x7b, 0x97, 0x17, 0xf0, 0xdc, 0x28, 0x75,
+	0xbd, 0xb1, 0x81, 0x1a, 0xb5, 0x4a, 0xbb, 0x51, 0xcf, 0x6a, 0xf9, 0xdc, 0xfe, 0x41, 0x69, 0x36,
+	0xe6, 0xa8, 0x93, 0x5e, 0x40, 0x4c, 0xbe, 0x0d, 0xe7, 0x53, 0xdc, 0x82, 0x1b, 0xbf, 0x4f, 0x82,
+	0xd2, 0x79, 0x8b, 0x3a, 0x24, 0xe0, 0x85, 0xda, 0xfa, 0x5a, 0x1b, 0x55, 0x6a, 0x6d, 0x43, 0x68,
+	0x5a, 0x69, 0xb6, 0xda, 0xeb, 0xe8, 0x9e, 0xb1, 0xbe, 0xd1, 0x40, 0x95, 0x76, 0x73, 0x7d, 0xed,
+	0xb4, 0xf7, 0xb3, 0xb4, 0x7f, 0x50, 0x7a, 0xee, 0x3c, 0xd9, 0xa3, 0x6f, 0xed, 0x0d, 0xf0, 0xec,
+	0x85, 0xd4, 0x34, 0xd7, 0x9a, 0xed, 0xac, 0x96, 0x5f, 0xdc, 0x3f, 0x28, 0x3d, 0x7d, 0x9e, 0xfc,
+	0xa6, 0x67, 0x33, 0xf8, 0x16, 0x78, 0xfe, 0x42, 0x82, 0x57, 0x9b, 0x77, 0x51, 0xa5, 0xdd, 0xc8,
+	0x26, 0xf2, 0xcf, 0xed, 0x1f, 0x94, 0xfe, 0xef, 0x3c, 0xd9, 0xf2, 0x46, 0x48, 0x2e, 0x2c, 0xfe,
+	0x6e, 0x63, 0xad, 0xd1, 0x6a, 0xb6, 0xb2, 0xc9, 0x8b, 0x89, 0xbf, 0x4b, 0x3c, 0x42, 0x6d, 0x2a,
+	0x5f, 0x54, 0x75, 0xe5, 0x93, 0x7f, 0x16, 0xc6, 0x3e, 0x38, 0x2c, 0x68, 0x9f, 0x1c, 0x16, 0xb4,
+	0x4f, 0x0f, 0x0b, 0xda, 0x3f, 0x0e, 0x0b, 0xda, 0xcf, 0x3f, 0x2b, 0x8c, 0x7d, 0xfa, 0x59, 0x61,
+	0xec, 0x6f, 0x9f, 0x15, 0xc6, 0xbe, 0x73, 0x7d, 0xa4, 0x5b, 0xd6, 0x7c, 0xea, 0xbe, 0x11, 0xfd,
+	0x17, 0xcd, 0x5a, 0xda, 0x13, 0x7f, 0x65, 0xc7, 0xec, 0x4c, 0x88, 0x2f, 0x07, 0xff, 0xff, 0xbf,
+	0x01, 0x00, 0x2d, 0x05, 0xb0, 0xf5, 0x6b, 0x1b, 0x00, 0x00,
+}
+
+func (this *AccessTypeParam) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*ContractCodeHistoryEntry)
+	that1, ok := that.(*AccessTypeParam)
 	if !ok {
-		that2, ok := that.(ContractCodeHistoryEntry)
+		that2, ok := that.(AccessTypeParam)
 		if ok {
 			that1 = &that2
 		} else {
@@ -748,29 +1387,20 @@ func (this *ContractCodeHistoryEntry) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Operation != that1.Operation {
-		return false
-	}
-	if this.CodeID != that1.CodeID {
-		return false
-	}
-	if !this.Updated.Equal(that1.Updated) {
-		return false
-	}
-	if !bytes.Equal(this.Msg, that1.Msg) {
+	if this.Value != that1.Value {
 		return false
 	}
 	return true
 }
 
-func (this *AbsoluteTxPosition) Equal(that interface{}) bool {
+func (this *AccessConfig) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*AbsoluteTxPosition)
+	that1, ok := that.(*AccessConfig)
 	if !ok {
-		that2, ok := that.(AbsoluteTxPosition)
+		that2, ok := that.(AccessConfig)
 		if ok {
 			that1 = &that2
 		} else {
@@ -782,23 +1412,28 @@ func (this *AbsoluteTxPosition) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.BlockHeight != that1.BlockHeight {
+	if this.Permission != that1.Permission {
 		return false
 	}
-	if this.TxIndex != that1.TxIndex {
+	if len(this.Addresses) != len(that1.Addresses) {
 		return false
 	}
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
 	return true
 }
 
-func (this *Model) Equal(that interface{}) bool {
+func (this *Params) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*Model)
+	that1, ok := that.(*Params)
 	if !ok {
-		that2, ok := that.(Model)
+		that2, ok := that.(Params)
 		if ok {
 			that1 = &that2
 		} else {
@@ -810,542 +1445,4374 @@ func (this *Model) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !bytes.Equal(this.Key, that1.Key) {
+	if !this.CodeUploadAccess.Equal(&that1.CodeUploadAccess) {
 		return false
 	}
-	if !bytes.Equal(this.Value, that1.Value) {
+	if this.InstantiateDefaultPermission != that1.InstantiateDefaultPermission {
 		return false
 	}
-	return true
-}
-
-func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.BlockedChecksums) != len(that1.BlockedChecksums) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AccessTypeParam) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AccessTypeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Value != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Value))
-		i--
-		dAtA[i] = 0x8
+	for i := range this.BlockedChecksums {
+		if !bytes.Equal(this.BlockedChecksums[i], that1.BlockedChecksums[i]) {
+			return false
+		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AccessConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.StorageDepositPerByte) != len(that1.StorageDepositPerByte) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AccessConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AccessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+	for i := range this.StorageDepositPerByte {
+		if !this.StorageDepositPerByte[i].Equal(&that1.StorageDepositPerByte[i]) {
+			return false
 		}
 	}
-	if m.Permission != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Permission))
-		i--
-		dAtA[i] = 0x8
+	if len(this.CallbackDeposit) != len(that1.CallbackDeposit) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	for i := range this.CallbackDeposit {
+		if !this.CallbackDeposit[i].Equal(&that1.CallbackDeposit[i]) {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InstantiateDefaultPermission != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.InstantiateDefaultPermission))
-		i--
-		dAtA[i] = 0x10
+	if this.UniqueContractLabels != that1.UniqueContractLabels {
+		return false
 	}
-	{
-		size, err := m.CodeUploadAccess.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+	if !this.GasCosts.Equal(that1.GasCosts) {
+		return false
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return true
 }
 
-func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *GasCosts) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CodeInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.InstantiateConfig.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	that1, ok := that.(*GasCosts)
+	if !ok {
+		that2, ok := that.(GasCosts)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.CodeHash) > 0 {
-		i -= len(m.CodeHash)
-		copy(dAtA[i:], m.CodeHash)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.CodeHash)))
-		i--
-		dAtA[i] = 0xa
+	if this.InstanceCost != that1.InstanceCost {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ContractInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.InstanceCostDiscount != that1.InstanceCostDiscount {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ContractInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Extension != nil {
-		{
-			size, err := m.Extension.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+	if this.CompileCost != that1.CompileCost {
+		return false
 	}
-	if len(m.IBCPortID) > 0 {
-		i -= len(m.IBCPortID)
-		copy(dAtA[i:], m.IBCPortID)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.IBCPortID)))
-		i--
-		dAtA[i] = 0x32
+	if this.UncompressCostNumerator != that1.UncompressCostNumerator {
+		return false
 	}
-	if m.Created != nil {
-		{
-			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+	if this.UncompressCostDenominator != that1.UncompressCostDenominator {
+		return false
 	}
-	if len(m.Label) > 0 {
-		i -= len(m.Label)
-		copy(dAtA[i:], m.Label)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Label)))
-		i--
-		dAtA[i] = 0x22
+	if this.GasMultiplier != that1.GasMultiplier {
+		return false
 	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x1a
+	if this.EventPerAttributeCost != that1.EventPerAttributeCost {
+		return false
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
+	if this.EventAttributeDataCost != that1.EventAttributeDataCost {
+		return false
 	}
-	if m.CodeID != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
-		i--
-		dAtA[i] = 0x8
+	if this.EventAttributeDataFreeTier != that1.EventAttributeDataFreeTier {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ContractCodeHistoryEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.ContractMessageDataCost != that1.ContractMessageDataCost {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.CustomEventCost != that1.CustomEventCost {
+		return false
+	}
+	return true
 }
 
-func (m *ContractCodeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+func (this *CodeInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ContractCodeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Updated != nil {
-		{
-			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+	that1, ok := that.(*CodeInfo)
+	if !ok {
+		that2, ok := that.(CodeInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
-	if m.CodeID != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
-		i--
-		dAtA[i] = 0x10
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Operation != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Operation))
-		i--
-		dAtA[i] = 0x8
+	if !bytes.Equal(this.CodeHash, that1.CodeHash) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AbsoluteTxPosition) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.Creator != that1.Creator {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AbsoluteTxPosition) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AbsoluteTxPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TxIndex != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.TxIndex))
-		i--
-		dAtA[i] = 0x10
+	if !this.InstantiateConfig.Equal(&that1.InstantiateConfig) {
+		return false
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x8
+	if this.Status != that1.Status {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
 
-func (m *Model) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *ContractInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Model) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+	that1, ok := that.(*ContractInfo)
+	if !ok {
+		that2, ok := that.(ContractInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if this.CodeID != that1.CodeID {
+		return false
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-
-func (m *AccessTypeParam) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Creator != that1.Creator {
+		return false
 	}
-	var l int
-	_ = l
-	if m.Value != 0 {
-		n += 1 + sovTypes(uint64(m.Value))
+	if this.Admin != that1.Admin {
+		return false
 	}
-	return n
-}
-
-func (m *AccessConfig) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Label != that1.Label {
+		return false
 	}
-	var l int
-	_ = l
-	if m.Permission != 0 {
-		n += 1 + sovTypes(uint64(m.Permission))
+	if !this.Created.Equal(that1.Created) {
+		return false
 	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	if this.IBCPortID != that1.IBCPortID {
+		return false
 	}
-	return n
-}
-
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.Extension.Equal(that1.Extension) {
+		return false
 	}
-	var l int
-	_ = l
-	l = m.CodeUploadAccess.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if m.InstantiateDefaultPermission != 0 {
-		n += 1 + sovTypes(uint64(m.InstantiateDefaultPermission))
+	if this.Frozen != that1.Frozen {
+		return false
 	}
-	return n
-}
-
-func (m *CodeInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.ExecutePermission.Equal(that1.ExecutePermission) {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.CodeHash)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if !this.AdminSet.Equal(that1.AdminSet) {
+		return false
 	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.FrozenByGov != that1.FrozenByGov {
+		return false
 	}
-	l = m.InstantiateConfig.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
+	return true
 }
 
-func (m *ContractInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CodeID != 0 {
-		n += 1 + sovTypes(uint64(m.CodeID))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+func (this *AdminSet) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+
+	that1, ok := that.(*AdminSet)
+	if !ok {
+		that2, ok := that.(AdminSet)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Label)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Created != nil {
-		l = m.Created.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if len(this.Members) != len(that1.Members) {
+		return false
 	}
-	l = len(m.IBCPortID)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	for i := range this.Members {
+		if this.Members[i] != that1.Members[i] {
+			return false
+		}
 	}
-	if m.Extension != nil {
-		l = m.Extension.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if this.Threshold != that1.Threshold {
+		return false
 	}
-	return n
+	return true
 }
 
-func (m *ContractCodeHistoryEntry) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *MigrationApproval) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if m.Operation != 0 {
-		n += 1 + sovTypes(uint64(m.Operation))
+
+	that1, ok := that.(*MigrationApproval)
+	if !ok {
+		that2, ok := that.(MigrationApproval)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.CodeID != 0 {
-		n += 1 + sovTypes(uint64(m.CodeID))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Updated != nil {
-		l = m.Updated.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if this.Contract != that1.Contract {
+		return false
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.CodeID != that1.CodeID {
+		return false
 	}
-	return n
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
+	}
+	if len(this.Approvals) != len(that1.Approvals) {
+		return false
+	}
+	for i := range this.Approvals {
+		if this.Approvals[i] != that1.Approvals[i] {
+			return false
+		}
+	}
+	if this.ExpiresAt != that1.ExpiresAt {
+		return false
+	}
+	return true
 }
 
-func (m *AbsoluteTxPosition) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ContractCodeHistoryEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if m.BlockHeight != 0 {
-		n += 1 + sovTypes(uint64(m.BlockHeight))
+
+	that1, ok := that.(*ContractCodeHistoryEntry)
+	if !ok {
+		that2, ok := that.(ContractCodeHistoryEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.TxIndex != 0 {
-		n += 1 + sovTypes(uint64(m.TxIndex))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Operation != that1.Operation {
+		return false
+	}
+	if this.CodeID != that1.CodeID {
+		return false
+	}
+	if !this.Updated.Equal(that1.Updated) {
+		return false
+	}
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
+	}
+	return true
+}
+
+func (this *AbsoluteTxPosition) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AbsoluteTxPosition)
+	if !ok {
+		that2, ok := that.(AbsoluteTxPosition)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return false
+	}
+	if this.TxIndex != that1.TxIndex {
+		return false
+	}
+	return true
+}
+
+func (this *Model) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Model)
+	if !ok {
+		that2, ok := that.(Model)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
+	}
+	if !bytes.Equal(this.Value, that1.Value) {
+		return false
+	}
+	return true
+}
+
+func (this *ContractStorageStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractStorageStats)
+	if !ok {
+		that2, ok := that.(ContractStorageStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.KeyCount != that1.KeyCount {
+		return false
+	}
+	if this.TotalBytes != that1.TotalBytes {
+		return false
+	}
+	return true
+}
+
+func (this *ContractStorageDeposit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractStorageDeposit)
+	if !ok {
+		that2, ok := that.(ContractStorageDeposit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *CronSchedule) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CronSchedule)
+	if !ok {
+		that2, ok := that.(CronSchedule)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if !bytes.Equal(this.Msg, that1.Msg) {
+		return false
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if !this.LastRun.Equal(that1.LastRun) {
+		return false
+	}
+	return true
+}
+
+func (this *CronRun) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CronRun)
+	if !ok {
+		that2, ok := that.(CronRun)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if this.GasUsed != that1.GasUsed {
+		return false
+	}
+	return true
+}
+
+func (this *Callback) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Callback)
+	if !ok {
+		that2, ok := that.(Callback)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ID != that1.ID {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Time != that1.Time {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	if len(this.Deposit) != len(that1.Deposit) {
+		return false
+	}
+	for i := range this.Deposit {
+		if !this.Deposit[i].Equal(&that1.Deposit[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *FeeSponsorship) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeSponsorship)
+	if !ok {
+		that2, ok := that.(FeeSponsorship)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if len(this.Balance) != len(that1.Balance) {
+		return false
+	}
+	for i := range this.Balance {
+		if !this.Balance[i].Equal(&that1.Balance[i]) {
+			return false
+		}
+	}
+	if len(this.MaxFeePerSender) != len(that1.MaxFeePerSender) {
+		return false
+	}
+	for i := range this.MaxFeePerSender {
+		if !this.MaxFeePerSender[i].Equal(&that1.MaxFeePerSender[i]) {
+			return false
+		}
+	}
+	if len(this.MaxFeePerBlock) != len(that1.MaxFeePerBlock) {
+		return false
+	}
+	for i := range this.MaxFeePerBlock {
+		if !this.MaxFeePerBlock[i].Equal(&that1.MaxFeePerBlock[i]) {
+			return false
+		}
+	}
+	if this.BlockHeight != that1.BlockHeight {
+		return false
+	}
+	if len(this.BlockSpent) != len(that1.BlockSpent) {
+		return false
+	}
+	for i := range this.BlockSpent {
+		if !this.BlockSpent[i].Equal(&that1.BlockSpent[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *FeeSponsorshipUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeSponsorshipUsage)
+	if !ok {
+		that2, ok := that.(FeeSponsorshipUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Contract != that1.Contract {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if len(this.Spent) != len(that1.Spent) {
+		return false
+	}
+	for i := range this.Spent {
+		if !this.Spent[i].Equal(&that1.Spent[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (this *ContractDependency) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractDependency)
+	if !ok {
+		that2, ok := that.(ContractDependency)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Caller != that1.Caller {
+		return false
+	}
+	if this.Callee != that1.Callee {
+		return false
+	}
+	if this.MessageCount != that1.MessageCount {
+		return false
+	}
+	if this.LastHeight != that1.LastHeight {
+		return false
+	}
+	return true
+}
+
+func (this *ContractMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractMetadata)
+	if !ok {
+		that2, ok := that.(ContractMetadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Homepage != that1.Homepage {
+		return false
+	}
+	if len(this.AuditLinks) != len(that1.AuditLinks) {
+		return false
+	}
+	for i := range this.AuditLinks {
+		if this.AuditLinks[i] != that1.AuditLinks[i] {
+			return false
+		}
+	}
+	if this.SchemaURI != that1.SchemaURI {
+		return false
+	}
+	if this.SchemaHash != that1.SchemaHash {
+		return false
+	}
+	return true
+}
+
+func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessTypeParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessTypeParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Value))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Permission != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Permission))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasCosts != nil {
+		{
+			size, err := m.GasCosts.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.UniqueContractLabels {
+		i--
+		if m.UniqueContractLabels {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CallbackDeposit) > 0 {
+		for iNdEx := len(m.CallbackDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CallbackDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.StorageDepositPerByte) > 0 {
+		for iNdEx := len(m.StorageDepositPerByte) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageDepositPerByte[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.BlockedChecksums) > 0 {
+		for iNdEx := len(m.BlockedChecksums) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedChecksums[iNdEx])
+			copy(dAtA[i:], m.BlockedChecksums[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.BlockedChecksums[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.InstantiateDefaultPermission != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InstantiateDefaultPermission))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.CodeUploadAccess.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GasCosts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasCosts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasCosts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CustomEventCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CustomEventCost))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ContractMessageDataCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ContractMessageDataCost))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.EventAttributeDataFreeTier != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventAttributeDataFreeTier))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.EventAttributeDataCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventAttributeDataCost))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EventPerAttributeCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventPerAttributeCost))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.GasMultiplier != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasMultiplier))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UncompressCostDenominator != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UncompressCostDenominator))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UncompressCostNumerator != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UncompressCostNumerator))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CompileCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CompileCost))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InstanceCostDiscount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InstanceCostDiscount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.InstanceCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InstanceCost))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.InstantiateConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FrozenByGov {
+		i--
+		if m.FrozenByGov {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.AdminSet != nil {
+		{
+			size, err := m.AdminSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ExecutePermission != nil {
+		{
+			size, err := m.ExecutePermission.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Extension != nil {
+		{
+			size, err := m.Extension.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.IBCPortID) > 0 {
+		i -= len(m.IBCPortID)
+		copy(dAtA[i:], m.IBCPortID)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.IBCPortID)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Created != nil {
+		{
+			size, err := m.Created.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AdminSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MigrationApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrationApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MigrationApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCodeHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCodeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCodeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Updated != nil {
+		{
+			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CodeID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CodeID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Operation != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Operation))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AbsoluteTxPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AbsoluteTxPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AbsoluteTxPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxIndex != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Model) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Model) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Model) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractStorageStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractStorageStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractStorageStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.KeyCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.KeyCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractStorageDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractStorageDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractStorageDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CronSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastRun != nil {
+		{
+			size, err := m.LastRun.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CronRun) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronRun) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronRun) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Callback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Callback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Callback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Time != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockSpent) > 0 {
+		for iNdEx := len(m.BlockSpent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockSpent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MaxFeePerBlock) > 0 {
+		for iNdEx := len(m.MaxFeePerBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxFeePerBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MaxFeePerSender) > 0 {
+		for iNdEx := len(m.MaxFeePerSender) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxFeePerSender[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSponsorshipUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSponsorshipUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSponsorshipUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractDependency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractDependency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractDependency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MessageCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MessageCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Callee) > 0 {
+		i -= len(m.Callee)
+		copy(dAtA[i:], m.Callee)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Callee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Caller) > 0 {
+		i -= len(m.Caller)
+		copy(dAtA[i:], m.Caller)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Caller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SchemaHash) > 0 {
+		i -= len(m.SchemaHash)
+		copy(dAtA[i:], m.SchemaHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SchemaHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SchemaURI) > 0 {
+		i -= len(m.SchemaURI)
+		copy(dAtA[i:], m.SchemaURI)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.SchemaURI)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AuditLinks) > 0 {
+		for iNdEx := len(m.AuditLinks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AuditLinks[iNdEx])
+			copy(dAtA[i:], m.AuditLinks[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AuditLinks[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Homepage) > 0 {
+		i -= len(m.Homepage)
+		copy(dAtA[i:], m.Homepage)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Homepage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+func (m *AccessTypeParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != 0 {
+		n += 1 + sovTypes(uint64(m.Value))
+	}
+	return n
+}
+
+func (m *AccessConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Permission != 0 {
+		n += 1 + sovTypes(uint64(m.Permission))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CodeUploadAccess.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.InstantiateDefaultPermission != 0 {
+		n += 1 + sovTypes(uint64(m.InstantiateDefaultPermission))
+	}
+	if len(m.BlockedChecksums) > 0 {
+		for _, b := range m.BlockedChecksums {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.StorageDepositPerByte) > 0 {
+		for _, e := range m.StorageDepositPerByte {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.CallbackDeposit) > 0 {
+		for _, e := range m.CallbackDeposit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.UniqueContractLabels {
+		n += 2
+	}
+	if m.GasCosts != nil {
+		l = m.GasCosts.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *GasCosts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InstanceCost != 0 {
+		n += 1 + sovTypes(uint64(m.InstanceCost))
+	}
+	if m.InstanceCostDiscount != 0 {
+		n += 1 + sovTypes(uint64(m.InstanceCostDiscount))
+	}
+	if m.CompileCost != 0 {
+		n += 1 + sovTypes(uint64(m.CompileCost))
+	}
+	if m.UncompressCostNumerator != 0 {
+		n += 1 + sovTypes(uint64(m.UncompressCostNumerator))
+	}
+	if m.UncompressCostDenominator != 0 {
+		n += 1 + sovTypes(uint64(m.UncompressCostDenominator))
+	}
+	if m.GasMultiplier != 0 {
+		n += 1 + sovTypes(uint64(m.GasMultiplier))
+	}
+	if m.EventPerAttributeCost != 0 {
+		n += 1 + sovTypes(uint64(m.EventPerAttributeCost))
+	}
+	if m.EventAttributeDataCost != 0 {
+		n += 1 + sovTypes(uint64(m.EventAttributeDataCost))
+	}
+	if m.EventAttributeDataFreeTier != 0 {
+		n += 1 + sovTypes(uint64(m.EventAttributeDataFreeTier))
+	}
+	if m.ContractMessageDataCost != 0 {
+		n += 1 + sovTypes(uint64(m.ContractMessageDataCost))
+	}
+	if m.CustomEventCost != 0 {
+		n += 1 + sovTypes(uint64(m.CustomEventCost))
+	}
+	return n
+}
+
+func (m *CodeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.InstantiateConfig.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *ContractInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.IBCPortID)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Extension != nil {
+		l = m.Extension.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Frozen {
+		n += 2
+	}
+	if m.ExecutePermission != nil {
+		l = m.ExecutePermission.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.AdminSet != nil {
+		l = m.AdminSet.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.FrozenByGov {
+		n += 2
+	}
+	return n
+}
+
+func (m *AdminSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTypes(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *MigrationApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Approvals) > 0 {
+		for _, s := range m.Approvals {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *ContractCodeHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Operation != 0 {
+		n += 1 + sovTypes(uint64(m.Operation))
+	}
+	if m.CodeID != 0 {
+		n += 1 + sovTypes(uint64(m.CodeID))
+	}
+	if m.Updated != nil {
+		l = m.Updated.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *AbsoluteTxPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.BlockHeight))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovTypes(uint64(m.TxIndex))
+	}
+	return n
+}
+
+func (m *Model) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractStorageStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KeyCount != 0 {
+		n += 1 + sovTypes(uint64(m.KeyCount))
+	}
+	if m.TotalBytes != 0 {
+		n += 1 + sovTypes(uint64(m.TotalBytes))
+	}
+	return n
+}
+
+func (m *ContractStorageDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CronSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTypes(uint64(m.Interval))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.GasLimit))
+	}
+	if m.LastRun != nil {
+		l = m.LastRun.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *CronRun) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovTypes(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func (m *Callback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovTypes(uint64(m.ID))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Time != 0 {
+		n += 1 + sovTypes(uint64(m.Time))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.MaxFeePerSender) > 0 {
+		for _, e := range m.MaxFeePerSender {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.MaxFeePerBlock) > 0 {
+		for _, e := range m.MaxFeePerBlock {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.BlockHeight))
+	}
+	if len(m.BlockSpent) > 0 {
+		for _, e := range m.BlockSpent {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeSponsorshipUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractDependency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Caller)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Callee)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MessageCount != 0 {
+		n += 1 + sovTypes(uint64(m.MessageCount))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovTypes(uint64(m.LastHeight))
+	}
+	return n
+}
+
+func (m *ContractMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Homepage)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.AuditLinks) > 0 {
+		for _, s := range m.AuditLinks {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.SchemaURI)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.SchemaHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+
+func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessTypeParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessTypeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *AccessConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			}
+			m.Permission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permission |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeUploadAccess", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CodeUploadAccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateDefaultPermission", wireType)
+			}
+			m.InstantiateDefaultPermission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstantiateDefaultPermission |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedChecksums", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedChecksums = append(m.BlockedChecksums, make([]byte, postIndex-iNdEx))
+			copy(m.BlockedChecksums[len(m.BlockedChecksums)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageDepositPerByte", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageDepositPerByte = append(m.StorageDepositPerByte, types.Coin{})
+			if err := m.StorageDepositPerByte[len(m.StorageDepositPerByte)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackDeposit = append(m.CallbackDeposit, types.Coin{})
+			if err := m.CallbackDeposit[len(m.CallbackDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueContractLabels", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UniqueContractLabels = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCosts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasCosts == nil {
+				m.GasCosts = &GasCosts{}
+			}
+			if err := m.GasCosts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *GasCosts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasCosts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasCosts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceCost", wireType)
+			}
+			m.InstanceCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstanceCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceCostDiscount", wireType)
+			}
+			m.InstanceCostDiscount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InstanceCostDiscount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompileCost", wireType)
+			}
+			m.CompileCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompileCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressCostNumerator", wireType)
+			}
+			m.UncompressCostNumerator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressCostNumerator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncompressCostDenominator", wireType)
+			}
+			m.UncompressCostDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UncompressCostDenominator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasMultiplier", wireType)
+			}
+			m.GasMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventPerAttributeCost", wireType)
+			}
+			m.EventPerAttributeCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventPerAttributeCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventAttributeDataCost", wireType)
+			}
+			m.EventAttributeDataCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventAttributeDataCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventAttributeDataFreeTier", wireType)
+			}
+			m.EventAttributeDataFreeTier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventAttributeDataFreeTier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractMessageDataCost", wireType)
+			}
+			m.ContractMessageDataCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ContractMessageDataCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomEventCost", wireType)
+			}
+			m.CustomEventCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CustomEventCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *CodeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InstantiateConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= CodeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &AbsoluteTxPosition{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCPortID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IBCPortID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Extension == nil {
+				m.Extension = &types1.Any{}
+			}
+			if err := m.Extension.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutePermission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExecutePermission == nil {
+				m.ExecutePermission = &AccessConfig{}
+			}
+			if err := m.ExecutePermission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AdminSet == nil {
+				m.AdminSet = &AdminSet{}
+			}
+			if err := m.AdminSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenByGov", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FrozenByGov = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *AdminSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *MigrationApproval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MigrationApproval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MigrationApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractCodeHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractCodeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			m.Operation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operation |= ContractCodeHistoryOperationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			}
+			m.CodeID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Updated == nil {
+				m.Updated = &AbsoluteTxPosition{}
+			}
+			if err := m.Updated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AbsoluteTxPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AbsoluteTxPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *Model) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Model: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Model: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *ContractStorageStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractStorageStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractStorageStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyCount", wireType)
+			}
+			m.KeyCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBytes", wireType)
+			}
+			m.TotalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *Model) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
 
-func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
+func (m *ContractStorageDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1368,17 +5835,17 @@ func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessTypeParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractStorageDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessTypeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractStorageDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Value = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1388,11 +5855,26 @@ func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= AccessType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1415,7 +5897,7 @@ func (m *AccessTypeParam) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *AccessConfig) Unmarshal(dAtA []byte) error {
+func (m *CronSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1438,17 +5920,17 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permission", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Permission = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1458,14 +5940,27 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Permission |= AccessType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1493,7 +5988,115 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRun", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastRun == nil {
+				m.LastRun = &CronRun{}
+			}
+			if err := m.LastRun.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1517,7 +6120,7 @@ func (m *AccessConfig) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *CronRun) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1540,17 +6143,56 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronRun: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronRun: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeUploadAccess", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1560,30 +6202,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CodeUploadAccess.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateDefaultPermission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			m.InstantiateDefaultPermission = 0
+			m.GasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1593,7 +6234,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InstantiateDefaultPermission |= AccessType(b&0x7F) << shift
+				m.GasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1620,7 +6261,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *CodeInfo) Unmarshal(dAtA []byte) error {
+func (m *Callback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1643,17 +6284,36 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CodeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Callback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Callback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1663,31 +6323,67 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.CodeHash == nil {
-				m.CodeHash = []byte{}
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1697,27 +6393,29 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1744,7 +6442,8 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InstantiateConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1770,7 +6469,7 @@ func (m *CodeInfo) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *ContractInfo) Unmarshal(dAtA []byte) error {
+func (m *FeeSponsorship) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1793,17 +6492,17 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeSponsorship: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			m.CodeID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1813,16 +6512,29 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1832,29 +6544,31 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeePerSender", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1864,29 +6578,31 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.MaxFeePerSender = append(m.MaxFeePerSender, types.Coin{})
+			if err := m.MaxFeePerSender[len(m.MaxFeePerSender)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeePerBlock", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1896,27 +6612,48 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.MaxFeePerBlock = append(m.MaxFeePerBlock, types.Coin{})
+			if err := m.MaxFeePerBlock[len(m.MaxFeePerBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSpent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1943,16 +6680,97 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Created == nil {
-				m.Created = &AbsoluteTxPosition{}
+			m.BlockSpent = append(m.BlockSpent, types.Coin{})
+			if err := m.BlockSpent[len(m.BlockSpent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *FeeSponsorshipUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSponsorshipUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSponsorshipUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IBCPortID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1980,11 +6798,11 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IBCPortID = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2011,10 +6829,8 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Extension == nil {
-				m.Extension = &types.Any{}
-			}
-			if err := m.Extension.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2040,7 +6856,7 @@ func (m *ContractInfo) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
+func (m *ContractDependency) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2063,17 +6879,17 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractCodeHistoryEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractDependency: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractCodeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractDependency: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
 			}
-			m.Operation = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2083,16 +6899,29 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Operation |= ContractCodeHistoryOperationType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Caller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeID", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callee", wireType)
 			}
-			m.CodeID = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2102,16 +6931,29 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CodeID |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageCount", wireType)
 			}
-			var msglen int
+			m.MessageCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2121,33 +6963,16 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MessageCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Updated == nil {
-				m.Updated = &AbsoluteTxPosition{}
-			}
-			if err := m.Updated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
 			}
-			var byteLen int
+			m.LastHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2157,26 +6982,11 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.LastHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
-			if m.Msg == nil {
-				m.Msg = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2199,7 +7009,7 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 	return nil
 }
 
-func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
+func (m *ContractMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2222,17 +7032,17 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AbsoluteTxPosition: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AbsoluteTxPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2242,16 +7052,29 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			m.TxIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2261,67 +7084,61 @@ func (m *AbsoluteTxPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxIndex |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-
-func (m *Model) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Homepage", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Model: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Model: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Homepage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditLinks", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2331,31 +7148,29 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
-			if m.Key == nil {
-				m.Key = []byte{}
-			}
+			m.AuditLinks = append(m.AuditLinks, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaURI", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2365,25 +7180,55 @@ func (m *Model) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			m.SchemaURI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.SchemaHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
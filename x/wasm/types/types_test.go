@@ -52,6 +52,34 @@ func TestContractInfoValidateBasic(t *testing.T) {
 			srcMutator: func(c *ContractInfo) { c.Label = strings.Repeat("a", MaxLabelSize+1) },
 			expError:   true,
 		},
+		"execute permission set": {
+			srcMutator: func(c *ContractInfo) { c.ExecutePermission = &AllowNobody },
+		},
+		"invalid execute permission": {
+			srcMutator: func(c *ContractInfo) { c.ExecutePermission = &AccessConfig{} },
+			expError:   true,
+		},
+		"admin set": {
+			srcMutator: func(c *ContractInfo) { c.AdminSet = &AdminSet{Members: []string{c.Creator}, Threshold: 1} },
+		},
+		"admin and admin set": {
+			srcMutator: func(c *ContractInfo) {
+				c.Admin = c.Creator
+				c.AdminSet = &AdminSet{Members: []string{c.Creator}, Threshold: 1}
+			},
+			expError: true,
+		},
+		"invalid admin set": {
+			srcMutator: func(c *ContractInfo) { c.AdminSet = &AdminSet{Members: []string{c.Creator}, Threshold: 2} },
+			expError:   true,
+		},
+		"frozen by gov": {
+			srcMutator: func(c *ContractInfo) { c.Frozen, c.FrozenByGov = true, true },
+		},
+		"frozen by gov but not frozen": {
+			srcMutator: func(c *ContractInfo) { c.FrozenByGov = true },
+			expError:   true,
+		},
 		"invalid extension": {
 			srcMutator: func(c *ContractInfo) {
 				// any protobuf type with ValidateBasic method
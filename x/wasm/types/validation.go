@@ -6,6 +6,7 @@ import (
 	"strings"
 	"unicode"
 
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
 	"github.com/distribution/reference"
 
 	errorsmod "cosmossdk.io/errors"
@@ -99,6 +100,29 @@ func ValidateVerificationInfo(source, builder string, codeHash []byte) error {
 	return nil
 }
 
+// validateChecksum ensures the checksum has the length of a wasmvm checksum
+func validateChecksum(checksum []byte) error {
+	if len(checksum) != wasmvmtypes.ChecksumLen {
+		return errorsmod.Wrapf(ErrInvalid, "checksum must be %d bytes", wasmvmtypes.ChecksumLen)
+	}
+	return nil
+}
+
+// validateChecksums ensures the checksums are valid and have no duplicates
+func validateChecksums(checksums [][]byte) error {
+	index := map[string]struct{}{}
+	for _, c := range checksums {
+		if err := validateChecksum(c); err != nil {
+			return err
+		}
+		if _, exists := index[string(c)]; exists {
+			return ErrDuplicate.Wrapf("checksum: %X", c)
+		}
+		index[string(c)] = struct{}{}
+	}
+	return nil
+}
+
 // validateBech32Addresses ensures the list is not empty, has no duplicates
 // and does not exceed the max number of addresses
 func validateBech32Addresses(addresses []string) error {
@@ -290,9 +290,20 @@ type WasmEngine interface {
 
 var _ wasmvm.KVStore = &StoreAdapter{}
 
+// StorageUsageTracker is notified about all writes to a contract store before they are applied
+type StorageUsageTracker interface {
+	// OnSet is called before the value is stored for the key
+	OnSet(key, value []byte)
+	// OnDelete is called before the key is removed
+	OnDelete(key []byte)
+	// Flush persists the tracked usage after a successful contract call
+	Flush()
+}
+
 // StoreAdapter adapter to bridge SDK store impl to wasmvm
 type StoreAdapter struct {
-	parent storetypes.KVStore
+	parent  storetypes.KVStore
+	tracker StorageUsageTracker
 }
 
 // NewStoreAdapter constructor
@@ -303,18 +314,41 @@ func NewStoreAdapter(s storetypes.KVStore) *StoreAdapter {
 	return &StoreAdapter{parent: s}
 }
 
+// NewTrackingStoreAdapter constructor for a store adapter that reports all writes to the given tracker
+func NewTrackingStoreAdapter(s storetypes.KVStore, t StorageUsageTracker) *StoreAdapter {
+	if t == nil {
+		panic("tracker must not be nil")
+	}
+	a := NewStoreAdapter(s)
+	a.tracker = t
+	return a
+}
+
 func (s StoreAdapter) Get(key []byte) []byte {
 	return s.parent.Get(key)
 }
 
 func (s StoreAdapter) Set(key, value []byte) {
+	if s.tracker != nil {
+		s.tracker.OnSet(key, value)
+	}
 	s.parent.Set(key, value)
 }
 
 func (s StoreAdapter) Delete(key []byte) {
+	if s.tracker != nil {
+		s.tracker.OnDelete(key)
+	}
 	s.parent.Delete(key)
 }
 
+// Flush persists the usage reported to the tracker, if any
+func (s StoreAdapter) Flush() {
+	if s.tracker != nil {
+		s.tracker.Flush()
+	}
+}
+
 func (s StoreAdapter) Iterator(start, end []byte) wasmvmtypes.Iterator {
 	return s.parent.Iterator(start, end)
 }